@@ -1,22 +1,60 @@
 package debug
 
 import (
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"os"
 	"strings"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"gopkg.in/yaml.v3"
 )
 
+var (
+	decodeVaaFormat      *string
+	decodeVaaEncoding    *string
+	decodeVaaFile        *string
+	decodeVaaNetwork     *string
+	decodeVaaGuardianSet *string
+)
+
+func init() {
+	decodeVaaFormat = decodeVaaCmd.Flags().String("format", "spew", "Output format (spew, json or yaml)")
+	decodeVaaEncoding = decodeVaaCmd.Flags().String("encoding", "auto", "Input encoding (auto, hex, base64 or binary)")
+	decodeVaaFile = decodeVaaCmd.Flags().String("file", "", "Read the VAA from a file instead of the command line (use - for stdin)")
+	decodeVaaNetwork = decodeVaaCmd.Flags().String("network", "", "Verify signatures against the known guardian set of this network (mainnet, testnet or devnet)")
+	decodeVaaGuardianSet = decodeVaaCmd.Flags().String("guardian-set", "", "Verify signatures against this comma-separated list of guardian addresses")
+}
+
 var decodeVaaCmd = &cobra.Command{
-	Use:   "decode-vaa [DATA]",
-	Short: "Decode a hex-encoded VAA",
+	Use:   "decode-vaa [DATA]...",
+	Short: "Decode a hex or base64-encoded VAA",
+	Long: `Decode one or more VAAs. If no VAA is passed on the command line and --file is not set, the VAA is read from stdin.
+
+With --format json or yaml, the output includes the signing digest, the message ID, the recovered signer of every
+signature and, for known emitters, the decoded payload. If --network or --guardian-set is set, the VAA is also
+verified against that guardian set.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		for _, arg := range args {
-			arg = strings.TrimPrefix(arg, "0x")
-			b, err := hex.DecodeString(arg)
+		inputs, err := decodeVaaInputs(args, *decodeVaaFile, cmd.InOrStdin())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		verifier, err := decodeVaaVerifier(*decodeVaaNetwork, *decodeVaaGuardianSet)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		for _, input := range inputs {
+			b, err := decodeVaaBytes(input, *decodeVaaEncoding)
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -26,7 +64,159 @@ var decodeVaaCmd = &cobra.Command{
 				log.Fatal(err)
 			}
 
-			spew.Dump(v)
+			if err := writeDecodedVaa(cmd.OutOrStdout(), v, verifier, *decodeVaaFormat); err != nil {
+				log.Fatal(err)
+			}
 		}
 	},
 }
+
+// decodeVaaInputs returns the raw VAA inputs, either from the command line, a file or stdin.
+func decodeVaaInputs(args []string, file string, stdin io.Reader) ([][]byte, error) {
+	if file != "" {
+		if len(args) != 0 {
+			return nil, fmt.Errorf("--file cannot be combined with VAAs on the command line")
+		}
+
+		var data []byte
+		var err error
+		if file == "-" {
+			data, err = io.ReadAll(stdin)
+		} else {
+			data, err = os.ReadFile(file)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read VAA: %w", err)
+		}
+		return [][]byte{data}, nil
+	}
+
+	if len(args) == 0 || (len(args) == 1 && args[0] == "-") {
+		data, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read VAA from stdin: %w", err)
+		}
+		return [][]byte{data}, nil
+	}
+
+	inputs := make([][]byte, len(args))
+	for i, arg := range args {
+		inputs[i] = []byte(arg)
+	}
+	return inputs, nil
+}
+
+// decodeVaaBytes converts an input to the binary VAA. In auto mode, hex is tried first, then base64 and finally the
+// input is assumed to be the binary VAA.
+func decodeVaaBytes(input []byte, encoding string) ([]byte, error) {
+	text := strings.TrimPrefix(strings.TrimSpace(string(input)), "0x")
+
+	switch encoding {
+	case "hex":
+		return hex.DecodeString(text)
+	case "base64":
+		return base64.StdEncoding.DecodeString(text)
+	case "binary":
+		return input, nil
+	case "auto":
+		if b, err := hex.DecodeString(text); err == nil {
+			return b, nil
+		}
+		if b, err := base64.StdEncoding.DecodeString(text); err == nil {
+			return b, nil
+		}
+		return input, nil
+	default:
+		return nil, fmt.Errorf("invalid encoding %q, must be auto, hex, base64 or binary", encoding)
+	}
+}
+
+// decodeVaaVerifier returns the guardian set to verify against, or nil if verification was not requested.
+func decodeVaaVerifier(network string, guardianSet string) (*verificationGuardianSet, error) {
+	if network != "" && guardianSet != "" {
+		return nil, fmt.Errorf("--network and --guardian-set are mutually exclusive")
+	}
+
+	if guardianSet != "" {
+		var keys []common.Address
+		for _, key := range strings.Split(guardianSet, ",") {
+			key = strings.TrimSpace(key)
+			if !common.IsHexAddress(key) {
+				return nil, fmt.Errorf("invalid guardian address %q", key)
+			}
+			keys = append(keys, common.HexToAddress(key))
+		}
+		return &verificationGuardianSet{GuardianSet: vaa.GuardianSet{Keys: keys}, indexFromVaa: true}, nil
+	}
+
+	switch network {
+	case "":
+		return nil, nil
+	case "mainnet":
+		return &verificationGuardianSet{GuardianSet: sdk.KnownMainnetGuardianSet}, nil
+	case "testnet":
+		return &verificationGuardianSet{GuardianSet: sdk.KnownTestnetGuardianSet}, nil
+	case "devnet":
+		return &verificationGuardianSet{GuardianSet: sdk.KnownDevnetGuardianSet}, nil
+	default:
+		return nil, fmt.Errorf("invalid network %q, must be mainnet, testnet or devnet", network)
+	}
+}
+
+func writeDecodedVaa(w io.Writer, v *vaa.VAA, guardianSet *verificationGuardianSet, format string) error {
+	switch format {
+	case "spew":
+		spew.Fdump(w, v)
+		if guardianSet != nil {
+			verification := verifyDecodedVaa(v, guardianSet)
+			if verification.Valid {
+				fmt.Fprintf(w, "VAA is valid for guardian set %d\n", verification.GuardianSetIndex)
+			} else {
+				fmt.Fprintf(w, "VAA is NOT valid for guardian set %d: %s\n", verification.GuardianSetIndex, verification.Error)
+			}
+		}
+		return nil
+	case "json":
+		b, err := json.MarshalIndent(decodeVaa(v, guardianSet), "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(b))
+		return err
+	case "yaml":
+		return writeYaml(w, decodeVaa(v, guardianSet))
+	default:
+		return fmt.Errorf("invalid format %q, must be spew, json or yaml", format)
+	}
+}
+
+// writeYaml writes the value as YAML, using the same field names as the JSON output.
+func writeYaml(w io.Writer, value interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	// JSON is valid YAML, so parsing it into a node preserves the field order. Reset the style so that it is
+	// not rendered as flow-style YAML.
+	var node yaml.Node
+	if err := yaml.Unmarshal(b, &node); err != nil {
+		return err
+	}
+	resetYamlStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	fmt.Fprintln(w, "---")
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func resetYamlStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYamlStyle(child)
+	}
+}
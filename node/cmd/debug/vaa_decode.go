@@ -0,0 +1,343 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+type (
+	// decodedVaa is the structured representation of a VAA used by the json and yaml output formats.
+	decodedVaa struct {
+		Version          uint8                `json:"version"`
+		GuardianSetIndex uint32               `json:"guardianSetIndex"`
+		Timestamp        time.Time            `json:"timestamp"`
+		Nonce            uint32               `json:"nonce"`
+		Sequence         uint64               `json:"sequence"`
+		ConsistencyLevel uint8                `json:"consistencyLevel"`
+		EmitterChain     string               `json:"emitterChain"`
+		EmitterChainID   uint16               `json:"emitterChainId"`
+		EmitterAddress   string               `json:"emitterAddress"`
+		MessageID        string               `json:"messageId"`
+		Digest           string               `json:"digest"`
+		Signatures       []decodedSignature   `json:"signatures"`
+		Verification     *decodedVerification `json:"verification,omitempty"`
+		Payload          string               `json:"payload"`
+		DecodedPayload   interface{}          `json:"decodedPayload,omitempty"`
+	}
+
+	decodedSignature struct {
+		Index     uint8  `json:"index"`
+		Signature string `json:"signature"`
+		// Signer is the address recovered from the signature.
+		Signer string `json:"signer"`
+		// ExpectedSigner is the guardian at this index in the guardian set used for verification.
+		ExpectedSigner string `json:"expectedSigner,omitempty"`
+		Valid          *bool  `json:"valid,omitempty"`
+		Error          string `json:"error,omitempty"`
+	}
+
+	decodedVerification struct {
+		GuardianSetIndex uint32 `json:"guardianSetIndex"`
+		Quorum           int    `json:"quorum"`
+		Valid            bool   `json:"valid"`
+		Error            string `json:"error,omitempty"`
+	}
+
+	decodedTransfer struct {
+		Type         string `json:"type"`
+		Amount       string `json:"amount"`
+		TokenAddress string `json:"tokenAddress"`
+		TokenChain   string `json:"tokenChain"`
+		To           string `json:"to"`
+		ToChain      string `json:"toChain"`
+		Fee          string `json:"fee,omitempty"`
+		FromAddress  string `json:"fromAddress,omitempty"`
+		Payload      string `json:"payload,omitempty"`
+	}
+
+	decodedAttestation struct {
+		Type         string `json:"type"`
+		TokenAddress string `json:"tokenAddress"`
+		TokenChain   string `json:"tokenChain"`
+		Decimals     uint8  `json:"decimals"`
+		Symbol       string `json:"symbol"`
+		Name         string `json:"name"`
+	}
+
+	decodedGovernance struct {
+		Type        string                 `json:"type"`
+		Module      string                 `json:"module"`
+		Action      uint8                  `json:"action"`
+		ActionName  string                 `json:"actionName,omitempty"`
+		TargetChain string                 `json:"targetChain"`
+		Fields      map[string]interface{} `json:"fields,omitempty"`
+		Body        string                 `json:"body,omitempty"`
+	}
+)
+
+// knownEmitterTypes maps chain and emitter address to the bridge type for all known emitters of all networks.
+var knownEmitterTypes = func() map[string]sdk.EmitterType {
+	out := make(map[string]sdk.EmitterType)
+	for _, emitters := range [][]sdk.EmitterInfo{sdk.KnownEmitters, sdk.KnownTestnetEmitters, sdk.KnownDevnetEmitters} {
+		for _, e := range emitters {
+			out[fmt.Sprintf("%d/%s", e.ChainID, strings.ToLower(e.Emitter))] = e.BridgeType
+		}
+	}
+	return out
+}()
+
+// decodeVaa builds the structured representation of a VAA. If guardianSet is not nil, the signatures are verified against it.
+func decodeVaa(v *vaa.VAA, guardianSet *verificationGuardianSet) *decodedVaa {
+	digest := v.SigningDigest()
+	out := &decodedVaa{
+		Version:          v.Version,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Timestamp:        v.Timestamp.UTC(),
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		ConsistencyLevel: v.ConsistencyLevel,
+		EmitterChain:     v.EmitterChain.String(),
+		EmitterChainID:   uint16(v.EmitterChain),
+		EmitterAddress:   v.EmitterAddress.String(),
+		MessageID:        v.MessageID(),
+		Digest:           digest.Hex(),
+		Signatures:       make([]decodedSignature, 0, len(v.Signatures)),
+		Payload:          hex.EncodeToString(v.Payload),
+		DecodedPayload:   decodePayload(v),
+	}
+
+	for _, sig := range v.Signatures {
+		ds := decodedSignature{
+			Index:     sig.Index,
+			Signature: hex.EncodeToString(sig.Signature[:]),
+		}
+
+		pubKey, err := crypto.Ecrecover(digest.Bytes(), sig.Signature[:])
+		if err != nil {
+			ds.Error = fmt.Sprintf("failed to recover signer: %v", err)
+		} else {
+			ds.Signer = common.BytesToAddress(crypto.Keccak256(pubKey[1:])[12:]).Hex()
+		}
+
+		// Signers can only be compared if the guardian set is the one that signed the VAA.
+		if guardianSet != nil && (guardianSet.indexFromVaa || guardianSet.Index == v.GuardianSetIndex) {
+			valid := false
+			if int(sig.Index) < len(guardianSet.Keys) {
+				ds.ExpectedSigner = guardianSet.Keys[sig.Index].Hex()
+				valid = err == nil && ds.Signer == ds.ExpectedSigner
+			}
+			ds.Valid = &valid
+		}
+
+		out.Signatures = append(out.Signatures, ds)
+	}
+
+	if guardianSet != nil {
+		out.Verification = verifyDecodedVaa(v, guardianSet)
+	}
+
+	return out
+}
+
+// verificationGuardianSet is the guardian set that decoded VAAs are verified against.
+type verificationGuardianSet struct {
+	vaa.GuardianSet
+	// indexFromVaa is set if the guardian set was provided without an index, in which case it is assumed to be
+	// the guardian set referenced by the VAA.
+	indexFromVaa bool
+}
+
+// verifyDecodedVaa verifies the VAA against the guardian set.
+func verifyDecodedVaa(v *vaa.VAA, guardianSet *verificationGuardianSet) *decodedVerification {
+	gs := guardianSet.GuardianSet
+	if guardianSet.indexFromVaa {
+		gs.Index = v.GuardianSetIndex
+	}
+
+	out := &decodedVerification{
+		GuardianSetIndex: gs.Index,
+		Quorum:           vaa.CalculateQuorum(len(gs.Keys)),
+	}
+
+	verifier, err := vaa.NewVerifier(gs)
+	if err == nil {
+		err = verifier.Verify(v)
+	}
+
+	if err != nil {
+		out.Error = err.Error()
+	} else {
+		out.Valid = true
+	}
+
+	return out
+}
+
+// decodePayload decodes the payload of governance VAAs and VAAs from known token bridge emitters. It returns nil if the
+// payload is not understood.
+func decodePayload(v *vaa.VAA) interface{} {
+	if v.EmitterChain == vaa.GovernanceChain && v.EmitterAddress == vaa.GovernanceEmitter {
+		if gov, err := decodeGovernancePayload(v.Payload); err == nil {
+			return gov
+		}
+		return nil
+	}
+
+	key := fmt.Sprintf("%d/%s", v.EmitterChain, hex.EncodeToString(v.EmitterAddress[:]))
+	if knownEmitterTypes[key] == sdk.EmitterTokenBridge {
+		if decoded, err := decodeTokenBridgePayload(v.Payload); err == nil {
+			return decoded
+		}
+	}
+
+	return nil
+}
+
+func decodeTokenBridgePayload(payload []byte) (interface{}, error) {
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("empty payload")
+	}
+
+	switch payload[0] {
+	case 1, 3:
+		// Transfer: type (1), amount (32), token address (32), token chain (2), to (32), to chain (2), then either
+		// the fee (32) or the sender (32) followed by an arbitrary payload.
+		if len(payload) < 133 {
+			return nil, fmt.Errorf("transfer payload too short: %d", len(payload))
+		}
+		hdr, err := vaa.DecodeTransferPayloadHdr(payload)
+		if err != nil {
+			return nil, err
+		}
+		out := &decodedTransfer{
+			Amount:       hdr.Amount.String(),
+			TokenAddress: hdr.OriginAddress.String(),
+			TokenChain:   hdr.OriginChain.String(),
+			To:           hdr.TargetAddress.String(),
+			ToChain:      hdr.TargetChain.String(),
+		}
+		if payload[0] == 1 {
+			out.Type = "Transfer"
+			out.Fee = new(big.Int).SetBytes(payload[101:133]).String()
+		} else {
+			out.Type = "TransferWithPayload"
+			out.FromAddress = hex.EncodeToString(payload[101:133])
+			out.Payload = hex.EncodeToString(payload[133:])
+		}
+		return out, nil
+	case 2:
+		// Attestation: type (1), token address (32), token chain (2), decimals (1), symbol (32), name (32).
+		if len(payload) < 100 {
+			return nil, fmt.Errorf("attestation payload too short: %d", len(payload))
+		}
+		return &decodedAttestation{
+			Type:         "AssetMeta",
+			TokenAddress: hex.EncodeToString(payload[1:33]),
+			TokenChain:   vaa.ChainID(binary.BigEndian.Uint16(payload[33:35])).String(),
+			Decimals:     payload[35],
+			Symbol:       string(bytes.TrimRight(payload[36:68], "\x00")),
+			Name:         string(bytes.TrimRight(payload[68:100], "\x00")),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown token bridge payload type %d", payload[0])
+	}
+}
+
+func decodeGovernancePayload(payload []byte) (*decodedGovernance, error) {
+	// Module (32), action (1), target chain (2).
+	if len(payload) < 35 {
+		return nil, fmt.Errorf("governance payload too short: %d", len(payload))
+	}
+
+	module := string(bytes.TrimLeft(payload[0:32], "\x00"))
+	action := payload[32]
+	body := payload[35:]
+	out := &decodedGovernance{
+		Type:        "Governance",
+		Module:      module,
+		Action:      action,
+		TargetChain: vaa.ChainID(binary.BigEndian.Uint16(payload[33:35])).String(),
+	}
+
+	switch module {
+	case "Core":
+		switch vaa.GovernanceAction(action) {
+		case vaa.ActionContractUpgrade:
+			if len(body) == 32 {
+				out.ActionName = "ContractUpgrade"
+				out.Fields = map[string]interface{}{"newContract": hex.EncodeToString(body)}
+			}
+		case vaa.ActionGuardianSetUpdate:
+			var gsu vaa.BodyGuardianSetUpdate
+			if err := gsu.Deserialize(body); err == nil {
+				keys := make([]string, len(gsu.Keys))
+				for i, k := range gsu.Keys {
+					keys[i] = k.Hex()
+				}
+				out.ActionName = "GuardianSetUpdate"
+				out.Fields = map[string]interface{}{"newIndex": gsu.NewIndex, "keys": keys}
+			}
+		case vaa.ActionCoreSetMessageFee:
+			if len(body) == 32 {
+				out.ActionName = "SetMessageFee"
+				out.Fields = map[string]interface{}{"fee": new(big.Int).SetBytes(body).String()}
+			}
+		case vaa.ActionCoreTransferFees:
+			if len(body) == 64 {
+				out.ActionName = "TransferFees"
+				out.Fields = map[string]interface{}{
+					"amount":    new(big.Int).SetBytes(body[0:32]).String(),
+					"recipient": hex.EncodeToString(body[32:64]),
+				}
+			}
+		case vaa.ActionCoreRecoverChainId:
+			decodeRecoverChainId(out, body)
+		}
+	case "TokenBridge", "NFTBridge":
+		switch vaa.GovernanceAction(action) {
+		case vaa.ActionRegisterChain:
+			if len(body) == 34 {
+				out.ActionName = "RegisterChain"
+				out.Fields = map[string]interface{}{
+					"emitterChain":   vaa.ChainID(binary.BigEndian.Uint16(body[0:2])).String(),
+					"emitterAddress": hex.EncodeToString(body[2:34]),
+				}
+			}
+		case vaa.ActionUpgradeTokenBridge:
+			if len(body) == 32 {
+				out.ActionName = "ContractUpgrade"
+				out.Fields = map[string]interface{}{"newContract": hex.EncodeToString(body)}
+			}
+		case vaa.ActionTokenBridgeRecoverChainId:
+			decodeRecoverChainId(out, body)
+		}
+	}
+
+	// Include the raw body for anything we could not decode.
+	if out.Fields == nil {
+		out.Body = hex.EncodeToString(body)
+	}
+
+	return out, nil
+}
+
+func decodeRecoverChainId(out *decodedGovernance, body []byte) {
+	if len(body) != 34 {
+		return
+	}
+	out.ActionName = "RecoverChainId"
+	out.Fields = map[string]interface{}{
+		"evmChainId": new(big.Int).SetBytes(body[0:32]).String(),
+		"newChainId": vaa.ChainID(binary.BigEndian.Uint16(body[32:34])).String(),
+	}
+}
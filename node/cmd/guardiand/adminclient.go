@@ -10,8 +10,10 @@ import (
 	"io"
 	"log"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/types"
@@ -22,6 +24,7 @@ import (
 	"github.com/spf13/pflag"
 	"golang.org/x/crypto/sha3"
 
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/node"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
@@ -43,6 +46,10 @@ var (
 	clientSocketPath *string
 	shouldBackfill   *bool
 	unsafeDevnetMode *bool
+
+	accountantQueryContract *bool
+
+	p2pStatusGuardiansOnly *bool
 )
 
 func init() {
@@ -57,6 +64,12 @@ func init() {
 	shouldBackfill = AdminClientFindMissingMessagesCmd.Flags().Bool(
 		"backfill", false, "backfill missing VAAs from public RPC")
 
+	accountantQueryContract = AccountantPendingTransfersCmd.Flags().Bool(
+		"queryContract", false, "query the status of each transfer from the accountant contract")
+
+	p2pStatusGuardiansOnly = P2PStatusCmd.Flags().Bool(
+		"guardiansOnly", false, "only list the peers that are known guardians")
+
 	AdminClientInjectGuardianSetUpdateCmd.Flags().AddFlagSet(pf)
 	AdminClientFindMissingMessagesCmd.Flags().AddFlagSet(pf)
 	AdminClientListNodes.Flags().AddFlagSet(pf)
@@ -70,6 +83,22 @@ func init() {
 	ClientChainGovernorReleasePendingVAACmd.Flags().AddFlagSet(pf)
 	ClientChainGovernorResetReleaseTimerCmd.Flags().AddFlagSet(pf)
 	PurgePythNetVaasCmd.Flags().AddFlagSet(pf)
+	ApplyVaaRetentionPolicyCmd.Flags().AddFlagSet(pf)
+	InspectObservationCmd.Flags().AddFlagSet(pf)
+	GuardianPerformanceCmd.Flags().AddFlagSet(pf)
+	DenylistStatusCmd.Flags().AddFlagSet(pf)
+	DenylistAddEntryCmd.Flags().AddFlagSet(pf)
+	DenylistRemoveEntryCmd.Flags().AddFlagSet(pf)
+	DenylistReleaseHeldMessageCmd.Flags().AddFlagSet(pf)
+	DenylistDropHeldMessageCmd.Flags().AddFlagSet(pf)
+	GatewayRelayerQueueCmd.Flags().AddFlagSet(pf)
+	GatewayRelayerReplayCmd.Flags().AddFlagSet(pf)
+	GatewayRelayerDropCmd.Flags().AddFlagSet(pf)
+	AccountantPendingTransfersCmd.Flags().AddFlagSet(pf)
+	AccountantResubmitObservationCmd.Flags().AddFlagSet(pf)
+	AccountantReconciliationReportCmd.Flags().AddFlagSet(pf)
+	P2PStatusCmd.Flags().AddFlagSet(pf)
+	ChainHeightStatusCmd.Flags().AddFlagSet(pf)
 	SignExistingVaaCmd.Flags().AddFlagSet(pf)
 	SignExistingVaasFromCSVCmd.Flags().AddFlagSet(pf)
 	GetAndObserveMissingVAAs.Flags().AddFlagSet(pf)
@@ -93,6 +122,22 @@ func init() {
 	AdminCmd.AddCommand(ClientChainGovernorReleasePendingVAACmd)
 	AdminCmd.AddCommand(ClientChainGovernorResetReleaseTimerCmd)
 	AdminCmd.AddCommand(PurgePythNetVaasCmd)
+	AdminCmd.AddCommand(ApplyVaaRetentionPolicyCmd)
+	AdminCmd.AddCommand(InspectObservationCmd)
+	AdminCmd.AddCommand(GuardianPerformanceCmd)
+	AdminCmd.AddCommand(DenylistStatusCmd)
+	AdminCmd.AddCommand(DenylistAddEntryCmd)
+	AdminCmd.AddCommand(DenylistRemoveEntryCmd)
+	AdminCmd.AddCommand(DenylistReleaseHeldMessageCmd)
+	AdminCmd.AddCommand(DenylistDropHeldMessageCmd)
+	AdminCmd.AddCommand(GatewayRelayerQueueCmd)
+	AdminCmd.AddCommand(GatewayRelayerReplayCmd)
+	AdminCmd.AddCommand(GatewayRelayerDropCmd)
+	AdminCmd.AddCommand(AccountantPendingTransfersCmd)
+	AdminCmd.AddCommand(AccountantResubmitObservationCmd)
+	AdminCmd.AddCommand(AccountantReconciliationReportCmd)
+	AdminCmd.AddCommand(P2PStatusCmd)
+	AdminCmd.AddCommand(ChainHeightStatusCmd)
 	AdminCmd.AddCommand(SignExistingVaaCmd)
 	AdminCmd.AddCommand(SignExistingVaasFromCSVCmd)
 	AdminCmd.AddCommand(Keccak256Hash)
@@ -188,6 +233,118 @@ var PurgePythNetVaasCmd = &cobra.Command{
 	Args:  cobra.RangeArgs(1, 2),
 }
 
+var ApplyVaaRetentionPolicyCmd = &cobra.Command{
+	Use:   "apply-vaa-retention-policy <dryrun>",
+	Short: "Applies the VAA retention policy the guardian was started with (if dryrun is specified, doesn't delete anything)",
+	Run:   runApplyVaaRetentionPolicy,
+	Args:  cobra.RangeArgs(0, 1),
+}
+
+var InspectObservationCmd = &cobra.Command{
+	Use:   "inspect-observation [MESSAGE_ID|DIGEST]",
+	Short: "Reports the processor, governor, accountant and database state of a message, identified by its message ID (chain/emitter/seq) or signing digest",
+	Run:   runInspectObservation,
+	Args:  cobra.ExactArgs(1),
+}
+
+var GuardianPerformanceCmd = &cobra.Command{
+	Use:   "guardian-performance [CHAIN_ID]",
+	Short: "Reports, per guardian and chain, the share of recently settled observations each guardian signed and its median signing latency",
+	Run:   runGuardianPerformance,
+	Args:  cobra.RangeArgs(0, 1),
+}
+
+var DenylistStatusCmd = &cobra.Command{
+	Use:   "denylist-status",
+	Short: "Displays the denylist entries and the messages held by the denylist",
+	Run:   runDenylistStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var DenylistAddEntryCmd = &cobra.Command{
+	Use:   "denylist-add [KIND] [CHAIN] [ADDRESS] [TTL] <REASON>",
+	Short: "Adds a denylist entry of KIND emitter, token or recipient, expiring after TTL (e.g. 24h, or 0 for never)",
+	Run:   runDenylistAddEntry,
+	Args:  cobra.RangeArgs(4, 5),
+}
+
+var DenylistRemoveEntryCmd = &cobra.Command{
+	Use:   "denylist-remove [KIND] [CHAIN] [ADDRESS]",
+	Short: "Removes a denylist entry that was added with denylist-add",
+	Run:   runDenylistRemoveEntry,
+	Args:  cobra.ExactArgs(3),
+}
+
+var DenylistReleaseHeldMessageCmd = &cobra.Command{
+	Use:   "denylist-release [VAA_ID]",
+	Short: "Releases a message held by the denylist, publishing it immediately",
+	Run:   runDenylistReleaseHeldMessage,
+	Args:  cobra.ExactArgs(1),
+}
+
+var DenylistDropHeldMessageCmd = &cobra.Command{
+	Use:   "denylist-drop [VAA_ID]",
+	Short: "Drops a message held by the denylist",
+	Run:   runDenylistDropHeldMessage,
+	Args:  cobra.ExactArgs(1),
+}
+
+var GatewayRelayerQueueCmd = &cobra.Command{
+	Use:   "gateway-relayer-queue",
+	Short: "Displays the VAAs waiting to be submitted to wormchain by the gateway relayer",
+	Run:   runGatewayRelayerQueue,
+	Args:  cobra.ExactArgs(0),
+}
+
+var GatewayRelayerReplayCmd = &cobra.Command{
+	Use:   "gateway-relayer-replay [VAA_ID]",
+	Short: "Retries a queued VAA immediately, or queues a stored VAA that was not relayed (e.g. after a wormchain outage)",
+	Run:   runGatewayRelayerReplay,
+	Args:  cobra.ExactArgs(1),
+}
+
+var GatewayRelayerDropCmd = &cobra.Command{
+	Use:   "gateway-relayer-drop [VAA_ID]",
+	Short: "Removes a VAA from the gateway relayer queue",
+	Run:   runGatewayRelayerDrop,
+	Args:  cobra.ExactArgs(1),
+}
+
+var AccountantPendingTransfersCmd = &cobra.Command{
+	Use:   "accountant-pending",
+	Short: "Displays the transfers held by the accountant (base and NTT), optionally with their status on the contract",
+	Run:   runAccountantPendingTransfers,
+	Args:  cobra.ExactArgs(0),
+}
+
+var AccountantResubmitObservationCmd = &cobra.Command{
+	Use:   "accountant-resubmit [VAA_ID]",
+	Short: "Forces the observation of a transfer held by the accountant to be submitted to the contract again",
+	Run:   runAccountantResubmitObservation,
+	Args:  cobra.ExactArgs(1),
+}
+
+var AccountantReconciliationReportCmd = &cobra.Command{
+	Use:   "accountant-reconcile",
+	Short: "Lists the disagreements between the transfers held by the accountant and the state of the contracts",
+	Run:   runAccountantReconciliationReport,
+	Args:  cobra.ExactArgs(0),
+}
+
+var P2PStatusCmd = &cobra.Command{
+	Use:   "p2p-status",
+	Short: "Shows the connected gossip peers, their mesh membership and traffic, and the recent disconnects",
+	Run:   runP2PStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
+var ChainHeightStatusCmd = &cobra.Command{
+	Use:   "chain-height-status",
+	Short: "Compares the block heights of our watchers to the median of the heights reported by the other guardians",
+	Run:   runChainHeightStatus,
+	Args:  cobra.ExactArgs(0),
+}
+
 var SignExistingVaaCmd = &cobra.Command{
 	Use:   "sign-existing-vaa [VAA] [NEW_GUARDIANS] [NEW_GUARDIAN_SET_INDEX]",
 	Short: "Signs an existing VAA for a new guardian set using the local guardian key. This only works if the new VAA would have quorum.",
@@ -266,7 +423,7 @@ func runSignWormchainValidatorAddress(cmd *cobra.Command, args []string) error {
 
 	// Hash and sign address
 	addrHash := crypto.Keccak256Hash(sdk.SignedWormchainAddressPrefix, addr)
-	sig, err := guardianSigner.Sign(ctx, addrHash.Bytes())
+	sig, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeWormchainAddress), addrHash.Bytes())
 	if err != nil {
 		return fmt.Errorf("failed to sign wormchain address: %w", err)
 	}
@@ -674,6 +831,619 @@ func runPurgePythNetVaas(cmd *cobra.Command, args []string) {
 	fmt.Println(resp.Response)
 }
 
+func runApplyVaaRetentionPolicy(cmd *cobra.Command, args []string) {
+	dryRun := false
+	if len(args) > 0 {
+		if args[0] != "dryrun" {
+			log.Fatalf("invalid option, only \"dryrun\" is supported")
+		}
+
+		dryRun = true
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.ApplyVaaRetentionPolicyRequest{
+		DryRun: dryRun,
+	}
+	resp, err := c.ApplyVaaRetentionPolicy(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run ApplyVaaRetentionPolicy RPC: %s", err)
+	}
+
+	fmt.Print(resp.Response)
+}
+
+func runInspectObservation(cmd *cobra.Command, args []string) {
+	msg := nodev1.InspectObservationRequest{}
+	if strings.Contains(args[0], "/") {
+		msg.Id = &nodev1.InspectObservationRequest_MessageId{MessageId: args[0]}
+	} else {
+		msg.Id = &nodev1.InspectObservationRequest_Digest{Digest: args[0]}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.InspectObservation(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run InspectObservation RPC: %s", err)
+	}
+
+	formatTime := func(ts int64) string {
+		if ts == 0 {
+			return "-"
+		}
+		return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+	}
+
+	fmt.Printf("Message ID: %s\n", resp.MessageId)
+
+	if len(resp.States) == 0 {
+		fmt.Println("Processor: no aggregation state")
+	}
+	for _, s := range resp.States {
+		fmt.Printf("Processor: digest %s\n", s.Digest)
+		fmt.Printf("  first observed:     %s\n", formatTime(s.FirstObserved))
+		fmt.Printf("  our observation:    %t\n", s.OurObservation)
+		fmt.Printf("  submitted:          %t\n", s.Submitted)
+		fmt.Printf("  settled:            %t\n", s.Settled)
+		fmt.Printf("  retries:            %d (next retry %s)\n", s.RetryCount, formatTime(s.NextRetry))
+		fmt.Printf("  tx hash:            %s\n", s.TxHash)
+		fmt.Printf("  signatures:         %d/%d for quorum on guardian set %d\n", len(s.SignedBy), s.Quorum, s.GuardianSetIndex)
+		for _, addr := range s.SignedBy {
+			fmt.Printf("    signed:  %s\n", addr)
+		}
+		for _, addr := range s.Missing {
+			fmt.Printf("    missing: %s\n", addr)
+		}
+	}
+
+	if resp.Governor == nil {
+		fmt.Println("Governor: disabled")
+	} else if resp.Governor.Pending {
+		fmt.Printf("Governor: pending, release time %s\n", formatTime(resp.Governor.ReleaseTime))
+	} else {
+		fmt.Printf("Governor: not pending (seen: %t)\n", resp.Governor.Seen)
+	}
+
+	if resp.Accountant == nil {
+		fmt.Println("Accountant: disabled")
+	} else if resp.Accountant.Pending {
+		fmt.Printf("Accountant: pending (ntt: %t, submit pending: %t, updated %s)\n",
+			resp.Accountant.IsNtt, resp.Accountant.SubmitPending, formatTime(resp.Accountant.Updated))
+	} else {
+		fmt.Println("Accountant: not pending")
+	}
+
+	if resp.StoredVaa == nil {
+		fmt.Println("Database: VAA not stored")
+	} else {
+		fmt.Printf("Database: VAA stored with %d signatures on guardian set %d (digest %s)\n",
+			resp.StoredVaa.NumSignatures, resp.StoredVaa.GuardianSetIndex, resp.StoredVaa.Digest)
+	}
+}
+
+func runGuardianPerformance(cmd *cobra.Command, args []string) {
+	msg := nodev1.GuardianPerformanceRequest{}
+	if len(args) > 0 {
+		// Accept both numeric chain IDs and chain names.
+		if chainID, err := strconv.ParseUint(args[0], 10, 16); err == nil {
+			msg.ChainId = uint32(chainID)
+		} else {
+			chainID, err := vaa.ChainIDFromString(args[0])
+			if err != nil {
+				log.Fatalf("invalid chain ID: %v", err)
+			}
+			msg.ChainId = uint32(chainID)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GuardianPerformance(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run GuardianPerformance RPC: %s", err)
+	}
+
+	fmt.Printf("Settled observations in the last %s\n\n", time.Duration(resp.WindowSeconds)*time.Second)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Chain\tGuardian\tObservations\tSigned\tMissed\tParticipation\tMedian latency\t")
+	for _, e := range resp.Entries {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%.2f%%\t%s\t\n",
+			vaa.ChainID(e.ChainId), // #nosec G115 -- The chain ID was validated by the server
+			e.GuardianAddress,
+			e.Observations,
+			e.Signed,
+			e.Missed,
+			e.ParticipationRate*100,
+			time.Duration(e.MedianLatencyMs)*time.Millisecond,
+		)
+	}
+	w.Flush()
+}
+
+func runDenylistStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.DenylistStatus(ctx, &nodev1.DenylistStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run DenylistStatus RPC: %s", err)
+	}
+
+	fmt.Print(resp.Response)
+}
+
+func runDenylistAddEntry(cmd *cobra.Command, args []string) {
+	chainID, err := denylist.ParseChain(args[1])
+	if err != nil {
+		log.Fatalf("invalid chain: %v", err)
+	}
+
+	ttl, err := time.ParseDuration(args[3])
+	if err != nil {
+		log.Fatalf("invalid TTL: %v", err)
+	}
+	if ttl < 0 {
+		log.Fatalf("TTL must not be negative")
+	}
+
+	msg := nodev1.DenylistAddEntryRequest{
+		Kind:       args[0],
+		ChainId:    uint32(chainID),
+		Address:    args[2],
+		TtlSeconds: uint64(ttl.Seconds()),
+	}
+	if len(args) > 4 {
+		msg.Reason = args[4]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.DenylistAddEntry(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run DenylistAddEntry RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runDenylistRemoveEntry(cmd *cobra.Command, args []string) {
+	chainID, err := denylist.ParseChain(args[1])
+	if err != nil {
+		log.Fatalf("invalid chain: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.DenylistRemoveEntryRequest{
+		Kind:    args[0],
+		ChainId: uint32(chainID),
+		Address: args[2],
+	}
+	resp, err := c.DenylistRemoveEntry(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run DenylistRemoveEntry RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runDenylistReleaseHeldMessage(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.DenylistReleaseHeldMessageRequest{
+		VaaId: args[0],
+	}
+	resp, err := c.DenylistReleaseHeldMessage(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run DenylistReleaseHeldMessage RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runDenylistDropHeldMessage(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.DenylistDropHeldMessageRequest{
+		VaaId: args[0],
+	}
+	resp, err := c.DenylistDropHeldMessage(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run DenylistDropHeldMessage RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runGatewayRelayerQueue(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.GatewayRelayerQueue(ctx, &nodev1.GatewayRelayerQueueRequest{})
+	if err != nil {
+		log.Fatalf("failed to run GatewayRelayerQueue RPC: %s", err)
+	}
+
+	fmt.Printf("%d VAAs queued\n\n", len(resp.Entries))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Message ID\tType\tEnqueued\tAttempts\tNext attempt\tStatus\tLast error\t")
+	for _, e := range resp.Entries {
+		status := "pending"
+		if e.Failed {
+			status = "failed"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\t\n",
+			e.MessageId,
+			e.VaaType,
+			time.Unix(e.EnqueuedAt, 0).UTC().Format(time.RFC3339),
+			e.Attempts,
+			time.Unix(e.NextAttempt, 0).UTC().Format(time.RFC3339),
+			status,
+			e.LastError,
+		)
+	}
+	w.Flush()
+}
+
+func runGatewayRelayerReplay(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.GatewayRelayerReplayRequest{
+		VaaId: args[0],
+	}
+	resp, err := c.GatewayRelayerReplay(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run GatewayRelayerReplay RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runGatewayRelayerDrop(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.GatewayRelayerDropRequest{
+		VaaId: args[0],
+	}
+	resp, err := c.GatewayRelayerDrop(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run GatewayRelayerDrop RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runAccountantPendingTransfers(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.AccountantPendingTransfersRequest{
+		QueryContract: *accountantQueryContract,
+	}
+	resp, err := c.AccountantPendingTransfers(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run AccountantPendingTransfers RPC: %s", err)
+	}
+
+	fmt.Printf("%d transfers pending\n\n", len(resp.Entries))
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Message ID\tAccountant\tEnforced\tSubmit pending\tUpdated\tContract status\t")
+	for _, e := range resp.Entries {
+		tag := "base"
+		if e.IsNtt {
+			tag = "ntt"
+		}
+		contractStatus := e.ContractStatus
+		if contractStatus == "" {
+			contractStatus = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%t\t%t\t%s\t%s\t\n",
+			e.MessageId,
+			tag,
+			e.Enforced,
+			e.SubmitPending,
+			time.Unix(e.UpdatedAt, 0).UTC().Format(time.RFC3339),
+			contractStatus,
+		)
+	}
+	w.Flush()
+}
+
+func runAccountantResubmitObservation(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	msg := nodev1.AccountantResubmitObservationRequest{
+		VaaId: args[0],
+	}
+	resp, err := c.AccountantResubmitObservation(ctx, &msg)
+	if err != nil {
+		log.Fatalf("failed to run AccountantResubmitObservation RPC: %s", err)
+	}
+
+	fmt.Println(resp.Response)
+}
+
+func runAccountantReconciliationReport(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.AccountantReconciliationReport(ctx, &nodev1.AccountantReconciliationReportRequest{})
+	if err != nil {
+		log.Fatalf("failed to run AccountantReconciliationReport RPC: %s", err)
+	}
+
+	fmt.Printf("Pending transfers: %d base, %d ntt\n", resp.NumPending, resp.NumNttPending)
+	fmt.Printf("Discrepancies: %d\n\n", len(resp.Discrepancies))
+	if len(resp.Discrepancies) == 0 {
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Kind\tAccountant\tMessage ID\tChain\tTx hash\tDetail\t")
+	for _, d := range resp.Discrepancies {
+		tag := "base"
+		if d.IsNtt {
+			tag = "ntt"
+		}
+		msgId := d.MessageId
+		if msgId == "" {
+			msgId = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			d.Kind,
+			tag,
+			msgId,
+			vaa.ChainID(d.ChainId), // #nosec G115 -- The chain ID comes from a uint16
+			d.TxHash,
+			d.Detail,
+		)
+	}
+	w.Flush()
+}
+
+func runP2PStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.P2PStatus(ctx, &nodev1.P2PStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run P2PStatus RPC: %s", err)
+	}
+
+	// Guardians are shown by node name, other peers by a dash.
+	nameOf := func(guardianAddr, nodeName string) string {
+		if guardianAddr == "" {
+			return "-"
+		}
+		return fmt.Sprintf("%s (%s)", nodeName, guardianAddr)
+	}
+	orDash := func(s []string) string {
+		if len(s) == 0 {
+			return "-"
+		}
+		return strings.Join(s, ",")
+	}
+
+	fmt.Printf("Peer ID: %s\n", resp.PeerId)
+	fmt.Printf("Listening on: %s\n", strings.Join(resp.ListenAddrs, ", "))
+	fmt.Printf("Peer scoring: %t\n", resp.PeerScoring)
+	kinds := make([]string, 0, len(resp.MeshSizes))
+	for kind := range resp.MeshSizes {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		fmt.Printf("Mesh size %s: %d\n", kind, resp.MeshSizes[kind])
+	}
+
+	numGuardians := 0
+	for _, p := range resp.Peers {
+		if p.GuardianAddr != "" {
+			numGuardians++
+		}
+	}
+	fmt.Printf("\n%d peers connected, %d of which are guardians\n\n", len(resp.Peers), numGuardians)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Peer ID\tGuardian\tProtected\tDirection\tConnected since\tLatency\tSubscribed\tMesh\tBytes in/out\tMsgs in/out\tScore\tAddrs\t")
+	for _, p := range resp.Peers {
+		if *p2pStatusGuardiansOnly && p.GuardianAddr == "" {
+			continue
+		}
+		score := "-"
+		if resp.PeerScoring {
+			score = fmt.Sprintf("%.2f", p.Score)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%dms\t%s\t%s\t%d/%d\t%d/%d\t%s\t%s\t\n",
+			p.PeerId,
+			nameOf(p.GuardianAddr, p.NodeName),
+			orDash(p.ProtectionTags),
+			p.Direction,
+			time.Unix(p.ConnectedSince, 0).UTC().Format(time.RFC3339),
+			p.LatencyMs,
+			orDash(p.SubscribedTopics),
+			orDash(p.MeshTopics),
+			p.BytesIn,
+			p.BytesOut,
+			p.MessagesIn,
+			p.MessagesOut,
+			score,
+			strings.Join(p.Addrs, ","),
+		)
+	}
+	w.Flush()
+
+	fmt.Printf("\n%d recent disconnects\n\n", len(resp.RecentDisconnects))
+	if len(resp.RecentDisconnects) == 0 {
+		return
+	}
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Disconnected at\tPeer ID\tGuardian\tConnected for\tReason\tAddr\t")
+	for _, d := range resp.RecentDisconnects {
+		if *p2pStatusGuardiansOnly && d.GuardianAddr == "" {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t\n",
+			time.Unix(d.DisconnectedAt, 0).UTC().Format(time.RFC3339),
+			d.PeerId,
+			nameOf(d.GuardianAddr, d.NodeName),
+			time.Duration(d.ConnectedFor)*time.Second,
+			d.Reason,
+			d.Addr,
+		)
+	}
+	w.Flush()
+}
+
+func runChainHeightStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, c, err := getAdminClient(ctx, *clientSocketPath)
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+	defer conn.Close()
+
+	resp, err := c.ChainHeightStatus(ctx, &nodev1.ChainHeightStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to run ChainHeightStatus RPC: %s", err)
+	}
+
+	// Unknown heights are shown as a dash.
+	orDash := func(v int64) string {
+		if v <= 0 {
+			return "-"
+		}
+		return strconv.FormatInt(v, 10)
+	}
+	heights := func(h *nodev1.ChainHeightStatusResponse_Heights) string {
+		return fmt.Sprintf("%s/%s/%s", orDash(h.GetHeight()), orDash(h.GetSafeHeight()), orDash(h.GetFinalizedHeight()))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Chain\tLocal (latest/safe/finalized)\tNetwork median\tGuardians\tDeviation\tThreshold\tStatus\t")
+	for _, e := range resp.Entries {
+		fmt.Fprintf(w, "%s (%d)\t%s\t%s\t%d\t%d\t%d\t%s\t\n",
+			e.ChainName,
+			e.ChainId,
+			heights(e.Local),
+			heights(e.NetworkMedian),
+			e.NumGuardians,
+			e.Deviation,
+			e.Threshold,
+			e.Status,
+		)
+	}
+	w.Flush()
+}
+
 func runSignExistingVaa(cmd *cobra.Command, args []string) {
 	existingVAA := ethcommon.Hex2Bytes(args[0])
 	if len(existingVAA) == 0 {
@@ -0,0 +1,279 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa/rpcverifier"
+	"go.uber.org/zap"
+)
+
+var (
+	dbDataDir *string
+	dbFile    *string
+
+	dbImportSkipVerify *bool
+
+	dbVerifyNetwork      *string
+	dbVerifyGuardianSets *[]string
+	dbVerifyEthRPC       *string
+	dbVerifyCoreContract *string
+	dbVerifyHistory      *uint32
+)
+
+func init() {
+	dbDataDir = DbCmd.PersistentFlags().String("dataDir", "", "Data directory of the guardian (must not be in use by a running guardian)")
+	dbFile = DbCmd.PersistentFlags().String("file", "-", "Export file (use - for stdin / stdout)")
+
+	// The guardian set flags are shared by import and verify.
+	verifierFlags := pflag.NewFlagSet("verifier", pflag.ExitOnError)
+	dbVerifyNetwork = verifierFlags.String("network", "", "Verify VAA signatures against the known guardian set of this network (mainnet, testnet or devnet)")
+	dbVerifyGuardianSets = verifierFlags.StringArray("guardianSet", nil, "Verify VAA signatures against this guardian set, in the format <index>:<address>,<address>,... (may be repeated)")
+	dbVerifyEthRPC = verifierFlags.String("ethRPC", "", "Verify VAA signatures against the guardian sets read from the core contract using this Ethereum RPC")
+	dbVerifyCoreContract = verifierFlags.String("coreContract", "", "Address of the Ethereum core contract (required if --ethRPC is set)")
+	dbVerifyHistory = verifierFlags.Uint32("guardianSetHistory", 10, "Number of previous guardian sets to read from the core contract")
+	DbImportCmd.Flags().AddFlagSet(verifierFlags)
+	DbVerifyCmd.Flags().AddFlagSet(verifierFlags)
+
+	dbImportSkipVerify = DbImportCmd.Flags().Bool("skipVerify", false, "Import the VAAs without verifying their signatures")
+
+	DbCmd.AddCommand(DbExportCmd)
+	DbCmd.AddCommand(DbImportCmd)
+	DbCmd.AddCommand(DbVerifyCmd)
+}
+
+var DbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Export, import and verify the guardian database",
+	Long: `Move the signed VAAs, governor transfers and pending transfers and accountant pending transfers of a guardian
+between hosts. Exports are a versioned, line based JSON format with checksums over every record and the whole file.`,
+}
+
+var DbExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the guardian database",
+	Run:   runDbExport,
+	Args:  cobra.NoArgs,
+}
+
+var DbImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import an export into the guardian database",
+	Long: `Import an export into the guardian database. The whole export is verified before anything is written.
+VAA signatures are verified against the guardian sets given with --network, --guardianSet or --ethRPC, unless
+--skipVerify is set.`,
+	Run:  runDbImport,
+	Args: cobra.NoArgs,
+}
+
+var DbVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify the checksums and contents of an export",
+	Long: `Verify the checksums and contents of an export. If --network, --guardianSet or --ethRPC is set, the VAA
+signatures are verified as well.`,
+	Run:  runDbVerify,
+	Args: cobra.NoArgs,
+}
+
+func openDbForCmd() *db.Database {
+	if *dbDataDir == "" {
+		log.Fatal("--dataDir is required")
+	}
+	logger, err := zap.NewProduction()
+	if err != nil {
+		log.Fatalf("failed to create logger: %v", err)
+	}
+	return db.OpenDb(logger.With(zap.String("component", "badgerDb")), dbDataDir)
+}
+
+func runDbExport(cmd *cobra.Command, args []string) {
+	database := openDbForCmd()
+	defer database.Close()
+
+	var w io.Writer = cmd.OutOrStdout()
+	if *dbFile != "-" {
+		f, err := os.OpenFile(*dbFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			log.Fatalf("failed to create export file: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	summary, err := database.Export(w)
+	if err != nil {
+		log.Fatalf("failed to export database: %v", err)
+	}
+	log.Printf("Exported database: %s", summary)
+}
+
+func runDbImport(cmd *cobra.Command, args []string) {
+	if *dbFile == "-" {
+		// The export is read twice, once to verify it and once to import it.
+		log.Fatal("--file is required for import")
+	}
+
+	var verifyVAA func(*vaa.VAA) error
+	if !*dbImportSkipVerify {
+		verifier, err := dbVerifier(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		if verifier == nil {
+			log.Fatal("a guardian set is required to verify the VAAs, use --network, --guardianSet or --ethRPC (or --skipVerify)")
+		}
+		verifyVAA = verifier.Verify
+	}
+
+	// The VAAs are verified here, before anything is written, so they don't need to be verified again on import.
+	if _, err := readExportFile(*dbFile, verifyVAA); err != nil {
+		log.Fatalf("export is invalid, nothing was imported: %v", err)
+	}
+
+	database := openDbForCmd()
+	defer database.Close()
+
+	f, err := os.Open(*dbFile)
+	if err != nil {
+		log.Fatalf("failed to open export file: %v", err)
+	}
+	defer f.Close()
+
+	summary, err := database.Import(f, nil)
+	if err != nil {
+		log.Fatalf("failed to import database: %v", err)
+	}
+	log.Printf("Imported export %s", summary)
+}
+
+func runDbVerify(cmd *cobra.Command, args []string) {
+	verifier, err := dbVerifier(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var verifyVAA func(*vaa.VAA) error
+	if verifier != nil {
+		verifyVAA = verifier.Verify
+	}
+
+	summary, err := readExportFile(*dbFile, verifyVAA)
+	if err != nil {
+		log.Fatalf("export is invalid: %v", err)
+	}
+	if verifier == nil {
+		log.Printf("Export is valid (VAA signatures were not verified): %s", summary)
+	} else {
+		log.Printf("Export is valid: %s", summary)
+	}
+}
+
+// readExportFile reads and verifies an export from a file (or stdin).
+func readExportFile(path string, verifyVAA func(*vaa.VAA) error) (*db.ExportSummary, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open export file: %w", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	return db.ReadExport(r, func(rec *db.ExportRecord) error {
+		if rec.Type != db.ExportRecordVAA || verifyVAA == nil {
+			return nil
+		}
+		v, err := vaa.Unmarshal(rec.Value)
+		if err != nil {
+			return err
+		}
+		if err := verifyVAA(v); err != nil {
+			return fmt.Errorf("failed to verify VAA %s: %w", v.MessageID(), err)
+		}
+		return nil
+	})
+}
+
+// dbVerifier returns a verifier for the guardian sets given on the command line, or nil if none were given. Exports
+// contain historical VAAs, so the guardian sets are accepted regardless of their expiration time.
+func dbVerifier(ctx context.Context) (*vaa.Verifier, error) {
+	sets, err := parseDbGuardianSets(*dbVerifyGuardianSets)
+	if err != nil {
+		return nil, err
+	}
+
+	switch *dbVerifyNetwork {
+	case "":
+	case "mainnet":
+		sets = append(sets, sdk.KnownMainnetGuardianSet)
+	case "testnet":
+		sets = append(sets, sdk.KnownTestnetGuardianSet)
+	case "devnet":
+		sets = append(sets, sdk.KnownDevnetGuardianSet)
+	default:
+		return nil, fmt.Errorf("invalid network %q, must be mainnet, testnet or devnet", *dbVerifyNetwork)
+	}
+
+	if *dbVerifyEthRPC != "" {
+		if !common.IsHexAddress(*dbVerifyCoreContract) {
+			return nil, fmt.Errorf("--coreContract must be set to a valid address if --ethRPC is set")
+		}
+		ctx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		rpcSets, err := rpcverifier.FetchGuardianSets(ctx, *dbVerifyEthRPC, common.HexToAddress(*dbVerifyCoreContract), *dbVerifyHistory)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read guardian sets: %w", err)
+		}
+		sets = append(sets, rpcSets...)
+	}
+
+	if len(sets) == 0 {
+		return nil, nil
+	}
+
+	for i := range sets {
+		sets[i].ExpirationTime = time.Time{}
+	}
+
+	return vaa.NewVerifier(sets...)
+}
+
+// parseDbGuardianSets parses guardian sets in the format <index>:<address>,<address>,...
+func parseDbGuardianSets(args []string) ([]vaa.GuardianSet, error) {
+	sets := make([]vaa.GuardianSet, 0, len(args))
+	for _, arg := range args {
+		indexStr, addrs, found := strings.Cut(arg, ":")
+		if !found {
+			return nil, fmt.Errorf("invalid guardian set %q, must be <index>:<address>,<address>,...", arg)
+		}
+
+		index, err := strconv.ParseUint(indexStr, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid guardian set index %q: %w", indexStr, err)
+		}
+
+		gs := vaa.GuardianSet{Index: uint32(index)}
+		for _, addr := range strings.Split(addrs, ",") {
+			addr = strings.TrimSpace(addr)
+			if !common.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid guardian address %q", addr)
+			}
+			gs.Keys = append(gs.Keys, common.HexToAddress(addr))
+		}
+		sets = append(sets, gs)
+	}
+	return sets, nil
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/ibc"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
@@ -38,11 +39,13 @@ import (
 	"github.com/certusone/wormhole/node/pkg/devnet"
 	"github.com/certusone/wormhole/node/pkg/node"
 	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/recovery"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	promremotew "github.com/certusone/wormhole/node/pkg/telemetry/prom_remote_write"
 	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/spf13/cobra"
+	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 
@@ -55,6 +58,9 @@ var (
 	p2pBootstrap   *string
 	protectedPeers []string
 
+	p2pRateLimits  *string
+	p2pPeerScoring *bool
+
 	nodeKeyPath *string
 
 	adminSocketPath      *string
@@ -62,10 +68,31 @@ var (
 
 	dataDir *string
 
+	dbVaaIndexes *bool
+
+	vaaRetentionPolicyPath *string
+
+	recoveryEnabled           *bool
+	recoveryInterval          *time.Duration
+	recoveryPeers             []string
+	recoveryMaxPerInterval    *int
+	recoveryRequestsPerSecond *float64
+	recoveryLookback          *uint64
+
+	heightMonitorEnabled      *bool
+	heightMonitorInterval     *time.Duration
+	heightMonitorThreshold    *int64
+	heightMonitorThresholds   *string
+	heightMonitorMinGuardians *int
+
 	statusAddr *string
 
-	guardianKeyPath   *string
-	guardianSignerUri *string
+	guardianKeyPath                      *string
+	guardianSignerUri                    *string
+	guardianSignerFailoverUris           *[]string
+	guardianSignerHealthCheckInterval    *time.Duration
+	guardianSignerMaxSignaturesPerSecond *float64
+	guardianSignerSignatureBurst         *int
 
 	ethRPC      *string
 	ethContract *string
@@ -139,6 +166,7 @@ var (
 	accountantContract      *string
 	accountantWS            *string
 	accountantCheckEnabled  *bool
+	accountantShadowMode    *bool
 	accountantKeyPath       *string
 	accountantKeyPassPhrase *string
 
@@ -157,9 +185,11 @@ var (
 	suiRPC           *string
 	suiMoveEventType *string
 
-	solanaRPC          *string
-	solanaContract     *string
-	solanaShimContract *string
+	solanaRPC              *string
+	solanaBackupRPCs       *string
+	solanaContract         *string
+	solanaShimContract     *string
+	solanaBackfillLookback *uint64
 
 	pythnetContract *string
 	pythnetRPC      *string
@@ -259,6 +289,9 @@ var (
 	governorFlowCancelEnabled *bool
 	coinGeckoApiKey           *string
 
+	denylistEnabled *bool
+	denylistFile    *string
+
 	ccqEnabled           *bool
 	ccqAllowedRequesters *string
 	ccqP2pPort           *uint
@@ -285,6 +318,8 @@ func init() {
 	p2pPort = NodeCmd.Flags().Uint("port", p2p.DefaultPort, "P2P UDP listener port")
 	p2pBootstrap = NodeCmd.Flags().String("bootstrap", "", "P2P bootstrap peers (optional for mainnet or testnet, overrides default, required for unsafeDevMode)")
 	NodeCmd.Flags().StringSliceVarP(&protectedPeers, "protectedPeers", "", []string{}, "")
+	p2pRateLimits = NodeCmd.Flags().String("p2pRateLimits", "", "Per peer gossip rate limits overriding the defaults, as comma separated topic=messagesPerSecond:burst (topics: control, attestation, vaa, ccq_req)")
+	p2pPeerScoring = NodeCmd.Flags().Bool("p2pPeerScoring", false, "Enable gossip peer scoring, penalizing peers that send invalid messages")
 
 	statusAddr = NodeCmd.Flags().String("statusAddr", "[::]:6060", "Listen address for status server (disabled if blank)")
 
@@ -294,11 +329,32 @@ func init() {
 	publicGRPCSocketPath = NodeCmd.Flags().String("publicGRPCSocket", "", "Public gRPC service UNIX domain socket path")
 
 	dataDir = NodeCmd.Flags().String("dataDir", "", "Data directory")
+	dbVaaIndexes = NodeCmd.Flags().Bool("dbVaaIndexes", false, "Maintain secondary indexes of the stored VAAs (by digest, source transaction and timestamp). Existing VAAs are indexed on the first start.")
+	vaaRetentionPolicyPath = NodeCmd.Flags().String("vaaRetentionPolicy", "", "Path to a JSON file with the VAA retention policy. If set, VAAs are periodically deleted from the database according to the policy")
+
+	recoveryEnabled = NodeCmd.Flags().Bool("recoveryEnabled", false, "Periodically look for VAAs of the known emitters missing from the database and fetch them from peer guardians. Reobservation of the ones nobody has is only requested if this guardian observed them, the others need to be reobserved manually")
+	recoveryInterval = NodeCmd.Flags().Duration("recoveryInterval", recovery.DefaultInterval, "Interval between scans for missing VAAs")
+	NodeCmd.Flags().StringSliceVarP(&recoveryPeers, "recoveryPeers", "", sdk.PublicRPCEndpoints, "Public RPC endpoints of the guardians missing VAAs are fetched from")
+	recoveryMaxPerInterval = NodeCmd.Flags().Int("recoveryMaxPerInterval", recovery.DefaultMaxPerInterval, "Maximum number of missing VAAs handled per scan")
+	recoveryRequestsPerSecond = NodeCmd.Flags().Float64("recoveryRequestsPerSecond", recovery.DefaultRequestsPerSecond, "Maximum number of requests per second to the peer guardians")
+	recoveryLookback = NodeCmd.Flags().Uint64("recoveryLookback", recovery.DefaultLookback, "Number of sequences, counted back from the latest stored VAA of an emitter, in which missing VAAs are recovered")
+
+	heightMonitorEnabled = NodeCmd.Flags().Bool("heightMonitorEnabled", false, "Periodically compare the block heights of our watchers to the median of the heights reported by the other guardians and raise readiness warnings when they deviate")
+	heightMonitorInterval = NodeCmd.Flags().Duration("heightMonitorInterval", heightmonitor.DefaultInterval, "Interval between comparisons of our block heights to the network")
+	heightMonitorThreshold = NodeCmd.Flags().Int64("heightMonitorThreshold", heightmonitor.DefaultThreshold, "Number of blocks our height may deviate from the network median before a warning is raised")
+	heightMonitorThresholds = NodeCmd.Flags().String("heightMonitorThresholds", "", "Per chain thresholds overriding heightMonitorThreshold, as comma separated chain=blocks (e.g. solana=500,ethereum=10)")
+	heightMonitorMinGuardians = NodeCmd.Flags().Int("heightMonitorMinGuardians", heightmonitor.DefaultMinGuardians, "Number of other guardians that must report a height for a chain to compare against")
 
 	guardianKeyPath = NodeCmd.Flags().String("guardianKey", "", "Path to guardian key")
 	guardianSignerUri = NodeCmd.Flags().String("guardianSignerUri", "", "Guardian signer URI")
+	guardianSignerFailoverUris = NodeCmd.Flags().StringArray("guardianSignerFailoverUri", nil, "Guardian signer URI of the same key to fail over to if the guardian signer fails, in order of preference (may be repeated)")
+	guardianSignerHealthCheckInterval = NodeCmd.Flags().Duration("guardianSignerHealthCheckInterval", time.Minute, "Interval between health checks of the guardian signers, which sign a canary digest (0 to disable)")
+	guardianSignerMaxSignaturesPerSecond = NodeCmd.Flags().Float64("guardianSignerMaxSignaturesPerSecond", 0, "Maximum number of signatures per second made by the guardian signer, signing requests in excess of it are delayed (0 to disable)")
+	guardianSignerSignatureBurst = NodeCmd.Flags().Int("guardianSignerSignatureBurst", 1000, "Number of signatures that may be made at once in excess of --guardianSignerMaxSignaturesPerSecond")
 	solanaContract = NodeCmd.Flags().String("solanaContract", "", "Address of the Solana program (required if solanaRpc is specified)")
 	solanaShimContract = NodeCmd.Flags().String("solanaShimContract", "", "Address of the Solana shim program")
+	solanaBackupRPCs = NodeCmd.Flags().String("solanaBackupRPCs", "", "Comma separated list of Solana RPC URLs to fail over to when solanaRPC is unhealthy")
+	solanaBackfillLookback = NodeCmd.Flags().Uint64("solanaBackfillLookback", solana.DefaultBackfillLookback, "Maximum number of slots backfilled by the Solana watcher since the last processed slot after a restart or an outage (0 to disable)")
 
 	ethRPC = node.RegisterFlagWithValidationOrFail(NodeCmd, "ethRPC", "Ethereum RPC URL", "ws://eth-devnet:8545", []string{"ws", "wss"})
 	ethContract = NodeCmd.Flags().String("ethContract", "", "Ethereum contract address")
@@ -374,6 +430,7 @@ func init() {
 	accountantKeyPath = NodeCmd.Flags().String("accountantKeyPath", "", "path to accountant private key for signing transactions")
 	accountantKeyPassPhrase = NodeCmd.Flags().String("accountantKeyPassPhrase", "", "pass phrase used to unarmor the accountant key file")
 	accountantCheckEnabled = NodeCmd.Flags().Bool("accountantCheckEnabled", false, "Should accountant be enforced on transfers")
+	accountantShadowMode = NodeCmd.Flags().Bool("accountantShadowMode", false, "Predict the accountant decisions locally and record divergences from the contract decisions")
 
 	accountantNttContract = NodeCmd.Flags().String("accountantNttContract", "", "Address of the NTT accountant smart contract on wormchain")
 	accountantNttKeyPath = NodeCmd.Flags().String("accountantNttKeyPath", "", "path to NTT accountant private key for signing transactions")
@@ -490,6 +547,9 @@ func init() {
 	governorFlowCancelEnabled = NodeCmd.Flags().Bool("governorFlowCancelEnabled", false, "Enable flow cancel on the governor")
 	coinGeckoApiKey = NodeCmd.Flags().String("coinGeckoApiKey", "", "CoinGecko Pro API key. If no API key is provided, CoinGecko requests may be throttled or blocked.")
 
+	denylistEnabled = NodeCmd.Flags().Bool("denylistEnabled", false, "Hold the messages of denylisted emitters and the token bridge transfers of denylisted tokens or to denylisted recipients")
+	denylistFile = NodeCmd.Flags().String("denylistFile", "", "Path to a JSON file with denylist entries, which is reloaded when it changes (optional)")
+
 	ccqEnabled = NodeCmd.Flags().Bool("ccqEnabled", false, "Enable cross chain query support")
 	ccqAllowedRequesters = NodeCmd.Flags().String("ccqAllowedRequesters", "", "Comma separated list of signers allowed to submit cross chain queries")
 	ccqP2pPort = NodeCmd.Flags().Uint("ccqP2pPort", 8996, "CCQ P2P UDP listener port")
@@ -727,12 +787,23 @@ func runNode(cmd *cobra.Command, args []string) {
 	rootCtx, rootCtxCancel = context.WithCancel(context.Background())
 	defer rootCtxCancel()
 
-	// Create the Guardian Signer
-	guardianSigner, err := guardiansigner.NewGuardianSignerFromUri(rootCtx, *guardianSignerUri, env == common.UnsafeDevNet)
+	// Create the Guardian Signer, which fails over to the failover signers and enforces the signature budget.
+	supervisedSigner, err := guardiansigner.NewSupervisedSigner(rootCtx,
+		append([]string{*guardianSignerUri}, *guardianSignerFailoverUris...),
+		env == common.UnsafeDevNet,
+		guardiansigner.SupervisedSignerConfig{
+			HealthCheckInterval:    *guardianSignerHealthCheckInterval,
+			MaxSignaturesPerSecond: *guardianSignerMaxSignaturesPerSecond,
+			SignatureBurst:         *guardianSignerSignatureBurst,
+		})
 	if err != nil {
 		logger.Fatal("failed to create a new guardian signer", zap.Error(err))
 	}
 
+	// Wrap the guardian signer in a benchmark signer, which will record the
+	// time taken to sign and verify messages.
+	guardianSigner := guardiansigner.BenchmarkWrappedSigner(supervisedSigner)
+
 	logger.Info("Created the guardian signer", zap.String(
 		"address", ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(rootCtx)).String()))
 
@@ -866,6 +937,20 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("--solanaShimContract is not currently supported in mainnet")
 	}
 
+	var solanaBackupRPCList []string
+	if *solanaBackupRPCs != "" {
+		if *solanaRPC == "" {
+			logger.Fatal("--solanaBackupRPCs may only be specified if --solanaRPC is specified")
+		}
+		for _, url := range strings.Split(*solanaBackupRPCs, ",") {
+			url = strings.TrimSpace(url)
+			if !node.ValidateURL(url, []string{"http", "https"}) {
+				logger.Fatal("Invalid URL in --solanaBackupRPCs, expected http or https", zap.String("url", url))
+			}
+			solanaBackupRPCList = append(solanaBackupRPCList, url)
+		}
+	}
+
 	if !argsConsistent([]string{*pythnetContract, *pythnetRPC, *pythnetWS}) {
 		logger.Fatal("Either --pythnetContract, --pythnetRPC and --pythnetWS must all be set or all unset")
 	}
@@ -922,6 +1007,10 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("If coinGeckoApiKey is set, then chainGovernorEnabled must be set")
 	}
 
+	if !*denylistEnabled && *denylistFile != "" {
+		logger.Fatal("If denylistFile is set, then denylistEnabled must be set")
+	}
+
 	var publicRpcLogDetail common.GrpcLogDetail
 	switch *publicRpcLogDetailStr {
 	case "none":
@@ -960,6 +1049,7 @@ func runNode(cmd *cobra.Command, args []string) {
 	// NOTE: Please keep these in numerical order by chain ID.
 	rpcMap := make(map[string]string)
 	rpcMap["solanaRPC"] = *solanaRPC
+	rpcMap["solanaBackupRPCs"] = *solanaBackupRPCs
 	rpcMap["ethRPC"] = *ethRPC
 	rpcMap["terraWS"] = *terraWS
 	rpcMap["terraLCD"] = *terraLCD
@@ -1051,10 +1141,60 @@ func runNode(cmd *cobra.Command, args []string) {
 	// Redirect ipfs logs to plain zap
 	ipfslog.SetPrimaryCore(logger.Core())
 
+	var vaaRetentionPolicy *db.RetentionPolicy
+	if *vaaRetentionPolicyPath != "" {
+		var err error
+		vaaRetentionPolicy, err = db.LoadRetentionPolicy(*vaaRetentionPolicyPath)
+		if err != nil {
+			logger.Fatal("failed to load VAA retention policy", zap.Error(err))
+		}
+	}
+
+	peerProtection := p2p.DefaultPeerProtectionParams()
+	peerProtection.PeerScoring = *p2pPeerScoring
+	if rateLimits, err := p2p.ParseTopicRateLimits(*p2pRateLimits, peerProtection.RateLimits); err != nil {
+		logger.Fatal("invalid p2pRateLimits", zap.Error(err))
+	} else {
+		peerProtection.RateLimits = rateLimits
+	}
+
+	var recoveryConfig *recovery.Config
+	if *recoveryEnabled {
+		recoveryConfig = &recovery.Config{
+			Interval:          *recoveryInterval,
+			Peers:             recoveryPeers,
+			MaxPerInterval:    *recoveryMaxPerInterval,
+			RequestsPerSecond: *recoveryRequestsPerSecond,
+			Lookback:          *recoveryLookback,
+		}
+	}
+
+	var heightMonitorConfig *heightmonitor.Config
+	if *heightMonitorEnabled {
+		thresholds, err := heightmonitor.ParseThresholds(*heightMonitorThresholds)
+		if err != nil {
+			logger.Fatal("invalid heightMonitorThresholds", zap.Error(err))
+		}
+		heightMonitorConfig = &heightmonitor.Config{
+			Interval:         *heightMonitorInterval,
+			DefaultThreshold: *heightMonitorThreshold,
+			Thresholds:       thresholds,
+			MinGuardians:     *heightMonitorMinGuardians,
+		}
+	}
+
 	// Database
 	db := db.OpenDb(logger.With(zap.String("component", "badgerDb")), dataDir)
 	defer db.Close()
 
+	if *dbVaaIndexes {
+		if err := db.EnableVAAIndexes(logger.With(zap.String("component", "badgerDb"))); err != nil {
+			logger.Fatal("failed to enable VAA indexes", zap.Error(err))
+		}
+	} else if err := db.DisableVAAIndexes(logger.With(zap.String("component", "badgerDb"))); err != nil {
+		logger.Fatal("failed to disable VAA indexes", zap.Error(err))
+	}
+
 	wormchainId := "wormchain"
 	if env == common.TestNet {
 		wormchainId = "wormchain-testnet-0"
@@ -1632,28 +1772,34 @@ func runNode(cmd *cobra.Command, args []string) {
 	if shouldStart(solanaRPC) {
 		// confirmed watcher
 		wc := &solana.WatcherConfig{
-			NetworkID:     "solana-confirmed",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ShimContract:  *solanaShimContract,
-			ReceiveObsReq: false,
-			Commitment:    rpc.CommitmentConfirmed,
+			NetworkID:        "solana-confirmed",
+			ChainID:          vaa.ChainIDSolana,
+			Rpc:              *solanaRPC,
+			BackupRpcs:       solanaBackupRPCList,
+			Websocket:        "",
+			Contract:         *solanaContract,
+			ShimContract:     *solanaShimContract,
+			ReceiveObsReq:    false,
+			Commitment:       rpc.CommitmentConfirmed,
+			CheckpointDB:     db,
+			BackfillLookback: *solanaBackfillLookback,
 		}
 
 		watcherConfigs = append(watcherConfigs, wc)
 
 		// finalized watcher
 		wc = &solana.WatcherConfig{
-			NetworkID:     "solana-finalized",
-			ChainID:       vaa.ChainIDSolana,
-			Rpc:           *solanaRPC,
-			Websocket:     "",
-			Contract:      *solanaContract,
-			ShimContract:  *solanaShimContract,
-			ReceiveObsReq: true,
-			Commitment:    rpc.CommitmentFinalized,
+			NetworkID:        "solana-finalized",
+			ChainID:          vaa.ChainIDSolana,
+			Rpc:              *solanaRPC,
+			BackupRpcs:       solanaBackupRPCList,
+			Websocket:        "",
+			Contract:         *solanaContract,
+			ShimContract:     *solanaShimContract,
+			ReceiveObsReq:    true,
+			Commitment:       rpc.CommitmentFinalized,
+			CheckpointDB:     db,
+			BackfillLookback: *solanaBackfillLookback,
 		}
 		watcherConfigs = append(watcherConfigs, wc)
 	}
@@ -1776,14 +1922,19 @@ func runNode(cmd *cobra.Command, args []string) {
 	guardianOptions := []*node.GuardianOption{
 		node.GuardianOptionDatabase(db),
 		node.GuardianOptionWatchers(watcherConfigs, ibcWatcherConfig),
-		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, accountantWormchainConn, *accountantNttContract, accountantNttWormchainConn),
+		node.GuardianOptionAccountant(*accountantWS, *accountantContract, *accountantCheckEnabled, *accountantShadowMode, accountantWormchainConn, *accountantNttContract, accountantNttWormchainConn),
 		node.GuardianOptionGovernor(*chainGovernorEnabled, *governorFlowCancelEnabled, *coinGeckoApiKey),
 		node.GuardianOptionGatewayRelayer(*gatewayRelayerContract, gatewayRelayerWormchainConn),
 		node.GuardianOptionQueryHandler(*ccqEnabled, *ccqAllowedRequesters),
+		node.GuardianOptionVAARetention(vaaRetentionPolicy),
+		node.GuardianOptionDenylist(*denylistEnabled, *denylistFile),
+		node.GuardianOptionMissingMessageRecovery(recoveryConfig),
+		node.GuardianOptionHeightMonitor(heightMonitorConfig),
 		node.GuardianOptionAdminService(*adminSocketPath, ethRPC, ethContract, rpcMap),
-		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *subscribeToVAAs, *disableHeartbeatVerify, *p2pPort, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, *gossipAdvertiseAddress, ibc.GetFeatures, protectedPeers, ccqProtectedPeers),
+		node.GuardianOptionP2P(p2pKey, *p2pNetworkID, *p2pBootstrap, *nodeName, *subscribeToVAAs, *disableHeartbeatVerify, *p2pPort, *ccqP2pBootstrap, *ccqP2pPort, *ccqAllowedPeers, *gossipAdvertiseAddress, ibc.GetFeatures, protectedPeers, ccqProtectedPeers, peerProtection),
 		node.GuardianOptionStatusServer(*statusAddr),
 		node.GuardianOptionProcessor(*p2pNetworkID),
+		node.GuardianOptionSignerHealthCheck(supervisedSigner),
 	}
 
 	if shouldStart(publicGRPCSocketPath) {
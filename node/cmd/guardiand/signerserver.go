@@ -0,0 +1,160 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	signerServerSignerUri          *string
+	signerServerListenAddr         *string
+	signerServerTLSCert            *string
+	signerServerTLSKey             *string
+	signerServerClientCA           *string
+	signerServerAllowedDigestTypes *[]string
+	signerServerRateLimit          *float64
+	signerServerRateBurst          *int
+	signerServerAuditLog           *string
+	signerServerLogLevel           *string
+	signerServerUnsafeDevMode      *bool
+)
+
+func init() {
+	// By default, every digest type the guardian signs is allowed, but digests without a type are not.
+	defaultDigestTypes := []string{}
+	for _, digestType := range guardiansigner.KnownDigestTypes {
+		if digestType != guardiansigner.DigestTypeUnknown {
+			defaultDigestTypes = append(defaultDigestTypes, string(digestType))
+		}
+	}
+
+	signerServerSignerUri = SignerServerCmd.Flags().String("signerUri", "", "Guardian signer URI of the key to serve (e.g. file://<path> or amazonkms://<arn>)")
+	signerServerListenAddr = SignerServerCmd.Flags().String("listenAddr", "127.0.0.1:7090", "Listen address for the remote signer gRPC interface")
+	signerServerTLSCert = SignerServerCmd.Flags().String("tlsCert", "", "Path to the TLS certificate of the remote signer")
+	signerServerTLSKey = SignerServerCmd.Flags().String("tlsKey", "", "Path to the TLS key of the remote signer")
+	signerServerClientCA = SignerServerCmd.Flags().String("clientCA", "", "Path to the CA certificate that guardian client certificates must be signed by")
+	signerServerAllowedDigestTypes = SignerServerCmd.Flags().StringSlice("allowedDigestTypes", defaultDigestTypes, fmt.Sprintf("Digest types that may be signed (known types: %s)", strings.Join(digestTypeNames(), ", ")))
+	signerServerRateLimit = SignerServerCmd.Flags().Float64("rateLimit", 0, "Maximum number of signatures per second (0 to disable)")
+	signerServerRateBurst = SignerServerCmd.Flags().Int("rateBurst", 100, "Number of signatures that may be made at once in excess of --rateLimit")
+	signerServerAuditLog = SignerServerCmd.Flags().String("auditLog", "", "Path to the audit log, which records every signing request as a line of JSON")
+	signerServerLogLevel = SignerServerCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
+	signerServerUnsafeDevMode = SignerServerCmd.Flags().Bool("unsafeDevMode", false, "Launch the signer in unsafe, deterministic devnet mode")
+}
+
+var SignerServerCmd = &cobra.Command{
+	Use:   "signer-server",
+	Short: "Run a remote signer for a guardian node",
+	Long: `Run a remote signer, which holds the guardian key on behalf of a guardian node that uses a remote:// guardian
+signer URI. The remote signer wraps any other guardian signer, only accepts clients with a certificate signed by
+--clientCA, enforces the allowed digest types and rate limit, and writes every signing request to the audit log.
+
+The remote signer only sees digests and cannot verify the digest type a client reports, so the allowed digest
+types only guard against misconfigured clients. Against a compromised guardian node, only the rate limit and the
+audit log are effective.`,
+	Run:  runSignerServer,
+	Args: cobra.NoArgs,
+}
+
+func digestTypeNames() []string {
+	names := make([]string, 0, len(guardiansigner.KnownDigestTypes))
+	for _, digestType := range guardiansigner.KnownDigestTypes {
+		names = append(names, string(digestType))
+	}
+	return names
+}
+
+func runSignerServer(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+
+	lvl, err := ipfslog.LevelFromString(*signerServerLogLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+
+	logger := ipfslog.Logger("wormhole-signer-server").Desugar()
+	ipfslog.SetAllLoggers(lvl)
+
+	if *signerServerSignerUri == "" {
+		logger.Fatal("Please specify --signerUri")
+	}
+	if *signerServerTLSCert == "" || *signerServerTLSKey == "" || *signerServerClientCA == "" {
+		logger.Fatal("Please specify --tlsCert, --tlsKey and --clientCA")
+	}
+	if *signerServerAuditLog == "" {
+		logger.Fatal("Please specify --auditLog")
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	signer, err := guardiansigner.NewGuardianSignerFromUri(ctx, *signerServerSignerUri, *signerServerUnsafeDevMode)
+	if err != nil {
+		logger.Fatal("failed to create guardian signer", zap.Error(err))
+	}
+
+	policy := guardiansigner.RemoteSignerPolicy{
+		RateLimit: *signerServerRateLimit,
+		RateBurst: *signerServerRateBurst,
+	}
+	for _, digestType := range *signerServerAllowedDigestTypes {
+		policy.AllowedDigestTypes = append(policy.AllowedDigestTypes, guardiansigner.DigestType(digestType))
+	}
+
+	auditLog, err := os.OpenFile(*signerServerAuditLog, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		logger.Fatal("failed to open audit log", zap.Error(err))
+	}
+	defer auditLog.Close()
+
+	server, err := guardiansigner.NewRemoteSignerServer(logger, signer, policy, auditLog)
+	if err != nil {
+		logger.Fatal("invalid remote signer policy", zap.Error(err))
+	}
+
+	tlsConfig, err := guardiansigner.NewRemoteSignerServerTLSConfig(*signerServerTLSCert, *signerServerTLSKey, *signerServerClientCA)
+	if err != nil {
+		logger.Fatal("failed to load TLS configuration", zap.Error(err))
+	}
+
+	listener, err := net.Listen("tcp", *signerServerListenAddr)
+	if err != nil {
+		logger.Fatal("failed to listen", zap.Error(err))
+	}
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	signerv1.RegisterSignerServiceServer(grpcServer, server)
+
+	go func() {
+		<-ctx.Done()
+		logger.Info("shutting down remote signer")
+		grpcServer.GracefulStop()
+	}()
+
+	publicKey := signer.PublicKey(ctx)
+	logger.Info("remote signer listening",
+		zap.String("listenAddr", listener.Addr().String()),
+		zap.Stringer("guardianAddress", ethcrypto.PubkeyToAddress(publicKey)),
+		zap.Strings("allowedDigestTypes", *signerServerAllowedDigestTypes),
+		zap.Float64("rateLimit", *signerServerRateLimit),
+	)
+
+	if err := grpcServer.Serve(listener); err != nil {
+		logger.Fatal("remote signer failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,190 @@
+package guardiand
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"log"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner/threshold"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+)
+
+var (
+	thresholdInitIndex   *uint32
+	thresholdInitShare   *string
+	thresholdInitTimeout *time.Duration
+
+	thresholdKeygenConfig *string
+	thresholdKeygenOut    *string
+
+	thresholdParticipantShare    *string
+	thresholdParticipantConfig   *string
+	thresholdParticipantListen   *string
+	thresholdParticipantTLSCert  *string
+	thresholdParticipantTLSKey   *string
+	thresholdParticipantClientCA *string
+)
+
+func init() {
+	thresholdInitIndex = ThresholdInitCmd.Flags().Uint32("index", 0, "Index of the participant, starting at 1")
+	thresholdInitShare = ThresholdInitCmd.Flags().String("share", "", "Path to write the share file of the participant to")
+	thresholdInitTimeout = ThresholdInitCmd.Flags().Duration("timeout", 30*time.Minute, "Maximum time to spend generating the safe primes of the participant")
+
+	thresholdKeygenConfig = ThresholdKeygenCmd.Flags().String("config", "", "Path to the threshold signer config listing the participants")
+	thresholdKeygenOut = ThresholdKeygenCmd.Flags().String("out", "", "Path to write the threshold signer config with the generated public key to")
+
+	thresholdParticipantShare = ThresholdParticipantCmd.Flags().String("share", "", "Path to the share file of the participant")
+	thresholdParticipantConfig = ThresholdParticipantCmd.Flags().String("config", "", "Path to the threshold signer config")
+	thresholdParticipantListen = ThresholdParticipantCmd.Flags().String("listen", "", "Address to listen on, either a UNIX socket (unix:///path/to/socket) or host:port")
+	thresholdParticipantTLSCert = ThresholdParticipantCmd.Flags().String("tlsCert", "", "TLS certificate of the participant, required for host:port addresses")
+	thresholdParticipantTLSKey = ThresholdParticipantCmd.Flags().String("tlsKey", "", "TLS key of the participant, required for host:port addresses")
+	thresholdParticipantClientCA = ThresholdParticipantCmd.Flags().String("clientCA", "", "CA that the certificates of the guardian node are verified against, required for host:port addresses")
+
+	ThresholdSignerCmd.AddCommand(ThresholdInitCmd)
+	ThresholdSignerCmd.AddCommand(ThresholdKeygenCmd)
+	ThresholdSignerCmd.AddCommand(ThresholdParticipantCmd)
+}
+
+var ThresholdSignerCmd = &cobra.Command{
+	Use:   "threshold-signer",
+	Short: "Create and run t-of-n threshold signers for the guardian key",
+	Long: `Create and run t-of-n threshold signers for the guardian key. Every participant initializes its share file
+with init, and is run with participant. The key is then generated among the participants by keygen, so that no
+machine ever holds the guardian key. The guardian node signs with the threshold://<config> guardian signer, which
+runs a signing session among threshold participants.`,
+}
+
+var ThresholdInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Initialize the share file of a participant of a threshold signer",
+	Long: `Initialize the share file of a participant of a threshold signer. This generates the identity keys of the
+participant, which are printed for the threshold signer config, and its Paillier key and range proof parameters,
+which can take several minutes.`,
+	Run:  runThresholdInit,
+	Args: cobra.NoArgs,
+}
+
+var ThresholdKeygenCmd = &cobra.Command{
+	Use:   "keygen",
+	Short: "Run the key generation ceremony of a threshold signer",
+	Long: `Run the key generation ceremony of a threshold signer. The participants of the config, which must all be
+running, generate the guardian key together and store their shares of it. The config is written to --out with the
+public key of the generated key, for the guardian node and the participants. A new key requires a guardian set
+upgrade.`,
+	Run:  runThresholdKeygen,
+	Args: cobra.NoArgs,
+}
+
+var ThresholdParticipantCmd = &cobra.Command{
+	Use:   "participant",
+	Short: "Run a participant of a threshold signer",
+	Run:   runThresholdParticipant,
+	Args:  cobra.NoArgs,
+}
+
+func runThresholdInit(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	if *thresholdInitIndex == 0 || *thresholdInitShare == "" {
+		log.Fatal("--index and --share are required")
+	}
+
+	log.Printf("Generating the share of participant %d, this can take several minutes", *thresholdInitIndex)
+	share, err := threshold.NewShare(*thresholdInitIndex, *thresholdInitTimeout)
+	if err != nil {
+		log.Fatalf("failed to generate share: %v", err)
+	}
+
+	if err := share.SaveShare(*thresholdInitShare); err != nil {
+		log.Fatalf("failed to write share: %v", err)
+	}
+
+	identity := share.Identity.Public()
+	log.Printf("Wrote share %d to %s", share.Index, *thresholdInitShare)
+	log.Printf("Signing key: %s", hex.EncodeToString(identity.SigningKey))
+	log.Printf("Encryption key: %s", hex.EncodeToString(identity.EncryptionKey[:]))
+}
+
+func runThresholdKeygen(cmd *cobra.Command, args []string) {
+	if *thresholdKeygenConfig == "" || *thresholdKeygenOut == "" {
+		log.Fatal("--config and --out are required")
+	}
+
+	config, err := threshold.LoadConfig(*thresholdKeygenConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if config.PublicKey != "" {
+		log.Fatal("the config already has a public key")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), threshold.KeygenTimeout)
+	defer cancel()
+
+	participants := make(map[uint32]signerv1.ThresholdSignerServiceClient, len(config.Participants))
+	for _, p := range config.Participants {
+		conn, err := config.Dial(ctx, p)
+		if err != nil {
+			log.Fatalf("failed to connect to participant %d: %v", p.Index, err)
+		}
+		defer conn.Close()
+		participants[p.Index] = signerv1.NewThresholdSignerServiceClient(conn)
+	}
+
+	publicKey, err := threshold.Keygen(ctx, participants)
+	if err != nil {
+		log.Fatalf("key generation failed: %v", err)
+	}
+
+	config.PublicKey = hex.EncodeToString(ethcrypto.FromECDSAPub(publicKey))
+	if err := config.SaveConfig(*thresholdKeygenOut); err != nil {
+		log.Fatalf("failed to write threshold signer config: %v", err)
+	}
+
+	log.Printf("Wrote threshold signer config to %s, use --guardianSignerUri=threshold://%s", *thresholdKeygenOut, *thresholdKeygenOut)
+	log.Printf("Guardian address: %s", ethcrypto.PubkeyToAddress(*publicKey).Hex())
+}
+
+func runThresholdParticipant(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	if *thresholdParticipantShare == "" || *thresholdParticipantConfig == "" || *thresholdParticipantListen == "" {
+		log.Fatal("--share, --config and --listen are required")
+	}
+
+	config, err := threshold.LoadConfig(*thresholdParticipantConfig)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	participant, err := threshold.NewParticipant(*thresholdParticipantShare, config)
+	if err != nil {
+		log.Fatalf("failed to create participant: %v", err)
+	}
+
+	var tlsConfig *tls.Config
+	if *thresholdParticipantTLSCert != "" || *thresholdParticipantTLSKey != "" || *thresholdParticipantClientCA != "" {
+		tlsConfig, err = threshold.NewServerTLSConfig(*thresholdParticipantTLSCert, *thresholdParticipantTLSKey, *thresholdParticipantClientCA)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	log.Printf("Participant of a %d-of-%d threshold signer listening on %s", config.Threshold, len(config.Participants), *thresholdParticipantListen)
+
+	if err := threshold.Serve(ctx, participant, *thresholdParticipantListen, tlsConfig); err != nil {
+		log.Fatalf("participant failed: %v", err)
+	}
+}
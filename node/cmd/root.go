@@ -55,6 +55,9 @@ func init() {
 	rootCmd.AddCommand(guardiand.KeygenCmd)
 	rootCmd.AddCommand(guardiand.AdminCmd)
 	rootCmd.AddCommand(guardiand.TemplateCmd)
+	rootCmd.AddCommand(guardiand.DbCmd)
+	rootCmd.AddCommand(guardiand.SignerServerCmd)
+	rootCmd.AddCommand(guardiand.ThresholdSignerCmd)
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(debug.DebugCmd)
 }
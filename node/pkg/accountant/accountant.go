@@ -98,6 +98,9 @@ type Accountant struct {
 	nttDirectEmitters validEmitters
 	nttArEmitters     validEmitters
 	nttSubChan        chan *common.MessagePublication
+
+	// shadow is only set if shadow mode is enabled.
+	shadow *shadowState
 }
 
 // On startup, there can be a large number of re-submission requests.
@@ -118,6 +121,7 @@ func NewAccountant(
 	wsUrl string, // the URL of the wormchain websocket interface
 	wormchainConn AccountantWormchainConn, // used for communicating with the smart contract
 	enforceFlag bool, // whether or not accountant should be enforced
+	shadowMode bool, // whether or not to predict the contract decisions and record divergences
 	nttContract string, // the address of the NTT smart contract on wormchain
 	nttWormchainConn AccountantWormchainConn, // used for communicating with the NTT smart contract
 	guardianSigner guardiansigner.GuardianSigner, // the guardian signer used for signing observation requests
@@ -125,6 +129,11 @@ func NewAccountant(
 	msgChan chan<- *common.MessagePublication, // the channel where transfers received by the accountant runnable should be published
 	env common.Environment, // Controls the set of token bridges to be monitored
 ) *Accountant {
+	var shadow *shadowState
+	if shadowMode {
+		shadow = newShadowState()
+	}
+
 	return &Accountant{
 		ctx:              ctx,
 		logger:           logger.With(zap.String("component", "gacct")),
@@ -148,12 +157,14 @@ func NewAccountant(
 		nttDirectEmitters: make(validEmitters),
 		nttArEmitters:     make(validEmitters),
 		nttSubChan:        make(chan *common.MessagePublication, subChanSize),
+
+		shadow: shadow,
 	}
 }
 
 // Start initializes the accountant and starts the worker and watcher runnables.
 func (acct *Accountant) Start(ctx context.Context) error {
-	acct.logger.Debug("entering Start", zap.Bool("enforceFlag", acct.enforceFlag), zap.Bool("baseEnabled", acct.baseEnabled()), zap.Bool("nttEnabled", acct.nttEnabled()))
+	acct.logger.Debug("entering Start", zap.Bool("enforceFlag", acct.enforceFlag), zap.Bool("shadowMode", acct.shadow != nil), zap.Bool("baseEnabled", acct.baseEnabled()), zap.Bool("nttEnabled", acct.nttEnabled()))
 	acct.pendingTransfersLock.Lock()
 	defer acct.pendingTransfersLock.Unlock()
 
@@ -199,6 +210,19 @@ func (acct *Accountant) Start(ctx context.Context) error {
 		return fmt.Errorf("failed to load pending transfers from the db: %w", err)
 	}
 
+	if acct.shadow != nil {
+		if acct.env == common.AccountantMock {
+			// We're not in a runnable context, so we can't use supervisor.
+			go func() {
+				_ = acct.shadowWorker(ctx)
+			}()
+		} else if acct.env != common.GoTest {
+			if err := supervisor.Run(ctx, "acctshadow", common.WrapWithScissors(acct.shadowWorker, "acctshadow")); err != nil {
+				return fmt.Errorf("failed to start shadow worker: %w", err)
+			}
+		}
+	}
+
 	// Start the watcher to listen to transfer events from the smart contract.
 	if acct.baseEnabled() {
 		if acct.env == common.AccountantMock {
@@ -352,6 +376,42 @@ func (acct *Accountant) SubmitObservation(msg *common.MessagePublication) (bool,
 	return !enforceFlag, nil
 }
 
+// PendingTransferStatus describes a transfer the accountant is holding.
+type PendingTransferStatus struct {
+	MsgId         string
+	Digest        string
+	IsNTT         bool
+	Enforced      bool
+	SubmitPending bool
+	UpdTime       time.Time
+}
+
+// GetPendingTransferStatus returns the status of the pending transfer with the given message ID, or nil if the
+// accountant is not holding it.
+func (acct *Accountant) GetPendingTransferStatus(msgId string) *PendingTransferStatus {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	pe, exists := acct.pendingTransfers[msgId]
+	if !exists {
+		return nil
+	}
+
+	return pe.status()
+}
+
+// status returns the PendingTransferStatus of the pending transfer. It grabs the state lock.
+func (pe *pendingEntry) status() *PendingTransferStatus {
+	return &PendingTransferStatus{
+		MsgId:         pe.msgId,
+		Digest:        pe.digest,
+		IsNTT:         pe.isNTT,
+		Enforced:      pe.enforceFlag,
+		SubmitPending: pe.submitPending(),
+		UpdTime:       pe.updTime(),
+	}
+}
+
 // publishTransferAlreadyLocked publishes a pending transfer to the accountant channel and deletes it from the pending map. It assumes the caller holds the lock.
 func (acct *Accountant) publishTransferAlreadyLocked(pe *pendingEntry) {
 	if pe.enforceFlag {
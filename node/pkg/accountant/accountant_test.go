@@ -124,6 +124,7 @@ func newAccountantForTest(
 		"none",       // accountantWS
 		wormchainConn,
 		accountantCheckEnabled,
+		false,
 		"",
 		nil,
 		guardianSigner,
@@ -57,4 +57,19 @@ var (
 			Name: "global_accountant_audit_errors_total",
 			Help: "Total number of audit errors detected by accountant",
 		})
+	shadowPredictions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_predictions_total",
+			Help: "Total number of contract decisions predicted by the accountant shadow mode, by prediction (approve, reject or unknown)",
+		}, []string{"accountant", "prediction"})
+	shadowAgreements = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_agreements_total",
+			Help: "Total number of contract decisions that matched the prediction of the accountant shadow mode",
+		}, []string{"accountant"})
+	shadowDivergences = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "global_accountant_shadow_divergences_total",
+			Help: "Total number of contract decisions that diverged from the prediction of the accountant shadow mode, by kind (unexpected_commit or unexpected_reject)",
+		}, []string{"accountant", "kind"})
 )
@@ -0,0 +1,276 @@
+// This code supports the admin commands used to inspect the accountant. Unlike the audit, the reconciliation report
+// only compares the pending transfers against the state of the contracts, it does not act on the disagreements.
+
+package accountant
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// The kinds of disagreements listed in a reconciliation report.
+const (
+	// DiscrepancyMissingObservation means the contract reports our observation of a pending transfer as missing.
+	DiscrepancyMissingObservation = "missing_observation"
+
+	// DiscrepancyUnknownObservation means the contract reports an observation as missing that is not pending locally.
+	DiscrepancyUnknownObservation = "unknown_observation"
+
+	// DiscrepancyUnknownTransfer means the contract does not know about a pending transfer.
+	DiscrepancyUnknownTransfer = "unknown_transfer"
+
+	// DiscrepancyCommitted means the contract committed a transfer that is still pending locally.
+	DiscrepancyCommitted = "committed"
+
+	// DiscrepancyDigestMismatch means the contract committed a transfer with a different digest than ours.
+	DiscrepancyDigestMismatch = "digest_mismatch"
+
+	// DiscrepancyStuckSubmitPending means a transfer has been in the submit pending state for too long.
+	DiscrepancyStuckSubmitPending = "stuck_submit_pending"
+)
+
+var (
+	ErrTransferNotPending = errors.New("transfer is not pending in the accountant")
+	ErrSubmitChannelFull  = errors.New("the submission channel is full")
+)
+
+type (
+	// Discrepancy is a disagreement between the accountant and a contract.
+	Discrepancy struct {
+		Kind  string
+		IsNTT bool
+		// MsgId is empty if the transfer is not pending locally.
+		MsgId   string
+		ChainId vaa.ChainID
+		TxHash  string
+		Detail  string
+	}
+
+	// ReconciliationReport lists the disagreements between the accountant and the contracts.
+	ReconciliationReport struct {
+		NumPending    int
+		NumNttPending int
+		Discrepancies []*Discrepancy
+	}
+)
+
+// String returns "committed", "pending" or "unknown". It may be called on a nil status, which is what the contract
+// returns for transfers it does not know about.
+func (ts *TransferStatus) String() string {
+	switch {
+	case ts == nil:
+		return "unknown"
+	case ts.Committed != nil:
+		return "committed"
+	case ts.Pending != nil:
+		return "pending"
+	default:
+		return "unknown"
+	}
+}
+
+// PendingTransfers returns the status of all transfers held by the accountant, sorted by message ID.
+func (acct *Accountant) PendingTransfers() []*PendingTransferStatus {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	ret := make([]*PendingTransferStatus, 0, len(acct.pendingTransfers))
+	for _, pe := range acct.pendingTransfers {
+		ret = append(ret, pe.status())
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].MsgId < ret[j].MsgId
+	})
+	return ret
+}
+
+// QueryPendingTransferStatus queries the contracts for the status of all transfers held by the accountant. The result
+// is keyed by message ID. Transfers the contract does not know about map to nil.
+func (acct *Accountant) QueryPendingTransferStatus(ctx context.Context) (map[string]*TransferStatus, error) {
+	ret := make(map[string]*TransferStatus)
+	for _, isNTT := range []bool{false, true} {
+		pendings := acct.pendingEntries(isNTT)
+		if len(pendings) == 0 {
+			continue
+		}
+
+		wormchainConn, contract := acct.contractFor(isNTT)
+		transferDetails, err := queryBatchTransferStatusWithConn(ctx, acct.logger, wormchainConn, contract, transferKeys(pendings))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pe := range pendings {
+			ret[pe.msgId] = transferDetails[pe.msgId]
+		}
+	}
+
+	return ret, nil
+}
+
+// ResubmitObservation submits the observation of a pending transfer to the contract again, even if it is marked as
+// submit pending.
+func (acct *Accountant) ResubmitObservation(msgId string) error {
+	acct.pendingTransfersLock.Lock()
+	pe, exists := acct.pendingTransfers[msgId]
+	acct.pendingTransfersLock.Unlock()
+	if !exists {
+		return ErrTransferNotPending
+	}
+
+	pe.setSubmitPending(false)
+	acct.submitObservation(pe)
+	if !pe.submitPending() {
+		return ErrSubmitChannelFull
+	}
+
+	acct.logger.Info("resubmitted observation on admin request", zap.String("msgId", msgId), zap.Bool("isNTT", pe.isNTT))
+	return nil
+}
+
+// Reconcile compares the transfers held by the accountant against the state of the contracts. It does not resubmit
+// anything, see the audit for that.
+func (acct *Accountant) Reconcile(ctx context.Context) (*ReconciliationReport, error) {
+	report := &ReconciliationReport{}
+	for _, isNTT := range []bool{false, true} {
+		if (isNTT && !acct.nttEnabled()) || (!isNTT && !acct.baseEnabled()) {
+			continue
+		}
+
+		pendings := acct.pendingEntries(isNTT)
+		if isNTT {
+			report.NumNttPending = len(pendings)
+		} else {
+			report.NumPending = len(pendings)
+		}
+
+		discrepancies, err := acct.reconcile(ctx, isNTT, pendings)
+		if err != nil {
+			return nil, err
+		}
+		report.Discrepancies = append(report.Discrepancies, discrepancies...)
+	}
+
+	return report, nil
+}
+
+// reconcile compares the pending transfers of either the base or the NTT accountant against its contract.
+func (acct *Accountant) reconcile(ctx context.Context, isNTT bool, pendings []*pendingEntry) ([]*Discrepancy, error) {
+	wormchainConn, contract := acct.contractFor(isNTT)
+	discrepancies := []*Discrepancy{}
+
+	tmpMap := make(map[string]*pendingEntry, len(pendings))
+	for _, pe := range pendings {
+		if pe.hasBeenPendingForTooLong() {
+			discrepancies = append(discrepancies, newDiscrepancy(DiscrepancyStuckSubmitPending, pe,
+				fmt.Sprintf("submit pending since %s", pe.updTime().UTC().Format("2006-01-02 15:04:05"))))
+		}
+		tmpMap[pe.makeAuditKey()] = pe
+	}
+
+	missingObservations, err := acct.queryMissingObservations(wormchainConn, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mo := range missingObservations {
+		key := mo.makeAuditKey()
+		if pe, exists := tmpMap[key]; exists {
+			discrepancies = append(discrepancies, newDiscrepancy(DiscrepancyMissingObservation, pe, "contract reports our observation as missing"))
+			delete(tmpMap, key)
+		} else {
+			discrepancies = append(discrepancies, &Discrepancy{
+				Kind:    DiscrepancyUnknownObservation,
+				IsNTT:   isNTT,
+				ChainId: vaa.ChainID(mo.ChainId),
+				TxHash:  hex.EncodeToString(mo.TxHash),
+				Detail:  "contract reports an observation as missing that is not pending locally",
+			})
+		}
+	}
+
+	if len(tmpMap) == 0 {
+		return discrepancies, nil
+	}
+
+	remaining := make([]*pendingEntry, 0, len(tmpMap))
+	for _, pe := range tmpMap {
+		remaining = append(remaining, pe)
+	}
+	sort.Slice(remaining, func(i, j int) bool {
+		return remaining[i].msgId < remaining[j].msgId
+	})
+
+	transferDetails, err := queryBatchTransferStatusWithConn(ctx, acct.logger, wormchainConn, contract, transferKeys(remaining))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pe := range remaining {
+		status := transferDetails[pe.msgId]
+		switch {
+		case status == nil || (status.Committed == nil && status.Pending == nil):
+			discrepancies = append(discrepancies, newDiscrepancy(DiscrepancyUnknownTransfer, pe, "contract does not know about the transfer"))
+		case status.Committed != nil:
+			digest := hex.EncodeToString(status.Committed.Digest)
+			if pe.digest == digest {
+				discrepancies = append(discrepancies, newDiscrepancy(DiscrepancyCommitted, pe, "contract committed the transfer but it is still pending locally"))
+			} else {
+				discrepancies = append(discrepancies, newDiscrepancy(DiscrepancyDigestMismatch, pe,
+					fmt.Sprintf("our digest: %s, committed digest: %s", pe.digest, digest)))
+			}
+		}
+	}
+
+	return discrepancies, nil
+}
+
+// newDiscrepancy creates a discrepancy for a pending transfer.
+func newDiscrepancy(kind string, pe *pendingEntry, detail string) *Discrepancy {
+	return &Discrepancy{
+		Kind:    kind,
+		IsNTT:   pe.isNTT,
+		MsgId:   pe.msgId,
+		ChainId: pe.msg.EmitterChain,
+		TxHash:  pe.msg.TxIDString(),
+		Detail:  detail,
+	}
+}
+
+// pendingEntries returns the transfers pending in either the base or the NTT accountant. It grabs the pending transfer lock.
+func (acct *Accountant) pendingEntries(isNTT bool) []*pendingEntry {
+	acct.pendingTransfersLock.Lock()
+	defer acct.pendingTransfersLock.Unlock()
+
+	ret := []*pendingEntry{}
+	for _, pe := range acct.pendingTransfers {
+		if pe.isNTT == isNTT {
+			ret = append(ret, pe)
+		}
+	}
+	return ret
+}
+
+// contractFor returns the connection and the contract of either the base or the NTT accountant.
+func (acct *Accountant) contractFor(isNTT bool) (AccountantWormchainConn, string) {
+	if isNTT {
+		return acct.nttWormchainConn, acct.nttContract
+	}
+	return acct.wormchainConn, acct.contract
+}
+
+// transferKeys returns the transfer keys of the pending transfers.
+func transferKeys(pendings []*pendingEntry) []TransferKey {
+	keys := make([]TransferKey, 0, len(pendings))
+	for _, pe := range pendings {
+		keys = append(keys, TransferKey{EmitterChain: uint16(pe.msg.EmitterChain), EmitterAddress: pe.msg.EmitterAddress, Sequence: pe.msg.Sequence})
+	}
+	return keys
+}
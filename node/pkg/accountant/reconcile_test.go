@@ -0,0 +1,154 @@
+package accountant
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// reconcileQueryConnMock answers the queries used by the reconciliation with canned responses.
+type reconcileQueryConnMock struct {
+	MockAccountantWormchainConn
+	missing  MissingObservationsResponse
+	statuses BatchTransferStatusResponse
+}
+
+func (c *reconcileQueryConnMock) SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	if strings.HasPrefix(string(query), `{"missing_observations"`) {
+		return json.Marshal(c.missing)
+	}
+	return json.Marshal(c.statuses)
+}
+
+func reconcileTestMsg(t *testing.T, sequence uint64, txHash string) *common.MessagePublication {
+	emitterAddr, err := vaa.StringToAddress("0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	return &common.MessagePublication{
+		TxID:             hashToTxID(txHash),
+		Timestamp:        time.Unix(int64(1654543099), 0),
+		Nonce:            uint32(1),
+		Sequence:         sequence,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		ConsistencyLevel: uint8(32),
+		Payload: buildMockTransferPayloadBytes(1,
+			vaa.ChainIDEthereum,
+			"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+			vaa.ChainIDPolygon,
+			"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+			1.25,
+		),
+	}
+}
+
+func transferKeyForMsg(msg *common.MessagePublication) TransferKey {
+	return TransferKey{EmitterChain: uint16(msg.EmitterChain), EmitterAddress: msg.EmitterAddress, Sequence: msg.Sequence}
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	missingMsg := reconcileTestMsg(t, 1, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4061")
+	committedMsg := reconcileTestMsg(t, 2, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4062")
+	mismatchMsg := reconcileTestMsg(t, 3, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063")
+	unknownMsg := reconcileTestMsg(t, 4, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4064")
+	pendingMsg := reconcileTestMsg(t, 5, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4065")
+	for _, msg := range []*common.MessagePublication{missingMsg, committedMsg, mismatchMsg, unknownMsg, pendingMsg} {
+		shouldPublish, err := acct.SubmitObservation(msg)
+		require.NoError(t, err)
+		require.False(t, shouldPublish)
+	}
+
+	committedDigest, err := hex.DecodeString(committedMsg.CreateDigest())
+	require.NoError(t, err)
+	unknownTxHash := hashToTxID("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4069")
+
+	acct.wormchainConn = &reconcileQueryConnMock{
+		missing: MissingObservationsResponse{
+			Missing: []MissingObservation{
+				{ChainId: uint16(vaa.ChainIDEthereum), TxHash: missingMsg.TxID},
+				{ChainId: uint16(vaa.ChainIDEthereum), TxHash: unknownTxHash},
+			},
+		},
+		statuses: BatchTransferStatusResponse{
+			Details: []TransferDetails{
+				{Key: transferKeyForMsg(committedMsg), Status: &TransferStatus{Committed: &TransferStatusCommitted{Digest: committedDigest}}},
+				{Key: transferKeyForMsg(mismatchMsg), Status: &TransferStatus{Committed: &TransferStatusCommitted{Digest: []byte{1, 2, 3}}}},
+				{Key: transferKeyForMsg(unknownMsg), Status: nil},
+				{Key: transferKeyForMsg(pendingMsg), Status: &TransferStatus{Pending: &[]TransferStatusPending{{}}}},
+			},
+		},
+	}
+
+	report, err := acct.Reconcile(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 5, report.NumPending)
+	assert.Equal(t, 0, report.NumNttPending)
+
+	kinds := map[string]string{}
+	for _, d := range report.Discrepancies {
+		if d.MsgId == "" {
+			assert.Equal(t, DiscrepancyUnknownObservation, d.Kind)
+			assert.Equal(t, hex.EncodeToString(unknownTxHash), d.TxHash)
+			continue
+		}
+		kinds[d.MsgId] = d.Kind
+	}
+	assert.Equal(t, map[string]string{
+		missingMsg.MessageIDString():   DiscrepancyMissingObservation,
+		committedMsg.MessageIDString(): DiscrepancyCommitted,
+		mismatchMsg.MessageIDString():  DiscrepancyDigestMismatch,
+		unknownMsg.MessageIDString():   DiscrepancyUnknownTransfer,
+	}, kinds)
+	assert.Len(t, report.Discrepancies, 5)
+
+	// The report does not change anything.
+	assert.Len(t, acct.PendingTransfers(), 5)
+
+	statuses, err := acct.QueryPendingTransferStatus(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "committed", statuses[committedMsg.MessageIDString()].String())
+	assert.Equal(t, "pending", statuses[pendingMsg.MessageIDString()].String())
+	assert.Equal(t, "unknown", statuses[unknownMsg.MessageIDString()].String())
+}
+
+func TestResubmitObservation(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+
+	msg := reconcileTestMsg(t, 1, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063")
+	require.ErrorIs(t, acct.ResubmitObservation(msg.MessageIDString()), ErrTransferNotPending)
+
+	_, err := acct.SubmitObservation(msg)
+	require.NoError(t, err)
+
+	// Resubmitting works even if the observation is already submit pending.
+	require.NoError(t, acct.ResubmitObservation(msg.MessageIDString()))
+	require.NoError(t, acct.ResubmitObservation(msg.MessageIDString()))
+	assert.Len(t, acct.subChan, 2)
+
+	pendings := acct.PendingTransfers()
+	require.Len(t, pendings, 1)
+	assert.Equal(t, msg.MessageIDString(), pendings[0].MsgId)
+	assert.True(t, pendings[0].SubmitPending)
+	assert.True(t, pendings[0].Enforced)
+}
@@ -0,0 +1,712 @@
+// This code implements the shadow mode of the accountant. When it is enabled, every observation submitted to the base or the NTT
+// accountant contract is first evaluated against a local replica of the transfer rules of the contracts (see cosmwasm/packages/accountant).
+// The replica predicts whether the transfer will be committed or rejected once it reaches quorum. When the contract decides,
+// either in the response to our submission or through an event, the decision is compared to the prediction and any divergence
+// is logged and counted.
+//
+// The replica uses the same TransferKey semantics as the contracts: a transfer that is already committed is approved if the digest
+// matches and rejected if not. Otherwise the source and destination accounts are loaded from the contract and the transfer is
+// applied to them. For NTT transfers, the token is identified by the hub of the sending transceiver, and the peers of the source
+// and destination transceivers must be cross-registered, just like in the NTT accountant contract.
+//
+// The predictions are made by a separate worker with a bounded timeout, so shadow mode never delays the submission of observations.
+// Batches that arrive while the worker is busy are queued up to shadowQueueSize, and are not predicted beyond that. A decision of the
+// contract that arrives before the prediction is made is kept until the prediction is done.
+//
+// The accounts are read around the time the observation is submitted, while the contract applies the transfer when it reaches quorum.
+// Transfers committed in between can cause a divergence, so a low rate of divergences is expected on busy accounts.
+//
+// Shadow mode does not change the behavior of the accountant, it can be combined with either enforcing or log only mode.
+
+package accountant
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// shadowPredictionTimeout is how long a prediction waits for the contract decision before it is discarded.
+	shadowPredictionTimeout = 24 * time.Hour
+
+	// shadowPredictTimeout bounds the queries made to predict a batch.
+	shadowPredictTimeout = 10 * time.Second
+
+	// shadowQueueSize is the number of batches that can wait for a prediction.
+	shadowQueueSize = 100
+
+	// shadowRegistrationRefreshInterval is the minimum time between reloads of the NTT registrations when one is missing.
+	shadowRegistrationRefreshInterval = time.Minute
+
+	// shadowRegistrationsPageSize is the number of NTT registrations requested per query.
+	shadowRegistrationsPageSize = 100
+
+	// nttTrimmedDecimals is the number of decimals the NTT accountant normalizes amounts to.
+	nttTrimmedDecimals = 8
+)
+
+// The reasons a transfer is rejected. They match the errors of the contracts.
+const (
+	shadowReasonDigestMismatch            = "digest mismatch for processed message"
+	shadowReasonMissingWrappedAccount     = "cannot burn wrapped tokens without an existing wrapped account"
+	shadowReasonMissingNativeAccount      = "cannot unlock native tokens without an existing native account"
+	shadowReasonInsufficientSourceBalance = "insufficient balance in source account"
+	shadowReasonInsufficientDestBalance   = "insufficient balance in destination account"
+	shadowReasonMissingHubRegistration    = "missing hub registration"
+	shadowReasonMissingSourcePeer         = "missing source peer registration"
+	shadowReasonMissingDestinationPeer    = "missing destination peer registration"
+	shadowReasonPeersNotCrossRegistered   = "peers are not cross-registered"
+	shadowReasonUnparsableTransferPayload = "failed to parse observation payload"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+type (
+	// shadowAccountKey identifies an account of the accountant contracts.
+	shadowAccountKey struct {
+		ChainId      uint16      `json:"chain_id"`
+		TokenChain   uint16      `json:"token_chain"`
+		TokenAddress vaa.Address `json:"token_address"`
+	}
+
+	// shadowTransfer is the transfer the contract commits when an observation reaches quorum.
+	shadowTransfer struct {
+		Key            TransferKey
+		Amount         *big.Int
+		TokenChain     uint16
+		TokenAddress   vaa.Address
+		RecipientChain uint16
+	}
+
+	// shadowBalanceFunc returns the balance of an account and whether the account exists.
+	shadowBalanceFunc func(key shadowAccountKey) (*big.Int, bool, error)
+
+	// shadowPrediction is the predicted decision of the contract for a transfer.
+	shadowPrediction struct {
+		approve     bool
+		reason      string
+		isNTT       bool
+		predictedAt time.Time
+	}
+
+	// shadowDecision is a decision of the contract that arrived before the prediction was made.
+	shadowDecision struct {
+		committed bool
+		errText   string
+	}
+
+	// shadowBatch is a batch of submitted observations waiting for a prediction.
+	shadowBatch struct {
+		msgs          []*common.MessagePublication
+		isNTT         bool
+		wormchainConn AccountantWormchainConn
+		contract      string
+	}
+
+	// nttTransceiver identifies an NTT transceiver (or hub) by chain and address.
+	nttTransceiver struct {
+		chain   uint16
+		address vaa.Address
+	}
+
+	// nttPeerKey identifies the peer of an NTT transceiver on a given chain.
+	nttPeerKey struct {
+		transceiver nttTransceiver
+		peerChain   uint16
+	}
+
+	// shadowState holds the predictions waiting for a decision and the cached NTT registrations.
+	shadowState struct {
+		batchC      chan *shadowBatch
+		lock        sync.Mutex
+		predictions map[string]*shadowPrediction
+		// pending are the transfers queued for a prediction, and decisions are the decisions of the contract that arrived for
+		// them before the prediction was made.
+		pending            map[string]struct{}
+		decisions          map[string]shadowDecision
+		nttHubs            map[nttTransceiver]nttTransceiver
+		nttPeers           map[nttPeerKey]vaa.Address
+		nttRegistrationsAt time.Time
+	}
+)
+
+func newShadowState() *shadowState {
+	return &shadowState{
+		batchC:      make(chan *shadowBatch, shadowQueueSize),
+		predictions: make(map[string]*shadowPrediction),
+		pending:     make(map[string]struct{}),
+		decisions:   make(map[string]shadowDecision),
+	}
+}
+
+// accountantTag returns the label used in the shadow mode metrics.
+func accountantTag(isNTT bool) string {
+	if isNTT {
+		return "ntt"
+	}
+	return "base"
+}
+
+// shadowEnqueue queues a batch that is about to be submitted for a prediction by shadowWorker. It never blocks, if the queue is full
+// the batch is not predicted.
+func (acct *Accountant) shadowEnqueue(msgs []*common.MessagePublication, isNTT bool, wormchainConn AccountantWormchainConn, contract string) {
+	if acct.shadow == nil {
+		return
+	}
+
+	toPredict := make([]*common.MessagePublication, 0, len(msgs))
+	acct.shadow.lock.Lock()
+	for _, msg := range msgs {
+		msgId := msg.MessageIDString()
+		_, predicted := acct.shadow.predictions[msgId]
+		_, pending := acct.shadow.pending[msgId]
+		if !predicted && !pending {
+			acct.shadow.pending[msgId] = struct{}{}
+			toPredict = append(toPredict, msg)
+		}
+	}
+	acct.shadow.lock.Unlock()
+
+	if len(toPredict) == 0 {
+		return
+	}
+
+	select {
+	case acct.shadow.batchC <- &shadowBatch{msgs: toPredict, isNTT: isNTT, wormchainConn: wormchainConn, contract: contract}:
+	default:
+		tag := accountantTag(isNTT)
+		acct.logger.Warn("shadow: prediction queue is full, not predicting batch", zap.String("accountant", tag), zap.Int("numMsgs", len(toPredict)))
+		shadowPredictions.WithLabelValues(tag, "unknown").Add(float64(len(toPredict)))
+		acct.shadow.lock.Lock()
+		for _, msg := range toPredict {
+			delete(acct.shadow.pending, msg.MessageIDString())
+		}
+		acct.shadow.lock.Unlock()
+	}
+}
+
+// shadowWorker predicts the batches queued by shadowEnqueue.
+func (acct *Accountant) shadowWorker(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case b := <-acct.shadow.batchC:
+			predictCtx, cancel := context.WithTimeout(ctx, shadowPredictTimeout)
+			acct.shadowPredict(predictCtx, b.msgs, b.isNTT, b.wormchainConn, b.contract)
+			cancel()
+		}
+	}
+}
+
+// shadowPredict predicts the decision of the contract for each message in the batch that doesn't have a prediction yet. It is
+// called by shadowWorker, and the queries it makes are bounded by ctx.
+func (acct *Accountant) shadowPredict(ctx context.Context, msgs []*common.MessagePublication, isNTT bool, wormchainConn AccountantWormchainConn, contract string) {
+	if acct.shadow == nil {
+		return
+	}
+
+	now := time.Now()
+	toPredict := make([]*common.MessagePublication, 0, len(msgs))
+	acct.shadow.lock.Lock()
+	for msgId, p := range acct.shadow.predictions {
+		if now.Sub(p.predictedAt) > shadowPredictionTimeout {
+			acct.logger.Debug("shadow: discarding prediction that was never decided", zap.String("msgId", msgId))
+			delete(acct.shadow.predictions, msgId)
+		}
+	}
+	for _, msg := range msgs {
+		if _, exists := acct.shadow.predictions[msg.MessageIDString()]; !exists {
+			toPredict = append(toPredict, msg)
+		}
+	}
+	acct.shadow.lock.Unlock()
+
+	// Once the batch is predicted, decisions that arrive for it are matched against the predictions directly.
+	defer func() {
+		acct.shadow.lock.Lock()
+		for _, msg := range msgs {
+			delete(acct.shadow.pending, msg.MessageIDString())
+			delete(acct.shadow.decisions, msg.MessageIDString())
+		}
+		acct.shadow.lock.Unlock()
+	}()
+
+	if len(toPredict) == 0 {
+		return
+	}
+
+	tag := accountantTag(isNTT)
+	keys := make([]TransferKey, 0, len(toPredict))
+	for _, msg := range toPredict {
+		keys = append(keys, TransferKey{EmitterChain: uint16(msg.EmitterChain), EmitterAddress: msg.EmitterAddress, Sequence: msg.Sequence})
+	}
+
+	statuses, err := queryBatchTransferStatusWithConn(ctx, acct.logger, wormchainConn, contract, keys)
+	if err != nil {
+		acct.logger.Error("shadow: failed to query transfer statuses, not predicting batch", zap.String("accountant", tag), zap.Error(err))
+		shadowPredictions.WithLabelValues(tag, "unknown").Add(float64(len(toPredict)))
+		return
+	}
+
+	getBalance := func(key shadowAccountKey) (*big.Int, bool, error) {
+		return queryShadowBalance(ctx, wormchainConn, contract, key)
+	}
+
+	for _, msg := range toPredict {
+		msgId := msg.MessageIDString()
+		approve, reason, err := acct.shadowEvaluate(ctx, msg, statuses[msgId], isNTT, wormchainConn, contract, getBalance)
+		if err != nil {
+			acct.logger.Error("shadow: failed to evaluate transfer", zap.String("accountant", tag), zap.String("msgId", msgId), zap.Error(err))
+			shadowPredictions.WithLabelValues(tag, "unknown").Inc()
+			continue
+		}
+
+		prediction := "approve"
+		if !approve {
+			prediction = "reject"
+		}
+		shadowPredictions.WithLabelValues(tag, prediction).Inc()
+		acct.logger.Info("shadow: predicted contract decision", zap.String("accountant", tag), zap.String("msgId", msgId), zap.String("prediction", prediction), zap.String("reason", reason))
+
+		p := &shadowPrediction{approve: approve, reason: reason, isNTT: isNTT, predictedAt: now}
+		acct.shadow.lock.Lock()
+		d, decided := acct.shadow.decisions[msgId]
+		if !decided {
+			acct.shadow.predictions[msgId] = p
+		}
+		acct.shadow.lock.Unlock()
+
+		if decided {
+			acct.shadowCompare(msgId, p, d.committed, d.errText)
+		}
+	}
+}
+
+// shadowEvaluate evaluates a single transfer against the replica of the contract rules. It returns whether the transfer is
+// expected to be committed and, if not, the reason it is expected to be rejected.
+func (acct *Accountant) shadowEvaluate(
+	ctx context.Context,
+	msg *common.MessagePublication,
+	status *TransferStatus,
+	isNTT bool,
+	wormchainConn AccountantWormchainConn,
+	contract string,
+	getBalance shadowBalanceFunc,
+) (bool, string, error) {
+	// A transfer that is already committed is approved again if the digest matches.
+	if status != nil && status.Committed != nil {
+		if hex.EncodeToString(status.Committed.Digest) != msg.CreateDigest() {
+			return false, shadowReasonDigestMismatch, nil
+		}
+		return true, "", nil
+	}
+
+	var xfer *shadowTransfer
+	var reason string
+	var err error
+	if isNTT {
+		xfer, reason, err = acct.shadowNttTransfer(ctx, msg, wormchainConn, contract)
+	} else {
+		xfer, reason = shadowTokenBridgeTransfer(msg)
+	}
+	if err != nil {
+		return false, "", err
+	}
+	if xfer == nil {
+		return false, reason, nil
+	}
+
+	return evaluateShadowTransfer(xfer, getBalance)
+}
+
+// shadowTokenBridgeTransfer builds the transfer the base accountant contract commits for a token bridge transfer.
+func shadowTokenBridgeTransfer(msg *common.MessagePublication) (*shadowTransfer, string) {
+	hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	if err != nil {
+		return nil, shadowReasonUnparsableTransferPayload
+	}
+
+	return &shadowTransfer{
+		Key:            TransferKey{EmitterChain: uint16(msg.EmitterChain), EmitterAddress: msg.EmitterAddress, Sequence: msg.Sequence},
+		Amount:         hdr.Amount,
+		TokenChain:     uint16(hdr.OriginChain),
+		TokenAddress:   hdr.OriginAddress,
+		RecipientChain: uint16(hdr.TargetChain),
+	}, ""
+}
+
+// shadowNttTransfer builds the transfer the NTT accountant contract commits for a native token transfer. It returns a nil
+// transfer along with the reason if the contract would reject the observation before getting to the balances.
+func (acct *Accountant) shadowNttTransfer(ctx context.Context, msg *common.MessagePublication, wormchainConn AccountantWormchainConn, contract string) (*shadowTransfer, string, error) {
+	sender := msg.EmitterAddress
+	payload := msg.Payload
+	if _, exists := acct.nttArEmitters[emitterKey{emitterChainId: msg.EmitterChain, emitterAddr: msg.EmitterAddress}]; exists {
+		success, senderAddress, nttPayload := nttParseArPayload(msg.Payload)
+		if !success {
+			return nil, shadowReasonUnparsableTransferPayload, nil
+		}
+		sender = senderAddress
+		payload = nttPayload
+	}
+
+	amount, toChain, err := nttParseTransfer(payload)
+	if err != nil {
+		return nil, shadowReasonUnparsableTransferPayload, nil
+	}
+
+	source := nttTransceiver{chain: uint16(msg.EmitterChain), address: sender}
+	hub, sourcePeer, destinationPeer, err := acct.shadowNttRegistrations(ctx, wormchainConn, contract, source, toChain)
+	if err != nil {
+		return nil, "", err
+	}
+	if hub == nil {
+		return nil, shadowReasonMissingHubRegistration, nil
+	}
+	if sourcePeer == nil {
+		return nil, shadowReasonMissingSourcePeer, nil
+	}
+	if destinationPeer == nil {
+		return nil, shadowReasonMissingDestinationPeer, nil
+	}
+	if *destinationPeer != sender {
+		return nil, shadowReasonPeersNotCrossRegistered, nil
+	}
+
+	return &shadowTransfer{
+		Key:            TransferKey{EmitterChain: uint16(msg.EmitterChain), EmitterAddress: msg.EmitterAddress, Sequence: msg.Sequence},
+		Amount:         amount,
+		TokenChain:     hub.chain,
+		TokenAddress:   hub.address,
+		RecipientChain: toChain,
+	}, "", nil
+}
+
+// shadowNttRegistrations looks up the hub of an NTT transceiver, its peer on the destination chain and the peer of that peer
+// on the source chain. The registrations are cached and reloaded from the contract if one of them is missing.
+func (acct *Accountant) shadowNttRegistrations(
+	ctx context.Context,
+	wormchainConn AccountantWormchainConn,
+	contract string,
+	source nttTransceiver,
+	toChain uint16,
+) (hub *nttTransceiver, sourcePeer *vaa.Address, destinationPeer *vaa.Address, err error) {
+	lookup := func() bool {
+		hub, sourcePeer, destinationPeer = nil, nil, nil
+		if h, exists := acct.shadow.nttHubs[source]; exists {
+			hub = &h
+		}
+		if p, exists := acct.shadow.nttPeers[nttPeerKey{transceiver: source, peerChain: toChain}]; exists {
+			sourcePeer = &p
+			if d, exists := acct.shadow.nttPeers[nttPeerKey{transceiver: nttTransceiver{chain: toChain, address: p}, peerChain: source.chain}]; exists {
+				destinationPeer = &d
+			}
+		}
+		return hub != nil && sourcePeer != nil && destinationPeer != nil
+	}
+
+	acct.shadow.lock.Lock()
+	found := lookup()
+	stale := time.Since(acct.shadow.nttRegistrationsAt) > shadowRegistrationRefreshInterval
+	acct.shadow.lock.Unlock()
+	if found || !stale {
+		return hub, sourcePeer, destinationPeer, nil
+	}
+
+	hubs, err := queryNttHubs(ctx, wormchainConn, contract)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	peers, err := queryNttPeers(ctx, wormchainConn, contract)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	acct.shadow.lock.Lock()
+	defer acct.shadow.lock.Unlock()
+	acct.shadow.nttHubs = hubs
+	acct.shadow.nttPeers = peers
+	acct.shadow.nttRegistrationsAt = time.Now()
+	lookup()
+	return hub, sourcePeer, destinationPeer, nil
+}
+
+// evaluateShadowTransfer applies a transfer to the source and destination accounts the same way the contracts do, without
+// saving anything. It returns whether the transfer would be committed and, if not, why.
+func evaluateShadowTransfer(t *shadowTransfer, getBalance shadowBalanceFunc) (bool, string, error) {
+	srcKey := shadowAccountKey{ChainId: t.Key.EmitterChain, TokenChain: t.TokenChain, TokenAddress: t.TokenAddress}
+	src, exists, err := getBalance(srcKey)
+	if err != nil {
+		return false, "", err
+	}
+	if !exists {
+		if srcKey.ChainId != srcKey.TokenChain {
+			return false, shadowReasonMissingWrappedAccount, nil
+		}
+		src = new(big.Int)
+	}
+	src = new(big.Int).Set(src)
+
+	// For a self-transfer, the source and destination accounts are the same.
+	dstKey := shadowAccountKey{ChainId: t.RecipientChain, TokenChain: t.TokenChain, TokenAddress: t.TokenAddress}
+	dst := src
+	if dstKey != srcKey {
+		balance, exists, err := getBalance(dstKey)
+		if err != nil {
+			return false, "", err
+		}
+		if !exists {
+			if dstKey.ChainId == dstKey.TokenChain {
+				return false, shadowReasonMissingNativeAccount, nil
+			}
+			balance = new(big.Int)
+		}
+		dst = new(big.Int).Set(balance)
+	}
+
+	// Lock native tokens or burn wrapped tokens on the source chain.
+	if srcKey.ChainId == srcKey.TokenChain {
+		src.Add(src, t.Amount)
+	} else {
+		src.Sub(src, t.Amount)
+	}
+	if src.Sign() < 0 || src.Cmp(maxUint256) > 0 {
+		return false, shadowReasonInsufficientSourceBalance, nil
+	}
+
+	// Unlock native tokens or mint wrapped tokens on the destination chain.
+	if dstKey.ChainId == dstKey.TokenChain {
+		dst.Sub(dst, t.Amount)
+	} else {
+		dst.Add(dst, t.Amount)
+	}
+	if dst.Sign() < 0 || dst.Cmp(maxUint256) > 0 {
+		return false, shadowReasonInsufficientDestBalance, nil
+	}
+
+	return true, "", nil
+}
+
+// shadowRecordDecision compares the decision of the contract for a transfer with the prediction, if there is one.
+func (acct *Accountant) shadowRecordDecision(msgId string, committed bool, errText string) {
+	if acct.shadow == nil {
+		return
+	}
+
+	acct.shadow.lock.Lock()
+	p, exists := acct.shadow.predictions[msgId]
+	if exists {
+		delete(acct.shadow.predictions, msgId)
+	} else if _, pending := acct.shadow.pending[msgId]; pending {
+		acct.shadow.decisions[msgId] = shadowDecision{committed: committed, errText: errText}
+	}
+	acct.shadow.lock.Unlock()
+	if !exists {
+		return
+	}
+
+	acct.shadowCompare(msgId, p, committed, errText)
+}
+
+// shadowCompare records whether the decision of the contract for a transfer matches the prediction.
+func (acct *Accountant) shadowCompare(msgId string, p *shadowPrediction, committed bool, errText string) {
+	tag := accountantTag(p.isNTT)
+	if p.approve == committed {
+		shadowAgreements.WithLabelValues(tag).Inc()
+		acct.logger.Debug("shadow: contract decision matches prediction", zap.String("accountant", tag), zap.String("msgId", msgId), zap.Bool("committed", committed))
+		return
+	}
+
+	kind := "unexpected_commit"
+	if p.approve {
+		kind = "unexpected_reject"
+	}
+	shadowDivergences.WithLabelValues(tag, kind).Inc()
+	acct.logger.Warn("shadow: contract decision diverges from prediction",
+		zap.String("accountant", tag),
+		zap.String("msgId", msgId),
+		zap.String("kind", kind),
+		zap.Bool("predictedApprove", p.approve),
+		zap.String("predictedReason", p.reason),
+		zap.Bool("committed", committed),
+		zap.String("contractError", errText),
+	)
+}
+
+// nttParseTransfer extracts the amount, normalized to nttTrimmedDecimals, and the destination chain from an NTT transceiver message.
+// The layout of the native token transfer is the NTT prefix, the decimals (u8), the amount (u64), the source token, the recipient and
+// the destination chain (u16).
+func nttParseTransfer(payload []byte) (*big.Int, uint16, error) {
+	if !nttIsPayloadNTT(payload) {
+		return nil, 0, fmt.Errorf("not an NTT payload")
+	}
+
+	const decimalsOffset = NTT_PREFIX_END
+	const amountOffset = decimalsOffset + 1
+	const toChainOffset = amountOffset + 8 + 32 + 32
+	if len(payload) < toChainOffset+2 {
+		return nil, 0, fmt.Errorf("NTT payload too short: %d", len(payload))
+	}
+
+	decimals := int64(payload[decimalsOffset])
+	amount := new(big.Int).SetUint64(binary.BigEndian.Uint64(payload[amountOffset:]))
+	toChain := binary.BigEndian.Uint16(payload[toChainOffset:])
+
+	if decimals > nttTrimmedDecimals {
+		amount.Div(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(decimals-nttTrimmedDecimals), nil))
+	} else if decimals < nttTrimmedDecimals {
+		amount.Mul(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(nttTrimmedDecimals-decimals), nil))
+	}
+
+	return amount, toChain, nil
+}
+
+// queryShadowBalance queries the balance of an account from the contract. It returns false if the account does not exist.
+func queryShadowBalance(ctx context.Context, qc queryConn, contract string, key shadowAccountKey) (*big.Int, bool, error) {
+	keyBytes, err := json.Marshal(key)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to marshal account key: %w", err)
+	}
+
+	query := fmt.Sprintf(`{"balance":%s}`, string(keyBytes))
+	respBytes, err := qc.SubmitQuery(ctx, contract, []byte(query))
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("balance query failed: %w, %s", err, query)
+	}
+
+	var balanceStr string
+	if err := json.Unmarshal(respBytes, &balanceStr); err != nil {
+		return nil, false, fmt.Errorf("failed to parse balance response: %w, resp: %s", err, string(respBytes))
+	}
+
+	balance, ok := new(big.Int).SetString(balanceStr, 10)
+	if !ok {
+		return nil, false, fmt.Errorf("invalid balance in response: %s", string(respBytes))
+	}
+
+	return balance, true, nil
+}
+
+// queryNttHubs loads all the transceiver hub registrations from the NTT accountant contract.
+func queryNttHubs(ctx context.Context, qc queryConn, contract string) (map[nttTransceiver]nttTransceiver, error) {
+	type hubsResponse struct {
+		Hubs []struct {
+			Key  [2]json.RawMessage `json:"key"`
+			Data [2]json.RawMessage `json:"data"`
+		} `json:"hubs"`
+	}
+
+	ret := make(map[nttTransceiver]nttTransceiver)
+	var startAfter []json.RawMessage
+	for {
+		var resp hubsResponse
+		if err := queryNttRegistrationsPage(ctx, qc, contract, "all_transceiver_hubs", startAfter, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Hubs) == 0 {
+			return ret, nil
+		}
+
+		for _, h := range resp.Hubs {
+			key, err := parseNttTransceiver(h.Key[0], h.Key[1])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse hub key: %w", err)
+			}
+			data, err := parseNttTransceiver(h.Data[0], h.Data[1])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse hub: %w", err)
+			}
+			ret[key] = data
+		}
+
+		last := resp.Hubs[len(resp.Hubs)-1]
+		startAfter = last.Key[:]
+	}
+}
+
+// queryNttPeers loads all the transceiver peer registrations from the NTT accountant contract.
+func queryNttPeers(ctx context.Context, qc queryConn, contract string) (map[nttPeerKey]vaa.Address, error) {
+	type peersResponse struct {
+		Peers []struct {
+			Key  [3]json.RawMessage `json:"key"`
+			Data vaa.Address        `json:"data"`
+		} `json:"peers"`
+	}
+
+	ret := make(map[nttPeerKey]vaa.Address)
+	var startAfter []json.RawMessage
+	for {
+		var resp peersResponse
+		if err := queryNttRegistrationsPage(ctx, qc, contract, "all_transceiver_peers", startAfter, &resp); err != nil {
+			return nil, err
+		}
+		if len(resp.Peers) == 0 {
+			return ret, nil
+		}
+
+		for _, p := range resp.Peers {
+			transceiver, err := parseNttTransceiver(p.Key[0], p.Key[1])
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse peer key: %w", err)
+			}
+			var peerChain uint16
+			if err := json.Unmarshal(p.Key[2], &peerChain); err != nil {
+				return nil, fmt.Errorf("failed to parse peer chain: %w", err)
+			}
+			ret[nttPeerKey{transceiver: transceiver, peerChain: peerChain}] = p.Data
+		}
+
+		last := resp.Peers[len(resp.Peers)-1]
+		startAfter = last.Key[:]
+	}
+}
+
+// queryNttRegistrationsPage queries a page of registrations from the NTT accountant contract.
+func queryNttRegistrationsPage(ctx context.Context, qc queryConn, contract string, queryName string, startAfter []json.RawMessage, resp any) error {
+	type pageParams struct {
+		StartAfter []json.RawMessage `json:"start_after"`
+		Limit      uint32            `json:"limit"`
+	}
+
+	params, err := json.Marshal(map[string]pageParams{queryName: {StartAfter: startAfter, Limit: shadowRegistrationsPageSize}})
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s query: %w", queryName, err)
+	}
+
+	respBytes, err := qc.SubmitQuery(ctx, contract, params)
+	if err != nil {
+		return fmt.Errorf("%s query failed: %w, %s", queryName, err, string(params))
+	}
+
+	if err := json.Unmarshal(respBytes, resp); err != nil {
+		return fmt.Errorf("failed to parse %s response: %w, resp: %s", queryName, err, string(respBytes))
+	}
+
+	return nil
+}
+
+// parseNttTransceiver parses the (chain, address) tuple the NTT accountant contract uses for transceivers.
+func parseNttTransceiver(chainJson json.RawMessage, addrJson json.RawMessage) (nttTransceiver, error) {
+	var t nttTransceiver
+	if err := json.Unmarshal(chainJson, &t.chain); err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(addrJson, &t.address); err != nil {
+		return t, err
+	}
+	return t, nil
+}
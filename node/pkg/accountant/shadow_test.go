@@ -0,0 +1,313 @@
+package accountant
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestEvaluateShadowTransfer(t *testing.T) {
+	tokenAddr, err := vaa.StringToAddress("0x707f9118e33a9b8998bea41dd0d46f38bb963fc8")
+	require.NoError(t, err)
+
+	eth := uint16(vaa.ChainIDEthereum)
+	polygon := uint16(vaa.ChainIDPolygon)
+	solana := uint16(vaa.ChainIDSolana)
+
+	account := func(chain uint16) shadowAccountKey {
+		return shadowAccountKey{ChainId: chain, TokenChain: eth, TokenAddress: tokenAddr}
+	}
+
+	tests := []struct {
+		name     string
+		from     uint16
+		to       uint16
+		amount   int64
+		balances map[shadowAccountKey]int64
+		approve  bool
+		reason   string
+	}{
+		{
+			name:    "lock native and mint wrapped without existing accounts",
+			from:    eth,
+			to:      polygon,
+			amount:  100,
+			approve: true,
+		},
+		{
+			name:     "burn wrapped and unlock native",
+			from:     polygon,
+			to:       eth,
+			amount:   100,
+			balances: map[shadowAccountKey]int64{account(polygon): 100, account(eth): 100},
+			approve:  true,
+		},
+		{
+			name:    "burn wrapped without an account",
+			from:    polygon,
+			to:      eth,
+			amount:  100,
+			approve: false,
+			reason:  shadowReasonMissingWrappedAccount,
+		},
+		{
+			name:     "burn more wrapped than minted",
+			from:     polygon,
+			to:       solana,
+			amount:   101,
+			balances: map[shadowAccountKey]int64{account(polygon): 100},
+			approve:  false,
+			reason:   shadowReasonInsufficientSourceBalance,
+		},
+		{
+			name:     "unlock native without an account",
+			from:     polygon,
+			to:       eth,
+			amount:   100,
+			balances: map[shadowAccountKey]int64{account(polygon): 100},
+			approve:  false,
+			reason:   shadowReasonMissingNativeAccount,
+		},
+		{
+			name:     "unlock more native than locked",
+			from:     polygon,
+			to:       eth,
+			amount:   100,
+			balances: map[shadowAccountKey]int64{account(polygon): 100, account(eth): 99},
+			approve:  false,
+			reason:   shadowReasonInsufficientDestBalance,
+		},
+		{
+			name:     "self transfer of wrapped tokens",
+			from:     polygon,
+			to:       polygon,
+			amount:   100,
+			balances: map[shadowAccountKey]int64{account(polygon): 100},
+			approve:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			getBalance := func(key shadowAccountKey) (*big.Int, bool, error) {
+				balance, exists := tc.balances[key]
+				return big.NewInt(balance), exists, nil
+			}
+
+			approve, reason, err := evaluateShadowTransfer(&shadowTransfer{
+				Key:            TransferKey{EmitterChain: tc.from, Sequence: 1},
+				Amount:         big.NewInt(tc.amount),
+				TokenChain:     eth,
+				TokenAddress:   tokenAddr,
+				RecipientChain: tc.to,
+			}, getBalance)
+			require.NoError(t, err)
+			assert.Equal(t, tc.approve, approve)
+			assert.Equal(t, tc.reason, reason)
+		})
+	}
+
+	_, _, err = evaluateShadowTransfer(&shadowTransfer{Key: TransferKey{EmitterChain: eth}, Amount: big.NewInt(1), TokenChain: eth, RecipientChain: polygon},
+		func(key shadowAccountKey) (*big.Int, bool, error) { return nil, false, errors.New("query failed") })
+	require.ErrorContains(t, err, "query failed")
+}
+
+func TestNttParseTransfer(t *testing.T) {
+	buildPayload := func(decimals uint8, amount uint64, toChain vaa.ChainID) []byte {
+		payload := make([]byte, NTT_PREFIX_END+1+8+32+32+2)
+		copy(payload[0:4], WH_PREFIX)
+		copy(payload[NTT_PREFIX_OFFSET:NTT_PREFIX_END], NTT_PREFIX)
+		payload[NTT_PREFIX_END] = decimals
+		binary.BigEndian.PutUint64(payload[NTT_PREFIX_END+1:], amount)
+		binary.BigEndian.PutUint16(payload[len(payload)-2:], uint16(toChain))
+		return payload
+	}
+
+	amount, toChain, err := nttParseTransfer(buildPayload(8, 12345, vaa.ChainIDSolana))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(12345), amount)
+	assert.Equal(t, uint16(vaa.ChainIDSolana), toChain)
+
+	amount, _, err = nttParseTransfer(buildPayload(6, 12345, vaa.ChainIDSolana))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1234500), amount)
+
+	amount, _, err = nttParseTransfer(buildPayload(10, 12345, vaa.ChainIDSolana))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(123), amount)
+
+	_, _, err = nttParseTransfer(buildPayload(8, 12345, vaa.ChainIDSolana)[:NTT_PREFIX_END+10])
+	require.Error(t, err)
+}
+
+// shadowQueryConnMock answers the queries used by shadow mode. Accounts that are not in the map do not exist.
+type shadowQueryConnMock struct {
+	MockAccountantWormchainConn
+	balances map[shadowAccountKey]string
+}
+
+func (c *shadowQueryConnMock) SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	if strings.HasPrefix(string(query), `{"batch_transfer_status"`) {
+		return json.Marshal(BatchTransferStatusResponse{})
+	}
+
+	var q struct {
+		Balance shadowAccountKey `json:"balance"`
+	}
+	if err := json.Unmarshal(query, &q); err != nil {
+		return nil, err
+	}
+	balance, exists := c.balances[q.Balance]
+	if !exists {
+		return nil, errors.New("rpc error: code = Unknown desc = accountant::state::account::Balance not found: query wasm contract failed")
+	}
+	return json.Marshal(balance)
+}
+
+func TestShadowPredictAndRecordDecision(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+	acct.shadow = newShadowState()
+
+	// Ethereum native tokens sent to Polygon are locked on Ethereum and minted on Polygon, which always works.
+	nativeMsg := reconcileTestMsg(t, 1, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4061")
+
+	// Polygon tokens sent from Ethereum must be burned on Ethereum, but nothing was minted there.
+	wrappedMsg := reconcileTestMsg(t, 2, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4062")
+	wrappedMsg.Payload = buildMockTransferPayloadBytes(1,
+		vaa.ChainIDPolygon,
+		"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+		vaa.ChainIDSolana,
+		"0x707f9118e33a9b8998bea41dd0d46f38bb963fc8",
+		1.25,
+	)
+
+	conn := &shadowQueryConnMock{balances: map[shadowAccountKey]string{}}
+	acct.shadowPredict(ctx, []*common.MessagePublication{nativeMsg, wrappedMsg}, false, conn, "0xdeadbeef")
+
+	require.Len(t, acct.shadow.predictions, 2)
+	assert.True(t, acct.shadow.predictions[nativeMsg.MessageIDString()].approve)
+	assert.False(t, acct.shadow.predictions[wrappedMsg.MessageIDString()].approve)
+	assert.Equal(t, shadowReasonMissingWrappedAccount, acct.shadow.predictions[wrappedMsg.MessageIDString()].reason)
+
+	// Predictions are only made once per transfer.
+	acct.shadow.predictions[nativeMsg.MessageIDString()].reason = "kept"
+	acct.shadowPredict(ctx, []*common.MessagePublication{nativeMsg}, false, conn, "0xdeadbeef")
+	assert.Equal(t, "kept", acct.shadow.predictions[nativeMsg.MessageIDString()].reason)
+
+	// Decisions consume the predictions.
+	acct.shadowRecordDecision(nativeMsg.MessageIDString(), true, "")
+	acct.shadowRecordDecision(wrappedMsg.MessageIDString(), true, "")
+	assert.Empty(t, acct.shadow.predictions)
+
+	// Without shadow mode, nothing happens.
+	acct.shadow = nil
+	acct.shadowPredict(ctx, []*common.MessagePublication{nativeMsg}, false, conn, "0xdeadbeef")
+	acct.shadowRecordDecision(nativeMsg.MessageIDString(), true, "")
+}
+
+func TestShadowEnqueue(t *testing.T) {
+	ctx := context.Background()
+	obsvReqWriteC := make(chan *gossipv1.ObservationRequest, 10)
+	acctChan := make(chan *common.MessagePublication, 10)
+	acct := newAccountantForTest(t, zap.NewNop(), ctx, enforceAccountant, obsvReqWriteC, acctChan, nil)
+	require.NotNil(t, acct)
+	acct.shadow = newShadowState()
+
+	conn := &shadowQueryConnMock{balances: map[shadowAccountKey]string{}}
+	msg := reconcileTestMsg(t, 1, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4061")
+
+	// Enqueueing doesn't predict, and a transfer is only queued once.
+	acct.shadowEnqueue([]*common.MessagePublication{msg}, false, conn, "0xdeadbeef")
+	acct.shadowEnqueue([]*common.MessagePublication{msg}, false, conn, "0xdeadbeef")
+	require.Len(t, acct.shadow.batchC, 1)
+	assert.Empty(t, acct.shadow.predictions)
+
+	// A decision that arrives before the prediction is compared once the prediction is made.
+	acct.shadowRecordDecision(msg.MessageIDString(), true, "")
+	require.Len(t, acct.shadow.decisions, 1)
+	agreements := testutil.ToFloat64(shadowAgreements.WithLabelValues("base"))
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = acct.shadowWorker(workerCtx)
+	}()
+	require.Eventually(t, func() bool {
+		acct.shadow.lock.Lock()
+		defer acct.shadow.lock.Unlock()
+		return len(acct.shadow.pending) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	cancel()
+	<-done
+
+	assert.Empty(t, acct.shadow.predictions)
+	assert.Empty(t, acct.shadow.decisions)
+	assert.Equal(t, agreements+1, testutil.ToFloat64(shadowAgreements.WithLabelValues("base")))
+
+	// Batches beyond the queue size are dropped instead of blocking the submission.
+	for seq := uint64(1); seq <= shadowQueueSize+1; seq++ {
+		acct.shadowEnqueue([]*common.MessagePublication{reconcileTestMsg(t, seq, "0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4061")}, false, conn, "0xdeadbeef")
+	}
+	assert.Len(t, acct.shadow.batchC, shadowQueueSize)
+	assert.Len(t, acct.shadow.pending, shadowQueueSize)
+}
+
+// nttRegistrationsConnMock returns one page of NTT registrations followed by an empty page.
+type nttRegistrationsConnMock struct {
+	queries []string
+}
+
+func (c *nttRegistrationsConnMock) SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	c.queries = append(c.queries, string(query))
+	if strings.Contains(string(query), `"start_after":null`) {
+		if strings.HasPrefix(string(query), `{"all_transceiver_hubs"`) {
+			return []byte(`{"hubs":[{"key":[2,"0000000000000000000000000000000000000000000000000000000000000001"],"data":[2,"0000000000000000000000000000000000000000000000000000000000000001"]}]}`), nil
+		}
+		return []byte(`{"peers":[{"key":[2,"0000000000000000000000000000000000000000000000000000000000000001",1],"data":"0000000000000000000000000000000000000000000000000000000000000002"}]}`), nil
+	}
+	if strings.HasPrefix(string(query), `{"all_transceiver_hubs"`) {
+		return []byte(`{"hubs":[]}`), nil
+	}
+	return []byte(`{"peers":[]}`), nil
+}
+
+func TestQueryNttRegistrations(t *testing.T) {
+	ctx := context.Background()
+	addr1, err := vaa.StringToAddress("0000000000000000000000000000000000000000000000000000000000000001")
+	require.NoError(t, err)
+	addr2, err := vaa.StringToAddress("0000000000000000000000000000000000000000000000000000000000000002")
+	require.NoError(t, err)
+
+	qc := &nttRegistrationsConnMock{}
+	hubs, err := queryNttHubs(ctx, qc, "0xdeadbeef")
+	require.NoError(t, err)
+	ethTransceiver := nttTransceiver{chain: uint16(vaa.ChainIDEthereum), address: addr1}
+	assert.Equal(t, map[nttTransceiver]nttTransceiver{ethTransceiver: ethTransceiver}, hubs)
+
+	peers, err := queryNttPeers(ctx, qc, "0xdeadbeef")
+	require.NoError(t, err)
+	assert.Equal(t, map[nttPeerKey]vaa.Address{{transceiver: ethTransceiver, peerChain: uint16(vaa.ChainIDSolana)}: addr2}, peers)
+
+	// The second page starts after the last key of the first one.
+	require.Len(t, qc.queries, 4)
+	assert.Equal(t, `{"all_transceiver_hubs":{"start_after":[2,"0000000000000000000000000000000000000000000000000000000000000001"],"limit":100}}`, qc.queries[1])
+}
@@ -52,7 +52,7 @@ func (acct *Accountant) worker(ctx context.Context, isNTT bool) error {
 		case <-ctx.Done():
 			return nil
 		default:
-			if err := acct.handleBatch(ctx, subChan, wormchainConn, contract, prefix, tag); err != nil {
+			if err := acct.handleBatch(ctx, isNTT, subChan, wormchainConn, contract, prefix, tag); err != nil {
 				return err
 			}
 		}
@@ -61,7 +61,7 @@ func (acct *Accountant) worker(ctx context.Context, isNTT bool) error {
 
 // handleBatch reads a batch of events from the channel, either until a timeout occurs or the batch is full,
 // and submits them to the smart contract.
-func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.MessagePublication, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string) error {
+func (acct *Accountant) handleBatch(ctx context.Context, isNTT bool, subChan chan *common.MessagePublication, wormchainConn AccountantWormchainConn, contract string, prefix []byte, tag string) error {
 	ctx, cancel := context.WithTimeout(ctx, delayInMS)
 	defer cancel()
 
@@ -88,6 +88,7 @@ func (acct *Accountant) handleBatch(ctx context.Context, subChan chan *common.Me
 		return fmt.Errorf("failed to get guardian index for %s", tag)
 	}
 
+	acct.shadowEnqueue(msgs, isNTT, wormchainConn, contract)
 	acct.submitObservationsToContract(msgs, gs.Index, uint32(guardianIndex), wormchainConn, contract, prefix, tag)
 	transfersSubmitted.Add(float64(len(msgs)))
 	return nil
@@ -251,9 +252,11 @@ func (acct *Accountant) submitObservationsToContract(msgs []*common.MessagePubli
 		case "pending":
 			acct.logger.Info(fmt.Sprintf("transfer is pending on %s", tag), zap.String("msgId", msgId))
 		case "committed":
+			acct.shadowRecordDecision(msgId, true, "")
 			acct.handleCommittedTransfer(msgId)
 		case "error":
 			submitFailures.Inc()
+			acct.shadowRecordDecision(msgId, false, status.Data)
 			acct.handleTransferError(msgId, status.Data, "transfer failed")
 		default:
 			// This will get retried next audit interval.
@@ -342,7 +345,7 @@ func SubmitObservationsToContract(
 		return nil, fmt.Errorf("failed to sign accountant Observation request: %w", err)
 	}
 
-	sigBytes, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sigBytes, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeAccountantObservation), digest.Bytes())
 	if err != nil {
 		return nil, fmt.Errorf("failed to sign accountant Observation request: %w", err)
 	}
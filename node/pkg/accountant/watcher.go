@@ -116,6 +116,7 @@ func (acct *Accountant) handleEvents(ctx context.Context, evts <-chan tmCoreType
 					}
 
 					errorEventsReceived.Inc()
+					acct.shadowRecordDecision(evt.Key.String(), false, evt.Error)
 					acct.handleTransferError(evt.Key.String(), evt.Error, fmt.Sprintf("transfer error event received from %s", tag))
 				} else {
 					acct.logger.Debug(fmt.Sprintf("ignoring uninteresting event from %s", tag), zap.String("eventType", event.Type))
@@ -190,6 +191,7 @@ func (acct *Accountant) processPendingTransfer(xfer *WasmObservation, tag string
 	}
 
 	msgId := msg.MessageIDString()
+	acct.shadowRecordDecision(msgId, true, "")
 
 	pe, exists := acct.pendingTransfers[msgId]
 	if exists {
@@ -18,7 +18,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/accountant"
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/holiman/uint256"
@@ -67,6 +73,14 @@ type nodePrivilegedService struct {
 	guardianAddress ethcommon.Address
 	rpcMap          map[string]string
 	reobservers     interfaces.Reobservers
+	retentionPolicy *db.RetentionPolicy
+	acct            *accountant.Accountant
+	inspectC        chan<- *processor.InspectRequest
+	performance     *processor.GuardianPerformance
+	denylist        *denylist.Denylist
+	gatewayRelayer  *gwrelayer.GatewayRelayer
+	p2pIntrospector *p2p.Introspector
+	heightMonitor   *heightmonitor.Monitor
 }
 
 func NewPrivService(
@@ -81,7 +95,14 @@ func NewPrivService(
 	guardianAddress ethcommon.Address,
 	rpcMap map[string]string,
 	reobservers interfaces.Reobservers,
-
+	retentionPolicy *db.RetentionPolicy,
+	acct *accountant.Accountant,
+	inspectC chan<- *processor.InspectRequest,
+	performance *processor.GuardianPerformance,
+	denylist *denylist.Denylist,
+	gatewayRelayer *gwrelayer.GatewayRelayer,
+	p2pIntrospector *p2p.Introspector,
+	heightMonitor *heightmonitor.Monitor,
 ) *nodePrivilegedService {
 	return &nodePrivilegedService{
 		db:              db,
@@ -95,6 +116,14 @@ func NewPrivService(
 		guardianAddress: guardianAddress,
 		rpcMap:          rpcMap,
 		reobservers:     reobservers,
+		retentionPolicy: retentionPolicy,
+		acct:            acct,
+		inspectC:        inspectC,
+		performance:     performance,
+		denylist:        denylist,
+		gatewayRelayer:  gatewayRelayer,
+		p2pIntrospector: p2pIntrospector,
+		heightMonitor:   heightMonitor,
 	}
 }
 
@@ -1079,6 +1108,47 @@ func (s *nodePrivilegedService) PurgePythNetVaas(ctx context.Context, req *nodev
 	}, nil
 }
 
+func (s *nodePrivilegedService) ApplyVaaRetentionPolicy(ctx context.Context, req *nodev1.ApplyVaaRetentionPolicyRequest) (*nodev1.ApplyVaaRetentionPolicyResponse, error) {
+	if s.retentionPolicy == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no VAA retention policy is configured")
+	}
+
+	results, err := s.db.ApplyRetentionPolicy(s.retentionPolicy, time.Now(), req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &nodev1.ApplyVaaRetentionPolicyResponse{
+		Entries: make([]*nodev1.ApplyVaaRetentionPolicyResponse_Entry, 0, len(results)),
+	}
+
+	var sb strings.Builder
+	for _, result := range results {
+		entry := &nodev1.ApplyVaaRetentionPolicyResponse_Entry{
+			EmitterChain: uint32(result.Rule.EmitterChain),
+			NumDeleted:   uint64(result.NumDeleted),
+			NumKept:      uint64(result.NumKept),
+		}
+		if result.Rule.EmitterAddress != nil {
+			entry.EmitterAddress = result.Rule.EmitterAddress.String()
+		}
+		resp.Entries = append(resp.Entries, entry)
+
+		if req.DryRun {
+			fmt.Fprintf(&sb, "%s: would delete %d VAAs and keep %d\n", result.Rule.String(), result.NumDeleted, result.NumKept)
+		} else {
+			fmt.Fprintf(&sb, "%s: deleted %d VAAs and kept %d\n", result.Rule.String(), result.NumDeleted, result.NumKept)
+		}
+	}
+	resp.Response = sb.String()
+
+	if !req.DryRun {
+		s.logger.Info("applied VAA retention policy on request", zap.String("result", resp.Response))
+	}
+
+	return resp, nil
+}
+
 func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1.SignExistingVAARequest) (*nodev1.SignExistingVAAResponse, error) {
 	v, err := vaa.Unmarshal(req.Vaa)
 	if err != nil {
@@ -1181,7 +1251,7 @@ func (s *nodePrivilegedService) SignExistingVAA(ctx context.Context, req *nodev1
 	}
 
 	// Add local signature
-	sig, err := s.guardianSigner.Sign(ctx, v.SigningDigest().Bytes())
+	sig, err := s.guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeObservation), v.SigningDigest().Bytes())
 	if err != nil {
 		panic(err)
 	}
@@ -1334,3 +1404,552 @@ func (s *nodePrivilegedService) GetAndObserveMissingVAAs(ctx context.Context, re
 		Response: response,
 	}, nil
 }
+
+func (s *nodePrivilegedService) GuardianPerformance(ctx context.Context, req *nodev1.GuardianPerformanceRequest) (*nodev1.GuardianPerformanceResponse, error) {
+	if s.performance == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the guardian performance report is not available")
+	}
+
+	if req.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id: %d", req.ChainId)
+	}
+	chainID := vaa.ChainID(req.ChainId)
+
+	resp := &nodev1.GuardianPerformanceResponse{
+		WindowSeconds: uint64(s.performance.Window().Seconds()),
+		Entries:       []*nodev1.GuardianPerformanceResponse_Entry{},
+	}
+	for _, entry := range s.performance.Report(time.Now()) {
+		if chainID != vaa.ChainIDUnset && entry.Chain != chainID {
+			continue
+		}
+		resp.Entries = append(resp.Entries, &nodev1.GuardianPerformanceResponse_Entry{
+			GuardianAddress:   entry.Guardian.Hex(),
+			ChainId:           uint32(entry.Chain),
+			Observations:      uint64(entry.Observations), // #nosec G115 -- The number of samples is bounded
+			Signed:            uint64(entry.Signed),       // #nosec G115 -- The number of samples is bounded
+			Missed:            uint64(entry.Missed),       // #nosec G115 -- The number of samples is bounded
+			ParticipationRate: entry.ParticipationRate,
+			MedianLatencyMs:   entry.MedianLatency.Milliseconds(),
+		})
+	}
+
+	return resp, nil
+}
+
+// denylistReleaseTimeout is the time the processor has to accept a message released by the denylist.
+const denylistReleaseTimeout = 5 * time.Second
+
+func (s *nodePrivilegedService) DenylistStatus(ctx context.Context, req *nodev1.DenylistStatusRequest) (*nodev1.DenylistStatusResponse, error) {
+	if s.denylist == nil {
+		return nil, fmt.Errorf("denylist is not enabled")
+	}
+
+	return &nodev1.DenylistStatusResponse{
+		Response: s.denylist.Status(),
+	}, nil
+}
+
+func (s *nodePrivilegedService) DenylistAddEntry(ctx context.Context, req *nodev1.DenylistAddEntryRequest) (*nodev1.DenylistAddEntryResponse, error) {
+	if s.denylist == nil {
+		return nil, fmt.Errorf("denylist is not enabled")
+	}
+
+	if req.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id: %d", req.ChainId)
+	}
+
+	var expiry time.Time
+	if req.TtlSeconds != 0 {
+		if req.TtlSeconds > math.MaxInt32 {
+			return nil, status.Errorf(codes.InvalidArgument, "ttl is too large: %d", req.TtlSeconds)
+		}
+		expiry = time.Now().Add(time.Duration(req.TtlSeconds) * time.Second) // #nosec G115 -- Checked above
+	}
+
+	entry, err := denylist.NewEntry(req.Kind, vaa.ChainID(req.ChainId), req.Address, expiry, req.Reason)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.denylist.AddEntry(entry); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.DenylistAddEntryResponse{
+		Response: fmt.Sprintf("added denylist entry %s", entry.Key()),
+	}, nil
+}
+
+func (s *nodePrivilegedService) DenylistRemoveEntry(ctx context.Context, req *nodev1.DenylistRemoveEntryRequest) (*nodev1.DenylistRemoveEntryResponse, error) {
+	if s.denylist == nil {
+		return nil, fmt.Errorf("denylist is not enabled")
+	}
+
+	if req.ChainId > math.MaxUint16 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid chain id: %d", req.ChainId)
+	}
+
+	entry, err := denylist.NewEntry(req.Kind, vaa.ChainID(req.ChainId), req.Address, time.Time{}, "")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.denylist.RemoveEntry(entry.Key()); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.DenylistRemoveEntryResponse{
+		Response: fmt.Sprintf("removed denylist entry %s", entry.Key()),
+	}, nil
+}
+
+func (s *nodePrivilegedService) DenylistReleaseHeldMessage(ctx context.Context, req *nodev1.DenylistReleaseHeldMessageRequest) (*nodev1.DenylistReleaseHeldMessageResponse, error) {
+	if s.denylist == nil {
+		return nil, fmt.Errorf("denylist is not enabled")
+	}
+
+	if len(req.VaaId) == 0 {
+		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	releaseCtx, cancel := context.WithTimeout(ctx, denylistReleaseTimeout)
+	defer cancel()
+	if err := s.denylist.ReleaseHeldMessage(releaseCtx, req.VaaId); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.DenylistReleaseHeldMessageResponse{
+		Response: fmt.Sprintf("released held message %s", req.VaaId),
+	}, nil
+}
+
+func (s *nodePrivilegedService) DenylistDropHeldMessage(ctx context.Context, req *nodev1.DenylistDropHeldMessageRequest) (*nodev1.DenylistDropHeldMessageResponse, error) {
+	if s.denylist == nil {
+		return nil, fmt.Errorf("denylist is not enabled")
+	}
+
+	if len(req.VaaId) == 0 {
+		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	if err := s.denylist.DropHeldMessage(req.VaaId); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.DenylistDropHeldMessageResponse{
+		Response: fmt.Sprintf("dropped held message %s", req.VaaId),
+	}, nil
+}
+
+func (s *nodePrivilegedService) GatewayRelayerQueue(ctx context.Context, req *nodev1.GatewayRelayerQueueRequest) (*nodev1.GatewayRelayerQueueResponse, error) {
+	if s.gatewayRelayer == nil {
+		return nil, fmt.Errorf("gateway relayer is not enabled")
+	}
+
+	entries := s.gatewayRelayer.QueueStatus()
+	resp := &nodev1.GatewayRelayerQueueResponse{
+		Entries: make([]*nodev1.GatewayRelayerQueueResponse_Entry, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &nodev1.GatewayRelayerQueueResponse_Entry{
+			MessageId:       entry.MessageID,
+			ContractAddress: entry.ContractAddress,
+			VaaType:         gwrelayer.VaaTypeString(gwrelayer.VaaType(entry.VaaType)),
+			EnqueuedAt:      entry.EnqueuedAt.Unix(),
+			Attempts:        uint32(entry.Attempts), // #nosec G115 -- The number of attempts is bounded
+			NextAttempt:     entry.NextAttempt.Unix(),
+			LastError:       entry.LastError,
+			Failed:          entry.Failed,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GatewayRelayerReplay(ctx context.Context, req *nodev1.GatewayRelayerReplayRequest) (*nodev1.GatewayRelayerReplayResponse, error) {
+	if s.gatewayRelayer == nil {
+		return nil, fmt.Errorf("gateway relayer is not enabled")
+	}
+
+	vaaId, err := db.VaaIDFromString(req.VaaId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	err = s.gatewayRelayer.Retry(req.VaaId)
+	if err == nil {
+		return &nodev1.GatewayRelayerReplayResponse{
+			Response: fmt.Sprintf("retrying queued VAA %s", req.VaaId),
+		}, nil
+	}
+	if !errors.Is(err, gwrelayer.ErrQueueEntryNotFound) {
+		return nil, err
+	}
+
+	// The VAA is not queued, so replay it from the database.
+	vaaBytes, err := s.db.GetSignedVAABytes(*vaaId)
+	if err != nil {
+		if errors.Is(err, db.ErrVAANotFound) {
+			return nil, status.Errorf(codes.NotFound, "VAA %s is neither queued nor stored", req.VaaId)
+		}
+		return nil, status.Errorf(codes.Internal, "database operation failed: %v", err)
+	}
+
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unmarshal stored VAA: %v", err)
+	}
+
+	if err := s.gatewayRelayer.Replay(v); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.GatewayRelayerReplayResponse{
+		Response: fmt.Sprintf("queued stored VAA %s", req.VaaId),
+	}, nil
+}
+
+func (s *nodePrivilegedService) GatewayRelayerDrop(ctx context.Context, req *nodev1.GatewayRelayerDropRequest) (*nodev1.GatewayRelayerDropResponse, error) {
+	if s.gatewayRelayer == nil {
+		return nil, fmt.Errorf("gateway relayer is not enabled")
+	}
+
+	if len(req.VaaId) == 0 {
+		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	if err := s.gatewayRelayer.Drop(req.VaaId); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.GatewayRelayerDropResponse{
+		Response: fmt.Sprintf("dropped queued VAA %s", req.VaaId),
+	}, nil
+}
+
+func (s *nodePrivilegedService) AccountantPendingTransfers(ctx context.Context, req *nodev1.AccountantPendingTransfersRequest) (*nodev1.AccountantPendingTransfersResponse, error) {
+	if s.acct == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	var statuses map[string]*accountant.TransferStatus
+	if req.QueryContract {
+		var err error
+		statuses, err = s.acct.QueryPendingTransferStatus(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to query the accountant contract: %v", err)
+		}
+	}
+
+	pendings := s.acct.PendingTransfers()
+	resp := &nodev1.AccountantPendingTransfersResponse{
+		Entries: make([]*nodev1.AccountantPendingTransfersResponse_Entry, 0, len(pendings)),
+	}
+	for _, pt := range pendings {
+		entry := &nodev1.AccountantPendingTransfersResponse_Entry{
+			MessageId:     pt.MsgId,
+			Digest:        pt.Digest,
+			IsNtt:         pt.IsNTT,
+			Enforced:      pt.Enforced,
+			SubmitPending: pt.SubmitPending,
+			UpdatedAt:     pt.UpdTime.Unix(),
+		}
+		if req.QueryContract {
+			ts := statuses[pt.MsgId]
+			entry.ContractStatus = ts.String()
+			if ts != nil {
+				details, err := json.Marshal(ts)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to marshal transfer status: %v", err)
+				}
+				entry.ContractStatusDetails = string(details)
+			}
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) AccountantResubmitObservation(ctx context.Context, req *nodev1.AccountantResubmitObservationRequest) (*nodev1.AccountantResubmitObservationResponse, error) {
+	if s.acct == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	if len(req.VaaId) == 0 {
+		return nil, fmt.Errorf("the VAA id must be specified as \"chainId/emitterAddress/seqNum\"")
+	}
+
+	if err := s.acct.ResubmitObservation(req.VaaId); err != nil {
+		return nil, err
+	}
+
+	return &nodev1.AccountantResubmitObservationResponse{
+		Response: fmt.Sprintf("resubmitted observation %s", req.VaaId),
+	}, nil
+}
+
+func (s *nodePrivilegedService) AccountantReconciliationReport(ctx context.Context, req *nodev1.AccountantReconciliationReportRequest) (*nodev1.AccountantReconciliationReportResponse, error) {
+	if s.acct == nil {
+		return nil, fmt.Errorf("accountant is not enabled")
+	}
+
+	report, err := s.acct.Reconcile(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "failed to reconcile with the accountant contract: %v", err)
+	}
+
+	resp := &nodev1.AccountantReconciliationReportResponse{
+		NumPending:    uint32(report.NumPending),    // #nosec G115 -- The number of pending transfers fits in a uint32
+		NumNttPending: uint32(report.NumNttPending), // #nosec G115 -- The number of pending transfers fits in a uint32
+		Discrepancies: make([]*nodev1.AccountantReconciliationReportResponse_Discrepancy, 0, len(report.Discrepancies)),
+	}
+	for _, d := range report.Discrepancies {
+		resp.Discrepancies = append(resp.Discrepancies, &nodev1.AccountantReconciliationReportResponse_Discrepancy{
+			Kind:      d.Kind,
+			IsNtt:     d.IsNTT,
+			MessageId: d.MsgId,
+			ChainId:   uint32(d.ChainId),
+			TxHash:    d.TxHash,
+			Detail:    d.Detail,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) P2PStatus(ctx context.Context, req *nodev1.P2PStatusRequest) (*nodev1.P2PStatusResponse, error) {
+	if s.p2pIntrospector == nil {
+		return nil, fmt.Errorf("p2p introspection is not enabled")
+	}
+
+	st, err := s.p2pIntrospector.Status()
+	if errors.Is(err, p2p.ErrP2PNotRunning) {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &nodev1.P2PStatusResponse{
+		PeerId:            st.PeerID.String(),
+		ListenAddrs:       st.ListenAddrs,
+		MeshSizes:         make(map[string]uint32, len(st.MeshSizes)),
+		PeerScoring:       st.PeerScoring,
+		Peers:             make([]*nodev1.P2PStatusResponse_Peer, 0, len(st.Peers)),
+		RecentDisconnects: make([]*nodev1.P2PStatusResponse_Disconnect, 0, len(st.RecentDisconnects)),
+	}
+	for kind, size := range st.MeshSizes {
+		resp.MeshSizes[kind] = uint32(size) // #nosec G115 -- The mesh size fits in a uint32
+	}
+	for _, p := range st.Peers {
+		resp.Peers = append(resp.Peers, &nodev1.P2PStatusResponse_Peer{
+			PeerId:           p.PeerID.String(),
+			GuardianAddr:     p.GuardianAddr,
+			NodeName:         p.NodeName,
+			ProtectionTags:   p.ProtectionTags,
+			Addrs:            p.Addrs,
+			Direction:        strings.ToLower(p.Direction.String()),
+			ConnectedSince:   p.ConnectedSince.Unix(),
+			LatencyMs:        p.Latency.Milliseconds(),
+			SubscribedTopics: p.SubscribedTopics,
+			MeshTopics:       p.MeshTopics,
+			BytesIn:          p.BytesIn,
+			BytesOut:         p.BytesOut,
+			MessagesIn:       p.MessagesIn,
+			MessagesOut:      p.MessagesOut,
+			Score:            p.Score,
+		})
+	}
+	for _, d := range st.RecentDisconnects {
+		resp.RecentDisconnects = append(resp.RecentDisconnects, &nodev1.P2PStatusResponse_Disconnect{
+			PeerId:         d.PeerID.String(),
+			GuardianAddr:   d.GuardianAddr,
+			NodeName:       d.NodeName,
+			Addr:           d.Addr,
+			DisconnectedAt: d.DisconnectedAt.Unix(),
+			ConnectedFor:   int64(d.ConnectedFor.Seconds()),
+			Reason:         d.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) ChainHeightStatus(ctx context.Context, req *nodev1.ChainHeightStatusRequest) (*nodev1.ChainHeightStatusResponse, error) {
+	if s.heightMonitor == nil {
+		return nil, fmt.Errorf("height monitor is not enabled")
+	}
+
+	heights := func(h heightmonitor.Heights) *nodev1.ChainHeightStatusResponse_Heights {
+		return &nodev1.ChainHeightStatusResponse_Heights{
+			Height:          h.Height,
+			SafeHeight:      h.SafeHeight,
+			FinalizedHeight: h.FinalizedHeight,
+		}
+	}
+
+	chains := s.heightMonitor.Status()
+	resp := &nodev1.ChainHeightStatusResponse{
+		Entries: make([]*nodev1.ChainHeightStatusResponse_Entry, 0, len(chains)),
+	}
+	for _, cs := range chains {
+		resp.Entries = append(resp.Entries, &nodev1.ChainHeightStatusResponse_Entry{
+			ChainId:       uint32(cs.ChainID),
+			ChainName:     cs.ChainID.String(),
+			Local:         heights(cs.Local),
+			NetworkMedian: heights(cs.Median),
+			NumGuardians:  uint32(cs.NumGuardians), // #nosec G115 -- The number of guardians fits in a uint32
+			Deviation:     cs.Deviation,
+			Threshold:     cs.Threshold,
+			Status:        cs.Status,
+		})
+	}
+
+	return resp, nil
+}
+
+// inspectTimeout is the time the processor has to answer an inspection request.
+const inspectTimeout = 5 * time.Second
+
+func (s *nodePrivilegedService) InspectObservation(ctx context.Context, req *nodev1.InspectObservationRequest) (*nodev1.InspectObservationResponse, error) {
+	var msgId, digest string
+	switch id := req.Id.(type) {
+	case *nodev1.InspectObservationRequest_MessageId:
+		vaaId, err := db.VaaIDFromString(id.MessageId)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid message id: %v", err)
+		}
+		msgId = vaaId.String()
+	case *nodev1.InspectObservationRequest_Digest:
+		b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(id.Digest), "0x"))
+		if err != nil || len(b) != 32 {
+			return nil, status.Error(codes.InvalidArgument, "digest must be 32 hex-encoded bytes")
+		}
+		digest = hex.EncodeToString(b)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either a message id or a digest is required")
+	}
+
+	resp := &nodev1.InspectObservationResponse{}
+
+	if s.inspectC != nil {
+		inspectCtx, cancel := context.WithTimeout(ctx, inspectTimeout)
+		defer cancel()
+		infos, err := processor.Inspect(inspectCtx, s.inspectC, msgId, digest)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to inspect the processor state: %v", err)
+		}
+
+		for _, info := range infos {
+			state := &nodev1.InspectObservationResponse_AggregationState{
+				Digest:           info.Digest,
+				MessageId:        info.MessageID,
+				FirstObserved:    info.FirstObserved.Unix(),
+				OurObservation:   info.OurObservation,
+				Submitted:        info.Submitted,
+				Settled:          info.Settled,
+				RetryCount:       uint32(info.RetryCount), // #nosec G115 -- The retry count is bounded by the retry limit
+				GuardianSetIndex: info.GuardianSetIndex,
+				Quorum:           uint32(info.Quorum), // #nosec G115 -- The quorum is bounded by the guardian set size
+				TxHash:           hex.EncodeToString(info.TxHash),
+			}
+			if !info.NextRetry.IsZero() {
+				state.NextRetry = info.NextRetry.Unix()
+			}
+			for _, addr := range info.SignedBy {
+				state.SignedBy = append(state.SignedBy, addr.Hex())
+			}
+			for _, addr := range info.Missing {
+				state.Missing = append(state.Missing, addr.Hex())
+			}
+			resp.States = append(resp.States, state)
+
+			// Fill in whichever identifier we were not given, so the governor, the accountant and the database can be queried.
+			if msgId == "" && info.MessageID != "" {
+				msgId = info.MessageID
+			}
+			if digest == "" && len(infos) == 1 {
+				digest = info.Digest
+			}
+		}
+	}
+	resp.MessageId = msgId
+
+	if s.governor != nil {
+		seen, pending, releaseTime := s.governor.MessageStatus(msgId, digest)
+		resp.Governor = &nodev1.InspectObservationResponse_Governor{
+			Seen:    seen,
+			Pending: pending,
+		}
+		if pending {
+			resp.Governor.ReleaseTime = releaseTime.Unix()
+		}
+	}
+
+	if s.acct != nil {
+		resp.Accountant = &nodev1.InspectObservationResponse_Accountant{}
+		if msgId != "" {
+			if pt := s.acct.GetPendingTransferStatus(msgId); pt != nil {
+				resp.Accountant.Pending = true
+				resp.Accountant.IsNtt = pt.IsNTT
+				resp.Accountant.SubmitPending = pt.SubmitPending
+				resp.Accountant.Updated = pt.UpdTime.Unix()
+			}
+		}
+	}
+
+	storedVAA, err := s.lookupStoredVAA(msgId, digest)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "database operation failed: %v", err)
+	}
+	if storedVAA != nil {
+		resp.StoredVaa = &nodev1.InspectObservationResponse_StoredVAA{
+			Digest:           hex.EncodeToString(storedVAA.SigningDigest().Bytes()),
+			GuardianSetIndex: storedVAA.GuardianSetIndex,
+			NumSignatures:    uint32(len(storedVAA.Signatures)), // #nosec G115 -- The number of signatures is bounded by the guardian set size
+		}
+		if resp.MessageId == "" {
+			resp.MessageId = storedVAA.MessageID()
+		}
+	}
+
+	return resp, nil
+}
+
+// lookupStoredVAA returns the signed VAA for the message ID or, if the VAA indexes are enabled, the digest. It
+// returns nil if the VAA is not stored.
+func (s *nodePrivilegedService) lookupStoredVAA(msgId string, digest string) (*vaa.VAA, error) {
+	if s.db == nil {
+		return nil, nil
+	}
+
+	var b []byte
+	var err error
+	if msgId != "" {
+		var vaaId *db.VAAID
+		vaaId, err = db.VaaIDFromString(msgId)
+		if err != nil {
+			return nil, err
+		}
+		b, err = s.db.GetSignedVAABytes(*vaaId)
+	} else if digest != "" && s.db.VAAIndexesEnabled() {
+		var digestBytes []byte
+		digestBytes, err = hex.DecodeString(digest)
+		if err != nil {
+			return nil, err
+		}
+		b, err = s.db.GetSignedVAABytesByDigest(digestBytes)
+	} else {
+		return nil, nil
+	}
+
+	if errors.Is(err, db.ErrVAANotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return vaa.Unmarshal(b)
+}
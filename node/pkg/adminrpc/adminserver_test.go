@@ -4,13 +4,22 @@ package adminrpc
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
 	wh_common "github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors"
 	"github.com/certusone/wormhole/node/pkg/watchers/evm/connectors/ethabi"
@@ -25,6 +34,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/prototext"
 )
 
@@ -418,3 +429,230 @@ func TestChainGovernorResetReleaseTimer(t *testing.T) {
 	}
 
 }
+
+func TestInspectObservation(t *testing.T) {
+	signers, addrs := generateGuardianSigners(2)
+	vBytes := generateMockVAA(0, signers, t)
+	v, err := vaa.Unmarshal(vBytes)
+	require.NoError(t, err)
+	digest := hex.EncodeToString(v.SigningDigest().Bytes())
+
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+	require.NoError(t, database.StoreSignedVAA(v))
+
+	// Answer inspection requests like the processor would.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	inspectC := make(chan *processor.InspectRequest)
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-inspectC:
+				infos := []*processor.ObservationInfo{}
+				if req.MessageID == v.MessageID() || req.Digest == digest {
+					infos = append(infos, &processor.ObservationInfo{
+						Digest:         digest,
+						MessageID:      v.MessageID(),
+						FirstObserved:  time.Unix(1_700_000_000, 0),
+						OurObservation: true,
+						Submitted:      true,
+						Quorum:         2,
+						SignedBy:       addrs,
+					})
+				}
+				req.ResponseC <- infos
+			}
+		}
+	}()
+
+	service := &nodePrivilegedService{
+		db:       database,
+		logger:   zap.NewNop(),
+		inspectC: inspectC,
+	}
+
+	tests := map[string]*nodev1.InspectObservationRequest{
+		"MessageID":    {Id: &nodev1.InspectObservationRequest_MessageId{MessageId: v.MessageID()}},
+		"Digest":       {Id: &nodev1.InspectObservationRequest_Digest{Digest: digest}},
+		"PrefixDigest": {Id: &nodev1.InspectObservationRequest_Digest{Digest: "0x" + strings.ToUpper(digest)}},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp, err := service.InspectObservation(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, v.MessageID(), resp.MessageId)
+			require.Len(t, resp.States, 1)
+			assert.Equal(t, digest, resp.States[0].Digest)
+			assert.True(t, resp.States[0].Submitted)
+			assert.Equal(t, addrsToHexStrings(addrs), resp.States[0].SignedBy)
+			assert.Empty(t, resp.States[0].Missing)
+			assert.Nil(t, resp.Governor)
+			assert.Nil(t, resp.Accountant)
+			require.NotNil(t, resp.StoredVaa)
+			assert.Equal(t, digest, resp.StoredVaa.Digest)
+			assert.Equal(t, uint32(2), resp.StoredVaa.NumSignatures)
+		})
+	}
+
+	// An unknown message is reported as absent everywhere.
+	resp, err := service.InspectObservation(context.Background(), &nodev1.InspectObservationRequest{
+		Id: &nodev1.InspectObservationRequest_MessageId{MessageId: "2/0000000000000000000000000000000000000000000000000000000000000004/1"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, resp.States)
+	assert.Nil(t, resp.StoredVaa)
+
+	_, err = service.InspectObservation(context.Background(), &nodev1.InspectObservationRequest{
+		Id: &nodev1.InspectObservationRequest_MessageId{MessageId: "invalid"},
+	})
+	require.ErrorContains(t, err, "invalid message id")
+
+	_, err = service.InspectObservation(context.Background(), &nodev1.InspectObservationRequest{
+		Id: &nodev1.InspectObservationRequest_Digest{Digest: "abcd"},
+	})
+	require.ErrorContains(t, err, "digest must be 32 hex-encoded bytes")
+
+	_, err = service.InspectObservation(context.Background(), &nodev1.InspectObservationRequest{})
+	require.ErrorContains(t, err, "either a message id or a digest is required")
+}
+
+func TestGuardianPerformance(t *testing.T) {
+	service := &nodePrivilegedService{}
+	_, err := service.GuardianPerformance(context.Background(), &nodev1.GuardianPerformanceRequest{})
+	require.ErrorContains(t, err, "not available")
+
+	service.performance = processor.NewGuardianPerformance(processor.GuardianPerformanceWindow)
+	resp, err := service.GuardianPerformance(context.Background(), &nodev1.GuardianPerformanceRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(processor.GuardianPerformanceWindow.Seconds()), resp.WindowSeconds)
+	assert.Empty(t, resp.Entries)
+
+	_, err = service.GuardianPerformance(context.Background(), &nodev1.GuardianPerformanceRequest{ChainId: math.MaxUint16 + 1})
+	require.ErrorContains(t, err, "invalid chain id")
+}
+
+func TestDenylistEntries(t *testing.T) {
+	ctx := context.Background()
+	service := &nodePrivilegedService{}
+	_, err := service.DenylistStatus(ctx, &nodev1.DenylistStatusRequest{})
+	require.ErrorContains(t, err, "denylist is not enabled")
+
+	service.denylist = denylist.NewDenylist(zap.NewNop(), &db.MockDenylistDB{}, wh_common.GoTest, "", nil)
+	require.NoError(t, service.denylist.Load())
+
+	resp, err := service.DenylistAddEntry(ctx, &nodev1.DenylistAddEntryRequest{
+		Kind:       denylist.KindEmitter,
+		ChainId:    uint32(vaa.ChainIDEthereum),
+		Address:    "0x3ee18B2214AFF97000D974cf647E7C347E8fa585",
+		TtlSeconds: 3600,
+		Reason:     "incident",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "added denylist entry emitter/2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", resp.Response)
+
+	status, err := service.DenylistStatus(ctx, &nodev1.DenylistStatusRequest{})
+	require.NoError(t, err)
+	assert.Contains(t, status.Response, "denylist entries: 1")
+	assert.Contains(t, status.Response, "reason: incident")
+
+	_, err = service.DenylistAddEntry(ctx, &nodev1.DenylistAddEntryRequest{Kind: "wallet", ChainId: 2, Address: "0x01"})
+	require.ErrorContains(t, err, "invalid kind")
+	_, err = service.DenylistAddEntry(ctx, &nodev1.DenylistAddEntryRequest{Kind: denylist.KindEmitter, ChainId: math.MaxUint16 + 1, Address: "0x01"})
+	require.ErrorContains(t, err, "invalid chain id")
+
+	_, err = service.DenylistRemoveEntry(ctx, &nodev1.DenylistRemoveEntryRequest{
+		Kind:    denylist.KindEmitter,
+		ChainId: uint32(vaa.ChainIDEthereum),
+		Address: "0x3ee18B2214AFF97000D974cf647E7C347E8fa585",
+	})
+	require.NoError(t, err)
+	_, err = service.DenylistRemoveEntry(ctx, &nodev1.DenylistRemoveEntryRequest{
+		Kind:    denylist.KindEmitter,
+		ChainId: uint32(vaa.ChainIDEthereum),
+		Address: "0x3ee18B2214AFF97000D974cf647E7C347E8fa585",
+	})
+	require.ErrorIs(t, err, denylist.ErrEntryNotFound)
+
+	_, err = service.DenylistDropHeldMessage(ctx, &nodev1.DenylistDropHeldMessageRequest{VaaId: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"})
+	require.ErrorIs(t, err, denylist.ErrHeldMessageNotFound)
+}
+
+func TestGatewayRelayerQueue(t *testing.T) {
+	ctx := context.Background()
+	service := &nodePrivilegedService{}
+	_, err := service.GatewayRelayerQueue(ctx, &nodev1.GatewayRelayerQueueRequest{})
+	require.ErrorContains(t, err, "gateway relayer is not enabled")
+
+	signers, _ := generateGuardianSigners(1)
+	v, err := vaa.Unmarshal(generateMockVAA(0, signers, t))
+	require.NoError(t, err)
+
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+
+	service.db = database
+	service.gatewayRelayer = gwrelayer.NewGatewayRelayer(ctx, zap.NewNop(), &db.MockGatewayRelayerDB{}, "wormhole1translator", nil, wh_common.GoTest)
+
+	resp, err := service.GatewayRelayerQueue(ctx, &nodev1.GatewayRelayerQueueRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Entries)
+
+	_, err = service.GatewayRelayerReplay(ctx, &nodev1.GatewayRelayerReplayRequest{VaaId: "invalid"})
+	require.ErrorContains(t, err, "chainId/emitterAddress/seqNum")
+	_, err = service.GatewayRelayerReplay(ctx, &nodev1.GatewayRelayerReplayRequest{VaaId: v.MessageID()})
+	require.ErrorContains(t, err, "neither queued nor stored")
+
+	// The VAA is stored, but it is not destined for the gateway.
+	require.NoError(t, database.StoreSignedVAA(v))
+	_, err = service.GatewayRelayerReplay(ctx, &nodev1.GatewayRelayerReplayRequest{VaaId: v.MessageID()})
+	require.ErrorIs(t, err, gwrelayer.ErrNotRelayed)
+
+	_, err = service.GatewayRelayerDrop(ctx, &nodev1.GatewayRelayerDropRequest{VaaId: v.MessageID()})
+	require.ErrorIs(t, err, gwrelayer.ErrQueueEntryNotFound)
+}
+
+func TestAccountantCommandsWhenDisabled(t *testing.T) {
+	ctx := context.Background()
+	service := &nodePrivilegedService{}
+
+	_, err := service.AccountantPendingTransfers(ctx, &nodev1.AccountantPendingTransfersRequest{QueryContract: true})
+	require.ErrorContains(t, err, "accountant is not enabled")
+	_, err = service.AccountantResubmitObservation(ctx, &nodev1.AccountantResubmitObservationRequest{VaaId: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1"})
+	require.ErrorContains(t, err, "accountant is not enabled")
+	_, err = service.AccountantReconciliationReport(ctx, &nodev1.AccountantReconciliationReportRequest{})
+	require.ErrorContains(t, err, "accountant is not enabled")
+}
+
+func TestP2PStatus(t *testing.T) {
+	ctx := context.Background()
+
+	service := &nodePrivilegedService{}
+	_, err := service.P2PStatus(ctx, &nodev1.P2PStatusRequest{})
+	require.ErrorContains(t, err, "p2p introspection is not enabled")
+
+	// The introspector is only populated once p2p is running.
+	service = &nodePrivilegedService{p2pIntrospector: p2p.NewIntrospector()}
+	_, err = service.P2PStatus(ctx, &nodev1.P2PStatusRequest{})
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestChainHeightStatus(t *testing.T) {
+	ctx := context.Background()
+
+	service := &nodePrivilegedService{}
+	_, err := service.ChainHeightStatus(ctx, &nodev1.ChainHeightStatusRequest{})
+	require.ErrorContains(t, err, "height monitor is not enabled")
+
+	// No comparison has been made yet.
+	monitor, err := heightmonitor.NewMonitor(zap.NewNop(), wh_common.NewGuardianSetState(nil), common.Address{}, func() map[vaa.ChainID]*gossipv1.Heartbeat_Network { return nil }, heightmonitor.DefaultConfig())
+	require.NoError(t, err)
+	service = &nodePrivilegedService{heightMonitor: monitor}
+	resp, err := service.ChainHeightStatus(ctx, &nodev1.ChainHeightStatusRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Entries)
+}
@@ -117,6 +117,9 @@ type GuardianSetState struct {
 	// across guardian set updates - these values don't change.
 	lastHeartbeats map[common.Address]map[peer.ID]*gossipv1.Heartbeat
 	updateC        chan *gossipv1.Heartbeat
+
+	// history keeps the heartbeats of the guardians in the current guardian set over time.
+	history *HeartbeatHistory
 }
 
 // NewGuardianSetState returns a new GuardianSetState.
@@ -127,6 +130,7 @@ func NewGuardianSetState(guardianSetStateUpdateC chan *gossipv1.Heartbeat) *Guar
 	return &GuardianSetState{
 		lastHeartbeats: map[common.Address]map[peer.ID]*gossipv1.Heartbeat{},
 		updateC:        guardianSetStateUpdateC,
+		history:        NewHeartbeatHistory(),
 	}
 }
 
@@ -176,12 +180,25 @@ func (st *GuardianSetState) SetHeartbeat(addr common.Address, peerId peer.ID, hb
 	}
 
 	v[peerId] = hb
+
+	// Only guardians in the current set are recorded in the history to keep it bounded.
+	if st.current != nil {
+		if _, ok := st.current.KeyIndex(addr); ok {
+			st.history.record(addr, peerId, hb, time.Now())
+		}
+	}
+
 	if st.updateC != nil {
 		st.updateC <- hb
 	}
 	return nil
 }
 
+// History returns the heartbeat history of the guardians in the current guardian set.
+func (st *GuardianSetState) History() *HeartbeatHistory {
+	return st.history
+}
+
 // GetAll returns all stored heartbeats.
 func (st *GuardianSetState) GetAll() map[common.Address]map[peer.ID]*gossipv1.Heartbeat {
 	st.mu.Lock()
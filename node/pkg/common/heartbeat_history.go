@@ -0,0 +1,239 @@
+package common
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	// HeartbeatHistorySampleInterval is the resolution of the heartbeat history. At most one heartbeat per node is
+	// recorded per interval, and uptime is measured in intervals with at least one heartbeat.
+	HeartbeatHistorySampleInterval = time.Minute
+
+	// MaxHeartbeatHistoryAge is how long heartbeats are kept in the history.
+	MaxHeartbeatHistoryAge = 24 * time.Hour
+)
+
+type (
+	// chainHeight is the height of a chain as reported in a heartbeat.
+	chainHeight struct {
+		chainID vaa.ChainID
+		height  int64
+	}
+
+	// heartbeatSample is the part of a heartbeat kept in the history.
+	heartbeatSample struct {
+		// bucket is the local receive time truncated to the sample interval. The timestamp in the heartbeat is
+		// not used since it is set by the sender.
+		bucket        time.Time
+		peerID        peer.ID
+		nodeName      string
+		bootTimestamp int64
+		version       string
+		heights       []chainHeight
+	}
+
+	// HeartbeatHistory keeps a bounded history of the heartbeats of the guardians in the current guardian set.
+	HeartbeatHistory struct {
+		mu sync.Mutex
+		// start is when the history started, which bounds the window that can be reported on.
+		start time.Time
+		// samples are the samples of each guardian, ordered by bucket.
+		samples map[common.Address][]heartbeatSample
+	}
+
+	// VersionChange records a node of a guardian coming up with a different version.
+	VersionChange struct {
+		Time            time.Time
+		PeerID          peer.ID
+		PreviousVersion string
+		Version         string
+	}
+
+	// ChainLag describes how far a guardian is behind the highest height reported by any guardian for a chain.
+	ChainLag struct {
+		ChainID vaa.ChainID
+		// Height is the most recent height reported by the guardian.
+		Height int64
+		MaxLag int64
+		AvgLag float64
+	}
+
+	// GuardianUptime summarizes the heartbeats of a guardian over a window.
+	GuardianUptime struct {
+		Guardian common.Address
+		// NodeName and Version are taken from the most recent heartbeat in the window.
+		NodeName string
+		Version  string
+		// Uptime is the fraction of sample intervals in which a heartbeat was received.
+		Uptime         float64
+		Restarts       int
+		VersionChanges []VersionChange
+		ChainLags      []ChainLag
+	}
+)
+
+// NewHeartbeatHistory returns an empty heartbeat history.
+func NewHeartbeatHistory() *HeartbeatHistory {
+	return &HeartbeatHistory{
+		start:   time.Now(),
+		samples: make(map[common.Address][]heartbeatSample),
+	}
+}
+
+// record adds a heartbeat received at the given time to the history of a guardian.
+func (h *HeartbeatHistory) record(addr common.Address, peerID peer.ID, hb *gossipv1.Heartbeat, now time.Time) {
+	bucket := now.Truncate(HeartbeatHistorySampleInterval)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	samples := h.samples[addr]
+
+	// Only keep the first heartbeat of each node per interval. Samples of the current bucket are at the end.
+	for i := len(samples) - 1; i >= 0 && samples[i].bucket.Equal(bucket); i-- {
+		if samples[i].peerID == peerID {
+			return
+		}
+	}
+
+	sample := heartbeatSample{
+		bucket:        bucket,
+		peerID:        peerID,
+		nodeName:      hb.NodeName,
+		bootTimestamp: hb.BootTimestamp,
+		version:       hb.Version,
+		heights:       make([]chainHeight, 0, len(hb.Networks)),
+	}
+	for _, n := range hb.Networks {
+		if n.Height > 0 {
+			sample.heights = append(sample.heights, chainHeight{chainID: vaa.ChainID(n.Id), height: n.Height})
+		}
+	}
+
+	// Drop the samples that are too old.
+	cutoff := now.Add(-MaxHeartbeatHistoryAge)
+	first := sort.Search(len(samples), func(i int) bool { return samples[i].bucket.After(cutoff) })
+	h.samples[addr] = append(samples[first:], sample)
+}
+
+// Report summarizes the history of the given guardians over the window ending at now. It also returns the duration
+// the report covers, which is shorter than the window if the history does not go back that far.
+func (h *HeartbeatHistory) Report(guardians []common.Address, window time.Duration, now time.Time) ([]*GuardianUptime, time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	observed := window
+	if since := now.Sub(h.start); since < observed {
+		observed = since
+	}
+	from := now.Add(-observed).Truncate(HeartbeatHistorySampleInterval)
+	expectedBuckets := int(now.Sub(from)/HeartbeatHistorySampleInterval) + 1
+
+	// inWindow returns the samples of a guardian within the window.
+	inWindow := func(addr common.Address) []heartbeatSample {
+		samples := h.samples[addr]
+		first := sort.Search(len(samples), func(i int) bool { return !samples[i].bucket.Before(from) })
+		return samples[first:]
+	}
+
+	// The highest height of each chain per interval across all guardians, which the lag is measured against.
+	networkHeights := make(map[time.Time]map[vaa.ChainID]int64)
+	for _, addr := range guardians {
+		for _, s := range inWindow(addr) {
+			heights, exists := networkHeights[s.bucket]
+			if !exists {
+				heights = make(map[vaa.ChainID]int64)
+				networkHeights[s.bucket] = heights
+			}
+			for _, ch := range s.heights {
+				if ch.height > heights[ch.chainID] {
+					heights[ch.chainID] = ch.height
+				}
+			}
+		}
+	}
+
+	ret := make([]*GuardianUptime, 0, len(guardians))
+	for _, addr := range guardians {
+		samples := inWindow(addr)
+		u := &GuardianUptime{Guardian: addr}
+
+		buckets := make(map[time.Time]struct{})
+		lastByPeer := make(map[peer.ID]heartbeatSample)
+		// The highest height of each chain per interval reported by the guardian.
+		guardianHeights := make(map[time.Time]map[vaa.ChainID]int64)
+		latestHeights := make(map[vaa.ChainID]int64)
+
+		for _, s := range samples {
+			buckets[s.bucket] = struct{}{}
+			u.NodeName = s.nodeName
+			u.Version = s.version
+
+			if prev, exists := lastByPeer[s.peerID]; exists {
+				if s.bootTimestamp != prev.bootTimestamp {
+					u.Restarts++
+				}
+				if s.version != prev.version {
+					u.VersionChanges = append(u.VersionChanges, VersionChange{
+						Time:            s.bucket,
+						PeerID:          s.peerID,
+						PreviousVersion: prev.version,
+						Version:         s.version,
+					})
+				}
+			}
+			lastByPeer[s.peerID] = s
+
+			heights, exists := guardianHeights[s.bucket]
+			if !exists {
+				heights = make(map[vaa.ChainID]int64)
+				guardianHeights[s.bucket] = heights
+			}
+			for _, ch := range s.heights {
+				if ch.height > heights[ch.chainID] {
+					heights[ch.chainID] = ch.height
+				}
+				latestHeights[ch.chainID] = ch.height
+			}
+		}
+
+		u.Uptime = float64(len(buckets)) / float64(expectedBuckets)
+		if u.Uptime > 1 {
+			u.Uptime = 1
+		}
+
+		lags := make(map[vaa.ChainID]*ChainLag)
+		counts := make(map[vaa.ChainID]int)
+		for bucket, heights := range guardianHeights {
+			for chainID, height := range heights {
+				lag := networkHeights[bucket][chainID] - height
+				cl, exists := lags[chainID]
+				if !exists {
+					cl = &ChainLag{ChainID: chainID, Height: latestHeights[chainID]}
+					lags[chainID] = cl
+				}
+				if lag > cl.MaxLag {
+					cl.MaxLag = lag
+				}
+				cl.AvgLag += float64(lag)
+				counts[chainID]++
+			}
+		}
+		for chainID, cl := range lags {
+			cl.AvgLag /= float64(counts[chainID])
+			u.ChainLags = append(u.ChainLags, *cl)
+		}
+		sort.Slice(u.ChainLags, func(i, j int) bool { return u.ChainLags[i].ChainID < u.ChainLags[j].ChainID })
+
+		ret = append(ret, u)
+	}
+
+	return ret, observed
+}
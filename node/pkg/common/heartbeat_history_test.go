@@ -0,0 +1,104 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func newTestHeartbeat(bootTimestamp int64, version string, heights map[vaa.ChainID]int64) *gossipv1.Heartbeat {
+	hb := &gossipv1.Heartbeat{NodeName: "node-" + version, BootTimestamp: bootTimestamp, Version: version}
+	for chainID, height := range heights {
+		hb.Networks = append(hb.Networks, &gossipv1.Heartbeat_Network{Id: uint32(chainID), Height: height})
+	}
+	return hb
+}
+
+func TestHeartbeatHistoryReport(t *testing.T) {
+	g1 := common.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	g2 := common.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c")
+	g3 := common.HexToAddress("0x58076F561CC62A47087B567C86f986426dFCD000")
+	p1 := peer.ID("peer-1")
+	p2 := peer.ID("peer-2")
+
+	start := time.Unix(1_700_000_000, 0).Truncate(HeartbeatHistorySampleInterval)
+	h := NewHeartbeatHistory()
+	h.start = start
+
+	// g1 is up for all ten minutes and restarts with a new version after five minutes.
+	// g2 is up for the first five minutes only and lags behind on Ethereum.
+	for i := 0; i < 10; i++ {
+		now := start.Add(time.Duration(i) * HeartbeatHistorySampleInterval)
+		if i < 5 {
+			h.record(g1, p1, newTestHeartbeat(1, "v1", map[vaa.ChainID]int64{vaa.ChainIDEthereum: 100 + int64(i)}), now)
+			h.record(g2, p2, newTestHeartbeat(1, "v1", map[vaa.ChainID]int64{vaa.ChainIDEthereum: 90 + int64(i), vaa.ChainIDSolana: 0}), now)
+		} else {
+			h.record(g1, p1, newTestHeartbeat(2, "v2", map[vaa.ChainID]int64{vaa.ChainIDEthereum: 100 + int64(i)}), now)
+		}
+		// Only one heartbeat per node and interval is recorded.
+		h.record(g1, p1, newTestHeartbeat(3, "v3", nil), now.Add(time.Second))
+	}
+
+	uptimes, observed := h.Report([]common.Address{g1, g2, g3}, time.Hour, start.Add(9*HeartbeatHistorySampleInterval+time.Second))
+	assert.Equal(t, 9*HeartbeatHistorySampleInterval+time.Second, observed)
+	require.Len(t, uptimes, 3)
+
+	u1 := uptimes[0]
+	assert.Equal(t, g1, u1.Guardian)
+	assert.Equal(t, "node-v2", u1.NodeName)
+	assert.Equal(t, "v2", u1.Version)
+	assert.Equal(t, 1.0, u1.Uptime)
+	assert.Equal(t, 1, u1.Restarts)
+	assert.Equal(t, []VersionChange{{Time: start.Add(5 * HeartbeatHistorySampleInterval), PeerID: p1, PreviousVersion: "v1", Version: "v2"}}, u1.VersionChanges)
+	assert.Equal(t, []ChainLag{{ChainID: vaa.ChainIDEthereum, Height: 109, MaxLag: 0, AvgLag: 0}}, u1.ChainLags)
+
+	u2 := uptimes[1]
+	assert.Equal(t, 0.5, u2.Uptime)
+	assert.Zero(t, u2.Restarts)
+	assert.Empty(t, u2.VersionChanges)
+	// Chains without a height are ignored.
+	assert.Equal(t, []ChainLag{{ChainID: vaa.ChainIDEthereum, Height: 94, MaxLag: 10, AvgLag: 10}}, u2.ChainLags)
+
+	assert.Equal(t, &GuardianUptime{Guardian: g3}, uptimes[2])
+
+	// A shorter window only covers the most recent samples.
+	uptimes, observed = h.Report([]common.Address{g1, g2}, 2*HeartbeatHistorySampleInterval, start.Add(9*HeartbeatHistorySampleInterval))
+	assert.Equal(t, 2*HeartbeatHistorySampleInterval, observed)
+	assert.Equal(t, 1.0, uptimes[0].Uptime)
+	assert.Zero(t, uptimes[0].Restarts)
+	assert.Zero(t, uptimes[1].Uptime)
+}
+
+func TestHeartbeatHistoryPrune(t *testing.T) {
+	g1 := common.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	start := time.Unix(1_700_000_000, 0)
+	h := NewHeartbeatHistory()
+
+	h.record(g1, "peer-1", newTestHeartbeat(1, "v1", nil), start)
+	h.record(g1, "peer-1", newTestHeartbeat(1, "v1", nil), start.Add(MaxHeartbeatHistoryAge/2))
+	assert.Len(t, h.samples[g1], 2)
+
+	h.record(g1, "peer-1", newTestHeartbeat(1, "v1", nil), start.Add(MaxHeartbeatHistoryAge+HeartbeatHistorySampleInterval))
+	assert.Len(t, h.samples[g1], 2)
+}
+
+func TestGuardianSetStateHistory(t *testing.T) {
+	g1 := common.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	g2 := common.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c")
+	gst := NewGuardianSetState(nil)
+
+	// Heartbeats are only recorded for guardians in the current guardian set.
+	assert.NoError(t, gst.SetHeartbeat(g1, "peer-1", newTestHeartbeat(1, "v1", nil)))
+	gst.Set(NewGuardianSet([]common.Address{g1}, 0))
+	assert.NoError(t, gst.SetHeartbeat(g1, "peer-1", newTestHeartbeat(1, "v1", nil)))
+	assert.NoError(t, gst.SetHeartbeat(g2, "peer-2", newTestHeartbeat(1, "v1", nil)))
+
+	assert.Len(t, gst.History().samples[g1], 1)
+	assert.Empty(t, gst.History().samples[g2])
+}
@@ -2,12 +2,16 @@ package common
 
 import (
 	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 // MsgWithTimeStamp allows us to track the time of receipt of an event.
 type MsgWithTimeStamp[T any] struct {
 	Msg       *T
 	Timestamp time.Time
+	// From is the p2p peer that originated the message, if it was received from gossip.
+	From peer.ID
 }
 
 // CreateMsgWithTimestamp creates a new MsgWithTimeStamp with the current time.
@@ -20,8 +24,15 @@ func CreateMsgWithTimestamp[T any](msg *T) *MsgWithTimeStamp[T] {
 
 // PostMsgWithTimestamp sends the message to the specified channel using the current timestamp. Returns ErrChanFull on error.
 func PostMsgWithTimestamp[T any](msg *T, c chan<- *MsgWithTimeStamp[T]) error {
+	return PostMsgWithTimestampFrom(msg, "", c)
+}
+
+// PostMsgWithTimestampFrom is like PostMsgWithTimestamp, for a message that was originated by the p2p peer from.
+func PostMsgWithTimestampFrom[T any](msg *T, from peer.ID, c chan<- *MsgWithTimeStamp[T]) error {
+	m := CreateMsgWithTimestamp[T](msg)
+	m.From = from
 	select {
-	case c <- CreateMsgWithTimestamp[T](msg):
+	case c <- m:
 		return nil
 	default:
 		return ErrChanFull
@@ -8,8 +8,9 @@ import (
 )
 
 const (
-	ReadinessEthSyncing readiness.Component = "ethSyncing"
-	ReadinessIBCSyncing readiness.Component = "IBCSyncing"
+	ReadinessEthSyncing     readiness.Component = "ethSyncing"
+	ReadinessIBCSyncing     readiness.Component = "IBCSyncing"
+	ReadinessGuardianSigner readiness.Component = "guardianSigner"
 )
 
 // MustRegisterReadinessSyncing registers the specified chain for readiness syncing. It panics if the chain ID is invalid so it should only be used during initialization.
@@ -0,0 +1,125 @@
+package db
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// aggregationStatePrefix is the key prefix of the aggregation state checkpoints, which are keyed by digest.
+const aggregationStatePrefix = "PROC:AGG:"
+
+// AggregationState is a checkpoint of the processor's aggregation state for an observation that this guardian
+// signed, but that has not reached quorum yet. It allows the processor to resume aggregating signatures after a
+// restart, without having to wait for a reobservation.
+type AggregationState struct {
+	// Digest is the signing digest of the VAA.
+	Digest []byte
+	// FirstObserved is the time the digest was first seen.
+	FirstObserved time.Time
+	// NextRetry is the time before which no re-observation request shall be sent.
+	NextRetry time.Time
+	// RetryCtr is the number of re-observation requests that were sent.
+	RetryCtr uint
+	// VAA is our unsigned VAA, as marshalled by vaa.VAA.Marshal.
+	VAA []byte
+	// Unreliable is set if the message can't be reobserved.
+	Unreliable bool
+	// Reobservation is set if the message was the result of a re-observation request.
+	Reobservation bool
+	// TxHash is the hash of the source transaction.
+	TxHash []byte
+	// Signatures are the signatures collected so far, including ours, keyed by guardian address.
+	Signatures map[ethCommon.Address][]byte
+}
+
+func aggregationStateKey(digest []byte) []byte {
+	return []byte(aggregationStatePrefix + hex.EncodeToString(digest))
+}
+
+// CheckpointAggregationStates replaces the stored aggregation states with the given states.
+func (d *Database) CheckpointAggregationStates(states []*AggregationState) error {
+	keep := make(map[string]struct{}, len(states))
+	for _, s := range states {
+		keep[string(aggregationStateKey(s.Digest))] = struct{}{}
+	}
+
+	// Find the checkpoints of the states that are no longer pending.
+	var stale [][]byte
+	prefix := []byte(aggregationStatePrefix)
+	if err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().KeyCopy(nil)
+			if _, ok := keep[string(key)]; !ok {
+				stale = append(stale, key)
+			}
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to list aggregation state checkpoints: %w", err)
+	}
+
+	batch := d.db.NewWriteBatch()
+	defer batch.Cancel()
+
+	for _, key := range stale {
+		if err := batch.Delete(key); err != nil {
+			return fmt.Errorf("failed to delete aggregation state checkpoint: %w", err)
+		}
+	}
+
+	for _, s := range states {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return fmt.Errorf("failed to marshal aggregation state for digest %s: %w", hex.EncodeToString(s.Digest), err)
+		}
+		if err := batch.Set(aggregationStateKey(s.Digest), b); err != nil {
+			return fmt.Errorf("failed to store aggregation state checkpoint: %w", err)
+		}
+	}
+
+	if err := batch.Flush(); err != nil {
+		return fmt.Errorf("failed to commit aggregation state checkpoints: %w", err)
+	}
+
+	return nil
+}
+
+// GetAggregationStates returns the stored aggregation states. Checkpoints that can't be parsed are logged and skipped.
+func (d *Database) GetAggregationStates(logger *zap.Logger) ([]*AggregationState, error) {
+	states := []*AggregationState{}
+	prefix := []byte(aggregationStatePrefix)
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			var s AggregationState
+			if err := json.Unmarshal(val, &s); err != nil {
+				logger.Error("failed to unmarshal aggregation state checkpoint", zap.String("key", string(item.Key())), zap.Error(err))
+				continue
+			}
+			states = append(states, &s)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load aggregation state checkpoints: %w", err)
+	}
+
+	return states, nil
+}
@@ -0,0 +1,62 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestCheckpointAggregationStates(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	v := getVAA()
+	vaaBytes, err := v.Marshal()
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0).UTC()
+	addr := ethCommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	state1 := &AggregationState{
+		Digest:        v.SigningDigest().Bytes(),
+		FirstObserved: now,
+		NextRetry:     now.Add(time.Minute),
+		RetryCtr:      2,
+		VAA:           vaaBytes,
+		Unreliable:    true,
+		TxHash:        []byte{1, 2, 3},
+		Signatures:    map[ethCommon.Address][]byte{addr: {4, 5, 6}},
+	}
+	state2 := &AggregationState{
+		Digest:        []byte{7, 8, 9},
+		FirstObserved: now,
+		VAA:           vaaBytes,
+		Signatures:    map[ethCommon.Address][]byte{},
+	}
+
+	states, err := db.GetAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, states)
+
+	require.NoError(t, db.CheckpointAggregationStates([]*AggregationState{state1, state2}))
+	states, err = db.GetAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	require.Len(t, states, 2)
+	assert.Contains(t, states, state1)
+	assert.Contains(t, states, state2)
+
+	// A checkpoint replaces the previous one.
+	require.NoError(t, db.CheckpointAggregationStates([]*AggregationState{state2}))
+	states, err = db.GetAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Equal(t, []*AggregationState{state2}, states)
+
+	require.NoError(t, db.CheckpointAggregationStates(nil))
+	states, err = db.GetAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
@@ -20,6 +20,9 @@ var storedVaaTotal = promauto.NewCounter(
 
 type Database struct {
 	db *badger.DB
+
+	// vaaIndexes is set if the secondary VAA indexes are enabled (see EnableVAAIndexes).
+	vaaIndexes bool
 }
 
 type VAAID struct {
@@ -68,8 +71,10 @@ func VaaIDFromVAA(v *vaa.VAA) *VAAID {
 }
 
 var (
-	ErrVAANotFound = errors.New("requested VAA not found in store")
-	nullAddr       = vaa.Address{}
+	ErrVAANotFound        = errors.New("requested VAA not found in store")
+	ErrVAAIndexesDisabled = errors.New("VAA indexes are not enabled")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	nullAddr              = vaa.Address{}
 )
 
 func (i *VAAID) Bytes() []byte {
@@ -105,6 +110,13 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 		if err := txn.Set(VaaIDFromVAA(v).Bytes(), b); err != nil {
 			return err
 		}
+		if d.vaaIndexes {
+			for k, val := range indexEntriesForVAA(v, nil) {
+				if err := txn.Set([]byte(k), val); err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	})
 
@@ -121,10 +133,21 @@ func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 // Note that the API takes care of splitting up the slice into the maximum allowed count
 // and size so we don't need to worry about that.
 func (d *Database) StoreSignedVAABatch(vaaBatch []*vaa.VAA) error {
+	return d.StoreSignedVAABatchWithTxHashes(vaaBatch, nil)
+}
+
+// StoreSignedVAABatchWithTxHashes is like StoreSignedVAABatch, but also records the hash of the source transaction
+// of each VAA in the tx hash index (if the VAA indexes are enabled). If txHashes is not nil, it must be the same
+// length as vaaBatch. Nil entries mean the transaction is not known.
+func (d *Database) StoreSignedVAABatchWithTxHashes(vaaBatch []*vaa.VAA, txHashes [][]byte) error {
+	if txHashes != nil && len(txHashes) != len(vaaBatch) {
+		return fmt.Errorf("length of txHashes (%d) does not match the number of VAAs (%d)", len(txHashes), len(vaaBatch))
+	}
+
 	batchTx := d.db.NewWriteBatch()
 	defer batchTx.Cancel()
 
-	for _, v := range vaaBatch {
+	for idx, v := range vaaBatch {
 		if len(v.Signatures) == 0 {
 			panic("StoreSignedVAABatch called for unsigned VAA")
 		}
@@ -138,6 +161,18 @@ func (d *Database) StoreSignedVAABatch(vaaBatch []*vaa.VAA) error {
 		if err != nil {
 			return err
 		}
+
+		if d.vaaIndexes {
+			var txHash []byte
+			if txHashes != nil {
+				txHash = txHashes[idx]
+			}
+			for k, val := range indexEntriesForVAA(v, txHash) {
+				if err := batchTx.Set([]byte(k), val); err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	// Wait for the batch to finish.
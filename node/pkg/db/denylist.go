@@ -0,0 +1,176 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type DenylistDB interface {
+	DenylistStoreEntry(entry *DenylistEntry) error
+	DenylistDeleteEntry(key string) error
+	DenylistStoreHeldMessage(held *DenylistHeldMessage) error
+	DenylistDeleteHeldMessage(msgId string) error
+	DenylistGetData(logger *zap.Logger) ([]*DenylistEntry, []*DenylistHeldMessage, error)
+}
+
+type MockDenylistDB struct {
+}
+
+func (d *MockDenylistDB) DenylistStoreEntry(entry *DenylistEntry) error {
+	return nil
+}
+
+func (d *MockDenylistDB) DenylistDeleteEntry(key string) error {
+	return nil
+}
+
+func (d *MockDenylistDB) DenylistStoreHeldMessage(held *DenylistHeldMessage) error {
+	return nil
+}
+
+func (d *MockDenylistDB) DenylistDeleteHeldMessage(msgId string) error {
+	return nil
+}
+
+func (d *MockDenylistDB) DenylistGetData(logger *zap.Logger) ([]*DenylistEntry, []*DenylistHeldMessage, error) {
+	return nil, nil, nil
+}
+
+const denylistEntryPrefix = "DENY:ENTRY:"
+const denylistHeldPrefix = "DENY:HELD:"
+
+// DenylistEntry refuses the messages of an emitter, or the token bridge transfers of a token or to a recipient.
+type DenylistEntry struct {
+	// Kind is one of "emitter", "token" or "recipient".
+	Kind    string
+	Chain   vaa.ChainID
+	Address vaa.Address
+	// Expiry is the time after which the entry no longer applies. The zero time means it never expires.
+	Expiry time.Time
+	Reason string
+}
+
+// Key uniquely identifies the entry by its kind, chain and address.
+func (e *DenylistEntry) Key() string {
+	return fmt.Sprintf("%s/%d/%s", e.Kind, e.Chain, e.Address)
+}
+
+// DenylistHeldMessage is a message publication that was held because it matched a denylist entry.
+type DenylistHeldMessage struct {
+	Msg    *common.MessagePublication
+	HeldAt time.Time
+	// Reason describes the entry that matched.
+	Reason string
+}
+
+func denylistEntryKey(key string) []byte {
+	return []byte(denylistEntryPrefix + key)
+}
+
+func denylistHeldKey(msgId string) []byte {
+	return []byte(denylistHeldPrefix + msgId)
+}
+
+func (d *Database) DenylistStoreEntry(entry *DenylistEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal denylist entry %s: %w", entry.Key(), err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(denylistEntryKey(entry.Key()), b)
+	}); err != nil {
+		return fmt.Errorf("failed to commit denylist entry %s: %w", entry.Key(), err)
+	}
+
+	return nil
+}
+
+func (d *Database) DenylistDeleteEntry(key string) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(denylistEntryKey(key))
+	}); err != nil {
+		return fmt.Errorf("failed to delete denylist entry %s: %w", key, err)
+	}
+
+	return nil
+}
+
+func (d *Database) DenylistStoreHeldMessage(held *DenylistHeldMessage) error {
+	msgId := held.Msg.MessageIDString()
+	b, err := json.Marshal(held)
+	if err != nil {
+		return fmt.Errorf("failed to marshal denylist held message %s: %w", msgId, err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(denylistHeldKey(msgId), b)
+	}); err != nil {
+		return fmt.Errorf("failed to commit denylist held message %s: %w", msgId, err)
+	}
+
+	return nil
+}
+
+func (d *Database) DenylistDeleteHeldMessage(msgId string) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(denylistHeldKey(msgId))
+	}); err != nil {
+		return fmt.Errorf("failed to delete denylist held message %s: %w", msgId, err)
+	}
+
+	return nil
+}
+
+// DenylistGetData is called by the denylist on start up to reload the entries added through the admin RPC and the
+// held messages. Records that can't be parsed are logged and skipped.
+func (d *Database) DenylistGetData(logger *zap.Logger) ([]*DenylistEntry, []*DenylistHeldMessage, error) {
+	entries := []*DenylistEntry{}
+	held := []*DenylistHeldMessage{}
+
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		prefix := []byte(denylistEntryPrefix)
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var entry DenylistEntry
+			if err := json.Unmarshal(val, &entry); err != nil {
+				logger.Error("failed to unmarshal denylist entry", zap.String("key", string(it.Item().Key())), zap.Error(err))
+				continue
+			}
+			entries = append(entries, &entry)
+		}
+
+		prefix = []byte(denylistHeldPrefix)
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var h DenylistHeldMessage
+			if err := json.Unmarshal(val, &h); err != nil || h.Msg == nil {
+				logger.Error("failed to unmarshal denylist held message", zap.String("key", string(it.Item().Key())), zap.Error(err))
+				continue
+			}
+			held = append(held, &h)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load denylist data: %w", err)
+	}
+
+	return entries, held, nil
+}
@@ -0,0 +1,69 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestDenylistStoreAndDelete(t *testing.T) {
+	logger := zap.NewNop()
+	dbPath := t.TempDir()
+	db := OpenDb(logger, &dbPath)
+	defer db.Close()
+
+	entries, held, err := db.DenylistGetData(logger)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.Empty(t, held)
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+
+	entry := &DenylistEntry{
+		Kind:    "emitter",
+		Chain:   vaa.ChainIDEthereum,
+		Address: emitterAddress,
+		Expiry:  time.Unix(1_800_000_000, 0).UTC(),
+		Reason:  "incident",
+	}
+	assert.Equal(t, "emitter/2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", entry.Key())
+
+	msg := &common.MessagePublication{
+		TxID:             []byte{1, 2, 3},
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Nonce:            1,
+		Sequence:         42,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		Payload:          []byte{4, 5, 6},
+		ConsistencyLevel: 1,
+	}
+	h := &DenylistHeldMessage{Msg: msg, HeldAt: time.Unix(1_700_000_100, 0).UTC(), Reason: "denylisted emitter"}
+
+	require.NoError(t, db.DenylistStoreEntry(entry))
+	require.NoError(t, db.DenylistStoreHeldMessage(h))
+
+	entries, held, err = db.DenylistGetData(logger)
+	require.NoError(t, err)
+	assert.Equal(t, []*DenylistEntry{entry}, entries)
+	require.Len(t, held, 1)
+	assert.Equal(t, h.HeldAt, held[0].HeldAt)
+	assert.Equal(t, h.Reason, held[0].Reason)
+	assert.Equal(t, msg.MessageIDString(), held[0].Msg.MessageIDString())
+	assert.Equal(t, msg.Payload, held[0].Msg.Payload)
+	assert.Equal(t, msg.TxID, held[0].Msg.TxID)
+
+	require.NoError(t, db.DenylistDeleteEntry(entry.Key()))
+	require.NoError(t, db.DenylistDeleteHeldMessage(msg.MessageIDString()))
+
+	entries, held, err = db.DenylistGetData(logger)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+	assert.Empty(t, held)
+}
@@ -0,0 +1,362 @@
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// The export format is a stream of JSON objects, one per line. The first line is a header, followed by one line per
+// database entry and a trailer. Every record carries a SHA-256 checksum of its key and value and the trailer carries
+// a checksum over all record checksums, so truncated or modified exports are detected.
+//
+//	{"header":{"format":"guardiand-db-export","version":1,"created_at":"..."}}
+//	{"record":{"type":"vaa","key":"signed/2/.../1","value":"<base64>","checksum":"<hex>"}}
+//	{"trailer":{"counts":{"vaa":1},"checksum":"<hex>"}}
+//
+// The keys and values are exported exactly as they are stored, so entries in old formats are preserved and get
+// converted by the usual code paths once a guardian starts with the imported database. The secondary VAA indexes are
+// not exported. They are written on import if enabled, otherwise they are backfilled the next time they are enabled.
+
+const ExportFormat = "guardiand-db-export"
+const ExportFormatVersion = 1
+
+// exportImportBatchSize is the number of records written per batch on import.
+const exportImportBatchSize = 1000
+
+type ExportRecordType string
+
+const (
+	ExportRecordVAA               ExportRecordType = "vaa"
+	ExportRecordGovernorTransfer  ExportRecordType = "governor_transfer"
+	ExportRecordGovernorPending   ExportRecordType = "governor_pending"
+	ExportRecordAccountantPending ExportRecordType = "accountant_pending"
+)
+
+// exportPrefixes lists the key spaces included in an export.
+var exportPrefixes = []struct {
+	prefix     string
+	recordType ExportRecordType
+}{
+	{"signed/", ExportRecordVAA},
+	{oldTransfer, ExportRecordGovernorTransfer},
+	{transfer, ExportRecordGovernorTransfer},
+	{oldPending, ExportRecordGovernorPending},
+	{pending, ExportRecordGovernorPending},
+	{acctOldPendingTransfer, ExportRecordAccountantPending},
+	{acctPendingTransfer, ExportRecordAccountantPending},
+}
+
+type ExportHeader struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type ExportRecord struct {
+	Type     ExportRecordType `json:"type"`
+	Key      string           `json:"key"`
+	Value    []byte           `json:"value"`
+	Checksum string           `json:"checksum"`
+}
+
+type ExportTrailer struct {
+	Counts   map[ExportRecordType]int `json:"counts"`
+	Checksum string                   `json:"checksum"`
+}
+
+type exportLine struct {
+	Header  *ExportHeader  `json:"header,omitempty"`
+	Record  *ExportRecord  `json:"record,omitempty"`
+	Trailer *ExportTrailer `json:"trailer,omitempty"`
+}
+
+// ExportSummary describes a complete export.
+type ExportSummary struct {
+	CreatedAt time.Time
+	Counts    map[ExportRecordType]int
+}
+
+func (s *ExportSummary) String() string {
+	return fmt.Sprintf("created at %s, %d VAAs, %d governor transfers, %d governor pending transfers, %d accountant pending transfers",
+		s.CreatedAt.Format(time.RFC3339),
+		s.Counts[ExportRecordVAA],
+		s.Counts[ExportRecordGovernorTransfer],
+		s.Counts[ExportRecordGovernorPending],
+		s.Counts[ExportRecordAccountantPending],
+	)
+}
+
+func exportRecordChecksum(key []byte, value []byte) []byte {
+	h := sha256.New()
+	// The key is length prefixed so that the boundary between key and value is unambiguous.
+	fmt.Fprintf(h, "%d:", len(key))
+	h.Write(key)
+	h.Write(value)
+	return h.Sum(nil)
+}
+
+// Export writes all signed VAAs, governor transfers and pending transfers and accountant pending transfers to w.
+func (d *Database) Export(w io.Writer) (*ExportSummary, error) {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+
+	summary := &ExportSummary{
+		CreatedAt: time.Now().UTC(),
+		Counts:    make(map[ExportRecordType]int),
+	}
+
+	if err := enc.Encode(exportLine{Header: &ExportHeader{Format: ExportFormat, Version: ExportFormatVersion, CreatedAt: summary.CreatedAt}}); err != nil {
+		return nil, err
+	}
+
+	total := sha256.New()
+	err := d.db.View(func(txn *badger.Txn) error {
+		for _, p := range exportPrefixes {
+			prefix := []byte(p.prefix)
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+				item := it.Item()
+				key := item.KeyCopy(nil)
+				val, err := item.ValueCopy(nil)
+				if err != nil {
+					it.Close()
+					return err
+				}
+
+				checksum := exportRecordChecksum(key, val)
+				total.Write(checksum)
+				summary.Counts[p.recordType]++
+
+				if err := enc.Encode(exportLine{Record: &ExportRecord{
+					Type:     p.recordType,
+					Key:      string(key),
+					Value:    val,
+					Checksum: hex.EncodeToString(checksum),
+				}}); err != nil {
+					it.Close()
+					return err
+				}
+			}
+			it.Close()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to export database: %w", err)
+	}
+
+	if err := enc.Encode(exportLine{Trailer: &ExportTrailer{Counts: summary.Counts, Checksum: hex.EncodeToString(total.Sum(nil))}}); err != nil {
+		return nil, err
+	}
+
+	if err := bw.Flush(); err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
+// ReadExport reads an export, checks the header, all checksums and the trailer and validates that every record can be
+// decoded. The callback is invoked for every record in order. Note that the callback may be called for records before
+// a problem later in the stream is detected.
+func ReadExport(r io.Reader, fn func(*ExportRecord) error) (*ExportSummary, error) {
+	dec := json.NewDecoder(bufio.NewReader(r))
+
+	var line exportLine
+	if err := dec.Decode(&line); err != nil {
+		return nil, fmt.Errorf("failed to read export header: %w", err)
+	}
+	if line.Header == nil || line.Header.Format != ExportFormat {
+		return nil, errors.New("not a guardian database export")
+	}
+	if line.Header.Version != ExportFormatVersion {
+		return nil, fmt.Errorf("unsupported export version %d", line.Header.Version)
+	}
+
+	summary := &ExportSummary{
+		CreatedAt: line.Header.CreatedAt,
+		Counts:    make(map[ExportRecordType]int),
+	}
+
+	total := sha256.New()
+	for lineNum := 2; ; lineNum++ {
+		line = exportLine{}
+		if err := dec.Decode(&line); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("export is truncated, the trailer is missing")
+			}
+			return nil, fmt.Errorf("failed to read line %d: %w", lineNum, err)
+		}
+
+		if line.Trailer != nil {
+			if hex.EncodeToString(total.Sum(nil)) != line.Trailer.Checksum {
+				return nil, errors.New("export checksum mismatch")
+			}
+			for _, p := range exportPrefixes {
+				if summary.Counts[p.recordType] != line.Trailer.Counts[p.recordType] {
+					return nil, fmt.Errorf("export contains %d records of type %s, trailer says %d", summary.Counts[p.recordType], p.recordType, line.Trailer.Counts[p.recordType])
+				}
+			}
+			if dec.More() {
+				return nil, errors.New("unexpected data after the export trailer")
+			}
+			return summary, nil
+		}
+
+		rec := line.Record
+		if rec == nil {
+			return nil, fmt.Errorf("line %d is not a record", lineNum)
+		}
+
+		checksum := exportRecordChecksum([]byte(rec.Key), rec.Value)
+		if hex.EncodeToString(checksum) != rec.Checksum {
+			return nil, fmt.Errorf("checksum mismatch for %s", rec.Key)
+		}
+		total.Write(checksum)
+
+		if err := validateExportRecord(rec); err != nil {
+			return nil, fmt.Errorf("invalid record %s: %w", rec.Key, err)
+		}
+		summary.Counts[rec.Type]++
+
+		if fn != nil {
+			if err := fn(rec); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// validateExportRecord checks that the key matches the record type and that the value can be decoded.
+func validateExportRecord(rec *ExportRecord) error {
+	key := []byte(rec.Key)
+
+	matched := false
+	for _, p := range exportPrefixes {
+		if p.recordType == rec.Type && strings.HasPrefix(rec.Key, p.prefix) {
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return fmt.Errorf("unexpected key for record type %s", rec.Type)
+	}
+
+	switch rec.Type {
+	case ExportRecordVAA:
+		v, err := vaa.Unmarshal(rec.Value)
+		if err != nil {
+			return err
+		}
+		if len(v.Signatures) == 0 {
+			return errors.New("VAA is not signed")
+		}
+		if !bytes.Equal(key, VaaIDFromVAA(v).Bytes()) {
+			return errors.New("key does not match the VAA")
+		}
+	case ExportRecordGovernorTransfer:
+		var err error
+		if IsTransfer(key) {
+			_, err = UnmarshalTransfer(rec.Value)
+		} else if isOldTransfer(key) {
+			_, err = unmarshalOldTransfer(rec.Value)
+		} else {
+			err = errors.New("malformed key")
+		}
+		return err
+	case ExportRecordGovernorPending:
+		if !IsPendingMsg(key) && !isOldPendingMsg(key) {
+			return errors.New("malformed key")
+		}
+		_, err := UnmarshalPendingTransfer(rec.Value, isOldPendingMsg(key))
+		return err
+	case ExportRecordAccountantPending:
+		if acctIsPendingTransfer(key) {
+			var msg common.MessagePublication
+			return json.Unmarshal(rec.Value, &msg)
+		} else if acctIsOldPendingTransfer(key) {
+			var msg OldMessagePublication
+			return json.Unmarshal(rec.Value, &msg)
+		}
+		return errors.New("malformed key")
+	}
+
+	return nil
+}
+
+// Import writes the records of an export into the database. Existing entries with the same keys are overwritten. If
+// verifyVAA is not nil, it is called for every VAA and the import fails if it returns an error.
+//
+// Records are written in batches while the export is read, so an import that fails part way leaves the records before
+// the failure in the database. Run ReadExport over the export first to catch corrupted files before importing.
+//
+// If the VAA indexes are not enabled, the imported VAAs are not indexed and the index version is cleared so that
+// they are backfilled the next time the indexes are enabled.
+func (d *Database) Import(r io.Reader, verifyVAA func(*vaa.VAA) error) (*ExportSummary, error) {
+	batch := d.db.NewWriteBatch()
+	defer func() { batch.Cancel() }()
+	count := 0
+
+	summary, err := ReadExport(r, func(rec *ExportRecord) error {
+		if rec.Type == ExportRecordVAA {
+			// The record was validated by ReadExport, so this can't fail.
+			v, err := vaa.Unmarshal(rec.Value)
+			if err != nil {
+				return err
+			}
+			if verifyVAA != nil {
+				if err := verifyVAA(v); err != nil {
+					return fmt.Errorf("failed to verify VAA %s: %w", v.MessageID(), err)
+				}
+			}
+			if d.vaaIndexes {
+				for k, val := range indexEntriesForVAA(v, nil) {
+					if err := batch.Set([]byte(k), val); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if err := batch.Set([]byte(rec.Key), rec.Value); err != nil {
+			return err
+		}
+
+		count++
+		if count >= exportImportBatchSize {
+			if err := batch.Flush(); err != nil {
+				return err
+			}
+			batch = d.db.NewWriteBatch()
+			count = 0
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := batch.Flush(); err != nil {
+		return nil, err
+	}
+
+	if !d.vaaIndexes && summary.Counts[ExportRecordVAA] != 0 {
+		if _, err := d.clearVAAIndexVersion(); err != nil {
+			return nil, fmt.Errorf("failed to clear VAA index version: %w", err)
+		}
+	}
+
+	return summary, nil
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// populateExportTestDB stores two VAAs, a governor transfer, a governor pending transfer and an accountant pending transfer.
+func populateExportTestDB(t *testing.T, db *Database) []*vaa.VAA {
+	t.Helper()
+
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+
+	vaas := []*vaa.VAA{}
+	for seq := uint64(1); seq <= 2; seq++ {
+		v := getVAAWithSeqNum(seq)
+		v.AddSignature(privKey, 0)
+		require.NoError(t, db.StoreSignedVAA(&v))
+		vaas = append(vaas, &v)
+	}
+
+	tokenBridgeAddr, err := vaa.StringToAddress("0x0290fb167208af455bb137780163b7b7a9a10c16")
+	require.NoError(t, err)
+
+	require.NoError(t, db.StoreTransfer(&Transfer{
+		Timestamp:      time.Unix(int64(1654516425), 0),
+		Value:          125000,
+		OriginChain:    vaa.ChainIDEthereum,
+		OriginAddress:  tokenBridgeAddr,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridgeAddr,
+		TargetChain:    vaa.ChainIDBSC,
+		TargetAddress:  tokenBridgeAddr,
+		MsgID:          "2/0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16/789101112131415",
+		Hash:           "Hash1",
+	}))
+
+	msg := &common.MessagePublication{
+		TxID:             eth_common.HexToHash("0x06f541f5ecfc43407c31587aa6ac3a689e8960f36dc23c332db5510dfc6a4063").Bytes(),
+		Timestamp:        time.Unix(int64(1654516425), 0),
+		Nonce:            123456,
+		Sequence:         789101112131416,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   tokenBridgeAddr,
+		Payload:          []byte{4, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		ConsistencyLevel: 16,
+	}
+	require.NoError(t, db.StorePendingMsg(&PendingTransfer{ReleaseTime: msg.Timestamp.Add(time.Hour * 72), Msg: *msg}))
+	require.NoError(t, db.AcctStorePendingTransfer(msg))
+
+	return vaas
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	srcPath := t.TempDir()
+	src := OpenDb(zap.NewNop(), &srcPath)
+	defer src.Close()
+	vaas := populateExportTestDB(t, src)
+
+	var buf bytes.Buffer
+	summary, err := src.Export(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.Counts[ExportRecordVAA])
+	assert.Equal(t, 1, summary.Counts[ExportRecordGovernorTransfer])
+	assert.Equal(t, 1, summary.Counts[ExportRecordGovernorPending])
+	assert.Equal(t, 1, summary.Counts[ExportRecordAccountantPending])
+
+	readSummary, err := ReadExport(bytes.NewReader(buf.Bytes()), nil)
+	require.NoError(t, err)
+	assert.Equal(t, summary.Counts, readSummary.Counts)
+
+	dstPath := t.TempDir()
+	dst := OpenDb(zap.NewNop(), &dstPath)
+	defer dst.Close()
+	require.NoError(t, dst.EnableVAAIndexes(zap.NewNop()))
+
+	numVerified := 0
+	_, err = dst.Import(bytes.NewReader(buf.Bytes()), func(v *vaa.VAA) error {
+		numVerified++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, numVerified)
+
+	for _, v := range vaas {
+		expected, err := v.Marshal()
+		require.NoError(t, err)
+		b, err := dst.GetSignedVAABytes(*VaaIDFromVAA(v))
+		require.NoError(t, err)
+		assert.Equal(t, expected, b)
+
+		// The indexes are rebuilt on import.
+		b, err = dst.GetSignedVAABytesByDigest(v.SigningDigest().Bytes())
+		require.NoError(t, err)
+		assert.Equal(t, expected, b)
+	}
+
+	transfers, pending, err := dst.GetChainGovernorData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Len(t, transfers, 1)
+	assert.Len(t, pending, 1)
+
+	acct, err := dst.AcctGetData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Len(t, acct, 1)
+
+	// Exporting the imported database gives the same records.
+	var buf2 bytes.Buffer
+	_, err = dst.Export(&buf2)
+	require.NoError(t, err)
+	assert.Equal(t, strings.SplitN(buf.String(), "\n", 2)[1], strings.SplitN(buf2.String(), "\n", 2)[1])
+}
+
+func TestImportVerificationFailure(t *testing.T) {
+	srcPath := t.TempDir()
+	src := OpenDb(zap.NewNop(), &srcPath)
+	defer src.Close()
+	populateExportTestDB(t, src)
+
+	var buf bytes.Buffer
+	_, err := src.Export(&buf)
+	require.NoError(t, err)
+
+	dstPath := t.TempDir()
+	dst := OpenDb(zap.NewNop(), &dstPath)
+	defer dst.Close()
+
+	errBadSignature := errors.New("bad signature")
+	_, err = dst.Import(bytes.NewReader(buf.Bytes()), func(v *vaa.VAA) error {
+		return errBadSignature
+	})
+	assert.ErrorIs(t, err, errBadSignature)
+}
+
+func TestImportWithoutIndexes(t *testing.T) {
+	srcPath := t.TempDir()
+	src := OpenDb(zap.NewNop(), &srcPath)
+	defer src.Close()
+	vaas := populateExportTestDB(t, src)
+
+	var buf bytes.Buffer
+	_, err := src.Export(&buf)
+	require.NoError(t, err)
+
+	// The destination had the indexes enabled before, but the import runs without them.
+	dstPath := t.TempDir()
+	dst := OpenDb(zap.NewNop(), &dstPath)
+	defer dst.Close()
+	require.NoError(t, dst.EnableVAAIndexes(zap.NewNop()))
+	dst.vaaIndexes = false
+
+	_, err = dst.Import(bytes.NewReader(buf.Bytes()), nil)
+	require.NoError(t, err)
+
+	// The imported VAAs are indexed once the indexes are enabled again.
+	require.NoError(t, dst.EnableVAAIndexes(zap.NewNop()))
+	for _, v := range vaas {
+		_, err := dst.GetSignedVAABytesByDigest(v.SigningDigest().Bytes())
+		require.NoError(t, err)
+	}
+}
+
+func TestReadExportDetectsCorruption(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+	populateExportTestDB(t, db)
+
+	var buf bytes.Buffer
+	_, err := db.Export(&buf)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 7)
+
+	// Missing trailer.
+	_, err = ReadExport(strings.NewReader(strings.Join(lines[:len(lines)-1], "\n")), nil)
+	assert.ErrorContains(t, err, "truncated")
+
+	// Missing record.
+	dropped := append(append([]string{}, lines[:1]...), lines[2:]...)
+	_, err = ReadExport(strings.NewReader(strings.Join(dropped, "\n")), nil)
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	// Modified record.
+	modified := append([]string{}, lines...)
+	modified[1] = strings.Replace(modified[1], `"checksum":"`, `"checksum":"00`, 1)
+	_, err = ReadExport(strings.NewReader(strings.Join(modified, "\n")), nil)
+	assert.ErrorContains(t, err, "checksum mismatch")
+
+	// Not an export.
+	_, err = ReadExport(strings.NewReader(`{"header":{"format":"something else","version":1}}`), nil)
+	assert.ErrorContains(t, err, "not a guardian database export")
+
+	// Unsupported version.
+	_, err = ReadExport(strings.NewReader(`{"header":{"format":"guardiand-db-export","version":2}}`), nil)
+	assert.ErrorContains(t, err, "unsupported export version")
+}
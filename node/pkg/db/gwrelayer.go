@@ -0,0 +1,110 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"go.uber.org/zap"
+)
+
+type GatewayRelayerDB interface {
+	GatewayRelayerStoreEntry(entry *GatewayRelayerQueueEntry) error
+	GatewayRelayerDeleteEntry(msgId string) error
+	GatewayRelayerGetQueue(logger *zap.Logger) ([]*GatewayRelayerQueueEntry, error)
+}
+
+type MockGatewayRelayerDB struct {
+}
+
+func (d *MockGatewayRelayerDB) GatewayRelayerStoreEntry(entry *GatewayRelayerQueueEntry) error {
+	return nil
+}
+
+func (d *MockGatewayRelayerDB) GatewayRelayerDeleteEntry(msgId string) error {
+	return nil
+}
+
+func (d *MockGatewayRelayerDB) GatewayRelayerGetQueue(logger *zap.Logger) ([]*GatewayRelayerQueueEntry, error) {
+	return nil, nil
+}
+
+const gatewayRelayerQueuePrefix = "GWR:QUEUE:"
+
+// GatewayRelayerQueueEntry is a VAA waiting to be submitted to a contract on wormchain by the gateway relayer.
+type GatewayRelayerQueueEntry struct {
+	MessageID string
+	// VAA is the serialized signed VAA.
+	VAA             []byte
+	ContractAddress string
+	VaaType         uint8
+	EnqueuedAt      time.Time
+	// Attempts is the number of failed submissions, and NextAttempt the time before which it isn't submitted again.
+	Attempts    int
+	NextAttempt time.Time
+	LastError   string
+	// Failed is set once the submission was rejected or ran out of attempts. It isn't retried until it is replayed.
+	Failed bool
+}
+
+func gatewayRelayerQueueKey(msgId string) []byte {
+	return []byte(gatewayRelayerQueuePrefix + msgId)
+}
+
+func (d *Database) GatewayRelayerStoreEntry(entry *GatewayRelayerQueueEntry) error {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal gateway relayer queue entry %s: %w", entry.MessageID, err)
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(gatewayRelayerQueueKey(entry.MessageID), b)
+	}); err != nil {
+		return fmt.Errorf("failed to commit gateway relayer queue entry %s: %w", entry.MessageID, err)
+	}
+
+	return nil
+}
+
+func (d *Database) GatewayRelayerDeleteEntry(msgId string) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(gatewayRelayerQueueKey(msgId))
+	}); err != nil {
+		return fmt.Errorf("failed to delete gateway relayer queue entry %s: %w", msgId, err)
+	}
+
+	return nil
+}
+
+// GatewayRelayerGetQueue is called by the gateway relayer on start up to reload the VAAs that weren't submitted yet.
+// Records that can't be parsed are logged and skipped.
+func (d *Database) GatewayRelayerGetQueue(logger *zap.Logger) ([]*GatewayRelayerQueueEntry, error) {
+	entries := []*GatewayRelayerQueueEntry{}
+
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		prefix := []byte(gatewayRelayerQueuePrefix)
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			val, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			var entry GatewayRelayerQueueEntry
+			if err := json.Unmarshal(val, &entry); err != nil {
+				logger.Error("failed to unmarshal gateway relayer queue entry", zap.String("key", string(it.Item().Key())), zap.Error(err))
+				continue
+			}
+			entries = append(entries, &entry)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load gateway relayer queue: %w", err)
+	}
+
+	return entries, nil
+}
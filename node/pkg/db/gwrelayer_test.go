@@ -0,0 +1,54 @@
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestGatewayRelayerStoreAndDelete(t *testing.T) {
+	logger := zap.NewNop()
+	dbPath := t.TempDir()
+	db := OpenDb(logger, &dbPath)
+	defer db.Close()
+
+	entries, err := db.GatewayRelayerGetQueue(logger)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	entry1 := &GatewayRelayerQueueEntry{
+		MessageID:       "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/1",
+		VAA:             []byte{1, 2, 3},
+		ContractAddress: "wormhole1contract",
+		VaaType:         1,
+		EnqueuedAt:      time.Unix(1_700_000_000, 0).UTC(),
+	}
+	entry2 := &GatewayRelayerQueueEntry{
+		MessageID:       "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/2",
+		VAA:             []byte{4, 5, 6},
+		ContractAddress: "wormhole1contract",
+		EnqueuedAt:      time.Unix(1_700_000_100, 0).UTC(),
+		Attempts:        3,
+		NextAttempt:     time.Unix(1_700_000_200, 0).UTC(),
+		LastError:       "failed to send broadcast",
+	}
+
+	require.NoError(t, db.GatewayRelayerStoreEntry(entry1))
+	require.NoError(t, db.GatewayRelayerStoreEntry(entry2))
+
+	entries, err = db.GatewayRelayerGetQueue(logger)
+	require.NoError(t, err)
+	assert.Equal(t, []*GatewayRelayerQueueEntry{entry1, entry2}, entries)
+
+	// Storing an entry again updates it.
+	entry1.Failed = true
+	require.NoError(t, db.GatewayRelayerStoreEntry(entry1))
+	require.NoError(t, db.GatewayRelayerDeleteEntry(entry2.MessageID))
+
+	entries, err = db.GatewayRelayerGetQueue(logger)
+	require.NoError(t, err)
+	assert.Equal(t, []*GatewayRelayerQueueEntry{entry1}, entries)
+}
@@ -1,6 +1,8 @@
 package db
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
@@ -20,7 +22,13 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 	numDeleted := 0
 	numKept := 0
 
-	if err := d.db.View(func(txn *badger.Txn) error {
+	if d.vaaIndexes {
+		var err error
+		numDeleted, numKept, err = d.purgeVaasUsingIndex(prefix, oldestTime, logOnly)
+		if err != nil {
+			return "", err
+		}
+	} else if err := d.db.View(func(txn *badger.Txn) error {
 		it := txn.NewIterator(badger.DefaultIteratorOptions)
 		defer it.Close()
 		prefix := prefix.EmitterPrefixBytes()
@@ -37,8 +45,7 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 					numDeleted++
 					if !logOnly {
 						if err := d.db.Update(func(txn *badger.Txn) error {
-							err := txn.Delete(key)
-							return err
+							return d.deleteVAAWithIndexes(txn, key, v)
 						}); err != nil {
 							return fmt.Errorf("failed to delete vaa for key [%v]: %w", key, err)
 						}
@@ -78,3 +85,82 @@ func (d *Database) PurgeVaas(prefix VAAID, oldestTime time.Time, logOnly bool) (
 
 	return ret, nil
 }
+
+// purgeVaasUsingIndex is the implementation of PurgeVaas used when the VAA indexes are enabled. Rather than reading
+// every VAA for the emitter, it walks the time index up to oldestTime and only reads the VAAs that are candidates for
+// deletion. The number of kept VAAs is determined by a key-only iteration.
+func (d *Database) purgeVaasUsingIndex(prefix VAAID, oldestTime time.Time, logOnly bool) (numDeleted int, numKept int, err error) {
+	emitterPrefix := prefix.EmitterPrefixBytes()
+	matchesPrefix := func(primary []byte) bool {
+		// The emitter prefix is either "signed/<chain>" or "signed/<chain>/<emitter>", so make sure that we don't match other chains with the same leading digits.
+		return bytes.HasPrefix(primary, emitterPrefix) && len(primary) > len(emitterPrefix) && primary[len(emitterPrefix)] == '/'
+	}
+
+	type candidate struct {
+		key []byte
+		v   *vaa.VAA
+	}
+	candidates := []candidate{}
+
+	numTotal := 0
+	err = d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(emitterPrefix); it.ValidForPrefix(emitterPrefix); it.Next() {
+			if matchesPrefix(it.Item().Key()) {
+				numTotal++
+			}
+		}
+
+		end := vaaIdxTimePrefix(oldestTime)
+		idxPrefix := []byte(vaaIdxTime)
+		idxIt := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer idxIt.Close()
+		for idxIt.Seek(idxPrefix); idxIt.ValidForPrefix(idxPrefix); idxIt.Next() {
+			item := idxIt.Item()
+			if bytes.Compare(item.Key(), end) >= 0 {
+				break
+			}
+			primary, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if !matchesPrefix(primary) {
+				continue
+			}
+			b, err := getValue(txn, primary)
+			if err != nil {
+				if errors.Is(err, badger.ErrKeyNotFound) {
+					continue
+				}
+				return err
+			}
+			v, err := vaa.Unmarshal(b)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(primary), err)
+			}
+			// The index has a resolution of one second, so double check the exact timestamp.
+			if v.Timestamp.Before(oldestTime) {
+				candidates = append(candidates, candidate{key: primary, v: v})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !logOnly {
+		for _, c := range candidates {
+			if err := d.db.Update(func(txn *badger.Txn) error {
+				return d.deleteVAAWithIndexes(txn, c.key, c.v)
+			}); err != nil {
+				return 0, 0, fmt.Errorf("failed to delete vaa for key [%v]: %w", c.key, err)
+			}
+		}
+	}
+
+	return len(candidates), numTotal - len(candidates), nil
+}
@@ -0,0 +1,374 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+var (
+	retentionDeletedVaas = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_db_retention_deleted_vaas_total",
+			Help: "Total number of VAAs deleted by the retention policy",
+		}, []string{"emitter_chain"})
+	retentionKeptVaas = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_db_retention_kept_vaas",
+			Help: "Number of VAAs covered by a retention rule that were kept during the last run",
+		}, []string{"emitter_chain"})
+	retentionLastRun = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_db_retention_last_run_timestamp",
+			Help: "Unix timestamp of the last completed run of the retention policy",
+		})
+)
+
+// DefaultRetentionInterval is how often the retention policy is applied if the policy does not specify an interval.
+const DefaultRetentionInterval = 24 * time.Hour
+
+// retentionDeleteBatchSize is the number of VAAs deleted per database transaction.
+const retentionDeleteBatchSize = 1000
+
+// RetentionRule defines how long the VAAs of a chain or of a single emitter are kept.
+type RetentionRule struct {
+	EmitterChain vaa.ChainID
+	// EmitterAddress restricts the rule to a single emitter. If nil, the rule applies to all emitters on the chain that
+	// are not covered by an emitter specific rule.
+	EmitterAddress *vaa.Address
+	// MaxAge is the maximum age of a VAA, based on the VAA timestamp. Zero means no limit.
+	MaxAge time.Duration
+	// MaxCount is the maximum number of VAAs kept per emitter. The VAAs with the highest sequence numbers are kept. Zero means no limit.
+	MaxCount uint64
+	// KeepGovernance prevents governance VAAs from being deleted by this rule.
+	KeepGovernance bool
+}
+
+func (r *RetentionRule) String() string {
+	s := r.EmitterChain.String()
+	if r.EmitterAddress != nil {
+		s += "/" + r.EmitterAddress.String()
+	}
+	return s
+}
+
+// prefix returns the key prefix of the VAAs covered by the rule. It ends with a slash so that for example chain 2
+// does not match chain 23.
+func (r *RetentionRule) prefix() []byte {
+	if r.EmitterAddress != nil {
+		return []byte(fmt.Sprintf("signed/%d/%s/", r.EmitterChain, r.EmitterAddress))
+	}
+	return []byte(fmt.Sprintf("signed/%d/", r.EmitterChain))
+}
+
+// RetentionPolicy is a set of retention rules that is applied periodically by the node.
+type RetentionPolicy struct {
+	Interval time.Duration
+	Rules    []RetentionRule
+}
+
+// RetentionResult reports what a retention rule deleted.
+type RetentionResult struct {
+	Rule       RetentionRule
+	NumDeleted int
+	NumKept    int
+}
+
+// retentionPolicyConfig is the JSON representation of a RetentionPolicy.
+type retentionPolicyConfig struct {
+	Interval string                `json:"interval"`
+	Rules    []retentionRuleConfig `json:"rules"`
+}
+
+type retentionRuleConfig struct {
+	// EmitterChain may be a chain name or a chain ID.
+	EmitterChain   string `json:"emitterChain"`
+	EmitterAddress string `json:"emitterAddress"`
+	MaxAge         string `json:"maxAge"`
+	MaxCount       uint64 `json:"maxCount"`
+	KeepGovernance bool   `json:"keepGovernance"`
+}
+
+// LoadRetentionPolicy reads a retention policy from a JSON file.
+func LoadRetentionPolicy(path string) (*RetentionPolicy, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read retention policy: %w", err)
+	}
+	return ParseRetentionPolicy(data)
+}
+
+// ParseRetentionPolicy parses a retention policy in the following JSON format:
+//
+//	{
+//	  "interval": "24h",
+//	  "rules": [
+//	    {"emitterChain": "pythnet", "maxAge": "720h"},
+//	    {"emitterChain": "solana", "emitterAddress": "ec7372995d5cc8732397fb0ad35c0121e0eaa90d26f828a534cab54391b3a4f5", "maxCount": 100000, "keepGovernance": true}
+//	  ]
+//	}
+func ParseRetentionPolicy(data []byte) (*RetentionPolicy, error) {
+	var cfg retentionPolicyConfig
+	dec := json.NewDecoder(strings.NewReader(string(data)))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse retention policy: %w", err)
+	}
+
+	policy := &RetentionPolicy{Interval: DefaultRetentionInterval}
+	if cfg.Interval != "" {
+		interval, err := time.ParseDuration(cfg.Interval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid interval: %w", err)
+		}
+		policy.Interval = interval
+	}
+
+	for idx, rc := range cfg.Rules {
+		rule := RetentionRule{MaxCount: rc.MaxCount, KeepGovernance: rc.KeepGovernance}
+
+		chainID, err := vaa.ChainIDFromString(rc.EmitterChain)
+		if err != nil {
+			num, numErr := strconv.ParseUint(rc.EmitterChain, 10, 16)
+			if numErr != nil {
+				return nil, fmt.Errorf("invalid emitter chain %q in rule %d", rc.EmitterChain, idx)
+			}
+			chainID = vaa.ChainID(num)
+		}
+		rule.EmitterChain = chainID
+
+		if rc.EmitterAddress != "" {
+			addr, err := vaa.StringToAddress(rc.EmitterAddress)
+			if err != nil {
+				return nil, fmt.Errorf("invalid emitter address in rule %d: %w", idx, err)
+			}
+			rule.EmitterAddress = &addr
+		}
+
+		if rc.MaxAge != "" {
+			rule.MaxAge, err = time.ParseDuration(rc.MaxAge)
+			if err != nil {
+				return nil, fmt.Errorf("invalid max age in rule %d: %w", idx, err)
+			}
+		}
+
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Validate checks that every rule limits something and that no chain or emitter is covered by more than one rule.
+func (p *RetentionPolicy) Validate() error {
+	if p.Interval <= 0 {
+		return fmt.Errorf("retention interval must be positive")
+	}
+
+	seen := make(map[string]struct{})
+	for _, rule := range p.Rules {
+		if rule.EmitterChain == vaa.ChainIDUnset {
+			return fmt.Errorf("retention rule must specify an emitter chain")
+		}
+		if rule.MaxAge < 0 {
+			return fmt.Errorf("retention rule %s has a negative max age", rule.String())
+		}
+		if rule.MaxAge == 0 && rule.MaxCount == 0 {
+			return fmt.Errorf("retention rule %s must specify a max age or a max count", rule.String())
+		}
+		if _, exists := seen[rule.String()]; exists {
+			return fmt.Errorf("duplicate retention rule for %s", rule.String())
+		}
+		seen[rule.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// ApplyRetentionPolicy applies all rules of the policy. If dryRun is set, nothing is deleted but the results report
+// what would have been deleted.
+func (d *Database) ApplyRetentionPolicy(policy *RetentionPolicy, now time.Time, dryRun bool) ([]RetentionResult, error) {
+	// Emitter specific rules take precedence over chain wide rules.
+	overridden := make(map[vaa.ChainID]map[vaa.Address]struct{})
+	for _, rule := range policy.Rules {
+		if rule.EmitterAddress != nil {
+			if overridden[rule.EmitterChain] == nil {
+				overridden[rule.EmitterChain] = make(map[vaa.Address]struct{})
+			}
+			overridden[rule.EmitterChain][*rule.EmitterAddress] = struct{}{}
+		}
+	}
+
+	results := make([]RetentionResult, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		var skip map[vaa.Address]struct{}
+		if rule.EmitterAddress == nil {
+			skip = overridden[rule.EmitterChain]
+		}
+
+		result, err := d.applyRetentionRule(rule, skip, now, dryRun)
+		if err != nil {
+			return results, fmt.Errorf("failed to apply retention rule %s: %w", rule.String(), err)
+		}
+		results = append(results, result)
+
+		if !dryRun {
+			retentionDeletedVaas.WithLabelValues(rule.EmitterChain.String()).Add(float64(result.NumDeleted))
+			retentionKeptVaas.WithLabelValues(rule.EmitterChain.String()).Set(float64(result.NumKept))
+		}
+	}
+
+	if !dryRun {
+		retentionLastRun.Set(float64(now.Unix()))
+	}
+
+	return results, nil
+}
+
+// retentionCandidate is a VAA that is going to be deleted.
+type retentionCandidate struct {
+	key []byte
+	v   *vaa.VAA
+}
+
+// applyRetentionRule applies a single rule. VAAs of emitters in skip are left alone.
+func (d *Database) applyRetentionRule(rule RetentionRule, skip map[vaa.Address]struct{}, now time.Time, dryRun bool) (RetentionResult, error) {
+	result := RetentionResult{Rule: rule}
+	prefix := rule.prefix()
+
+	var minSequence map[vaa.Address]uint64
+	if rule.MaxCount != 0 {
+		var err error
+		minSequence, err = d.retentionMinSequences(prefix, rule.MaxCount)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	var oldestTime time.Time
+	if rule.MaxAge != 0 {
+		oldestTime = now.Add(-rule.MaxAge)
+	}
+
+	candidates := make([]retentionCandidate, 0, retentionDeleteBatchSize)
+	deleteCandidates := func() error {
+		if dryRun || len(candidates) == 0 {
+			candidates = candidates[:0]
+			return nil
+		}
+		err := d.db.Update(func(txn *badger.Txn) error {
+			for _, c := range candidates {
+				if err := d.deleteVAAWithIndexes(txn, c.key, c.v); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		candidates = candidates[:0]
+		return err
+	}
+
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			v, err := vaa.Unmarshal(val)
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(key), err)
+			}
+
+			if _, exists := skip[v.EmitterAddress]; exists {
+				continue
+			}
+
+			expired := !oldestTime.IsZero() && v.Timestamp.Before(oldestTime)
+			if min, exists := minSequence[v.EmitterAddress]; exists && v.Sequence < min {
+				expired = true
+			}
+			if expired && rule.KeepGovernance && isGovernanceVAA(v) {
+				expired = false
+			}
+
+			if !expired {
+				result.NumKept++
+				continue
+			}
+
+			result.NumDeleted++
+			candidates = append(candidates, retentionCandidate{key: key, v: v})
+			if len(candidates) >= retentionDeleteBatchSize {
+				if err := deleteCandidates(); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if err := deleteCandidates(); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// retentionMinSequences returns, for every emitter under the prefix with more than maxCount VAAs, the lowest sequence
+// number that is kept. Sequence numbers are not zero padded in the keys, so they have to be sorted numerically.
+func (d *Database) retentionMinSequences(prefix []byte, maxCount uint64) (map[vaa.Address]uint64, error) {
+	sequences := make(map[vaa.Address][]uint64)
+	err := d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			key := string(it.Item().Key())
+			id, err := VaaIDFromString(strings.TrimPrefix(key, "signed/"))
+			if err != nil {
+				return fmt.Errorf("failed to parse key %s: %w", key, err)
+			}
+			sequences[id.EmitterAddress] = append(sequences[id.EmitterAddress], id.Sequence)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	minSequence := make(map[vaa.Address]uint64)
+	for addr, seqs := range sequences {
+		if uint64(len(seqs)) <= maxCount {
+			continue
+		}
+		sort.Slice(seqs, func(i, j int) bool { return seqs[i] > seqs[j] })
+		minSequence[addr] = seqs[maxCount-1]
+	}
+
+	return minSequence, nil
+}
+
+func isGovernanceVAA(v *vaa.VAA) bool {
+	return v.EmitterChain == vaa.GovernanceChain && v.EmitterAddress == vaa.GovernanceEmitter
+}
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var retentionTestEmitter = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 5}
+
+func storeRetentionTestVAA(t *testing.T, db *Database, chain vaa.ChainID, emitter vaa.Address, seq uint64, timestamp time.Time) *vaa.VAA {
+	t.Helper()
+	v := getVAAWithSeqNum(seq)
+	v.EmitterChain = chain
+	v.EmitterAddress = emitter
+	v.Timestamp = timestamp
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v.AddSignature(privKey, 0)
+	require.NoError(t, db.StoreSignedVAA(&v))
+	return &v
+}
+
+func vaaExists(t *testing.T, db *Database, v *vaa.VAA) bool {
+	t.Helper()
+	exists, err := db.HasVAA(*VaaIDFromVAA(v))
+	require.NoError(t, err)
+	return exists
+}
+
+func TestParseRetentionPolicy(t *testing.T) {
+	policy, err := ParseRetentionPolicy([]byte(`{
+		"interval": "1h",
+		"rules": [
+			{"emitterChain": "pythnet", "maxAge": "720h"},
+			{"emitterChain": "1", "emitterAddress": "0000000000000000000000000000000000000000000000000000000000000004", "maxCount": 10, "keepGovernance": true}
+		]
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, time.Hour, policy.Interval)
+	require.Len(t, policy.Rules, 2)
+
+	assert.Equal(t, vaa.ChainIDPythNet, policy.Rules[0].EmitterChain)
+	assert.Nil(t, policy.Rules[0].EmitterAddress)
+	assert.Equal(t, 720*time.Hour, policy.Rules[0].MaxAge)
+
+	assert.Equal(t, vaa.ChainIDSolana, policy.Rules[1].EmitterChain)
+	require.NotNil(t, policy.Rules[1].EmitterAddress)
+	assert.Equal(t, vaa.GovernanceEmitter, *policy.Rules[1].EmitterAddress)
+	assert.Equal(t, uint64(10), policy.Rules[1].MaxCount)
+	assert.True(t, policy.Rules[1].KeepGovernance)
+
+	policy, err = ParseRetentionPolicy([]byte(`{"rules": [{"emitterChain": "pythnet", "maxAge": "1h"}]}`))
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRetentionInterval, policy.Interval)
+
+	_, err = ParseRetentionPolicy([]byte(`{"rules": [{"emitterChain": "pythnet"}]}`))
+	assert.ErrorContains(t, err, "must specify a max age or a max count")
+
+	_, err = ParseRetentionPolicy([]byte(`{"rules": [{"emitterChain": "pythnet", "maxAge": "1h"}, {"emitterChain": "26", "maxCount": 1}]}`))
+	assert.ErrorContains(t, err, "duplicate retention rule")
+
+	_, err = ParseRetentionPolicy([]byte(`{"rules": [{"emitterChain": "notachain", "maxAge": "1h"}]}`))
+	assert.ErrorContains(t, err, "invalid emitter chain")
+
+	_, err = ParseRetentionPolicy([]byte(`{"rules": [{"emitterChain": "pythnet", "maxAge": "1h", "unknown": true}]}`))
+	assert.Error(t, err)
+}
+
+func TestRetentionMaxAge(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	now := time.Unix(1_000_000, 0)
+	old := storeRetentionTestVAA(t, db, vaa.ChainIDEthereum, retentionTestEmitter, 1, now.Add(-2*time.Hour))
+	recent := storeRetentionTestVAA(t, db, vaa.ChainIDEthereum, retentionTestEmitter, 2, now.Add(-30*time.Minute))
+	// Chain 23 must not be matched by a rule for chain 2.
+	otherChain := storeRetentionTestVAA(t, db, vaa.ChainIDArbitrum, retentionTestEmitter, 1, now.Add(-2*time.Hour))
+
+	policy := &RetentionPolicy{Interval: time.Hour, Rules: []RetentionRule{{EmitterChain: vaa.ChainIDEthereum, MaxAge: time.Hour}}}
+
+	// A dry run reports but does not delete.
+	results, err := db.ApplyRetentionPolicy(policy, now, true)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, 1, results[0].NumDeleted)
+	assert.Equal(t, 1, results[0].NumKept)
+	assert.True(t, vaaExists(t, db, old))
+
+	results, err = db.ApplyRetentionPolicy(policy, now, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, results[0].NumDeleted)
+	assert.False(t, vaaExists(t, db, old))
+	assert.True(t, vaaExists(t, db, recent))
+	assert.True(t, vaaExists(t, db, otherChain))
+}
+
+func TestRetentionMaxCount(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	now := time.Unix(1_000_000, 0)
+	vaas := []*vaa.VAA{}
+	// Sequences 8 to 11 make sure the sequences are compared numerically rather than lexicographically.
+	for seq := uint64(8); seq <= 11; seq++ {
+		vaas = append(vaas, storeRetentionTestVAA(t, db, vaa.ChainIDEthereum, retentionTestEmitter, seq, now))
+	}
+	otherEmitter := storeRetentionTestVAA(t, db, vaa.ChainIDEthereum, vaa.GovernanceEmitter, 1, now)
+
+	policy := &RetentionPolicy{Interval: time.Hour, Rules: []RetentionRule{{EmitterChain: vaa.ChainIDEthereum, MaxCount: 2}}}
+	results, err := db.ApplyRetentionPolicy(policy, now, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, results[0].NumDeleted)
+	assert.Equal(t, 3, results[0].NumKept)
+
+	assert.False(t, vaaExists(t, db, vaas[0]))
+	assert.False(t, vaaExists(t, db, vaas[1]))
+	assert.True(t, vaaExists(t, db, vaas[2]))
+	assert.True(t, vaaExists(t, db, vaas[3]))
+	assert.True(t, vaaExists(t, db, otherEmitter))
+}
+
+func TestRetentionKeepGovernanceAndEmitterOverride(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	now := time.Unix(1_000_000, 0)
+	old := now.Add(-48 * time.Hour)
+	governance := storeRetentionTestVAA(t, db, vaa.GovernanceChain, vaa.GovernanceEmitter, 1, old)
+	overridden := storeRetentionTestVAA(t, db, vaa.ChainIDSolana, retentionTestEmitter, 1, old)
+	other := storeRetentionTestVAA(t, db, vaa.ChainIDSolana, vaa.Address{1}, 1, old)
+
+	policy := &RetentionPolicy{Interval: time.Hour, Rules: []RetentionRule{
+		{EmitterChain: vaa.ChainIDSolana, MaxAge: time.Hour, KeepGovernance: true},
+		{EmitterChain: vaa.ChainIDSolana, EmitterAddress: &retentionTestEmitter, MaxAge: 72 * time.Hour},
+	}}
+	require.NoError(t, policy.Validate())
+
+	results, err := db.ApplyRetentionPolicy(policy, now, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, 1, results[0].NumDeleted)
+	assert.Equal(t, 1, results[0].NumKept)
+	assert.Equal(t, 0, results[1].NumDeleted)
+	assert.Equal(t, 1, results[1].NumKept)
+
+	assert.True(t, vaaExists(t, db, governance))
+	assert.True(t, vaaExists(t, db, overridden))
+	assert.False(t, vaaExists(t, db, other))
+}
+
+func TestRetentionCleansUpIndexes(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+
+	now := time.Unix(1_000_000, 0)
+	v := storeRetentionTestVAA(t, db, vaa.ChainIDEthereum, retentionTestEmitter, 1, now.Add(-2*time.Hour))
+	assert.Equal(t, 2, countIndexEntries(t, db))
+
+	policy := &RetentionPolicy{Interval: time.Hour, Rules: []RetentionRule{{EmitterChain: vaa.ChainIDEthereum, MaxAge: time.Hour}}}
+	_, err := db.ApplyRetentionPolicy(policy, now, false)
+	require.NoError(t, err)
+
+	assert.False(t, vaaExists(t, db, v))
+	assert.Equal(t, 0, countIndexEntries(t, db))
+}
@@ -0,0 +1,385 @@
+package db
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+// The secondary VAA indexes map alternative lookup keys to the primary VAAID key ("signed/<chain>/<emitter>/<seq>").
+// They are optional and only maintained once EnableVAAIndexes has been called. The values of all index entries
+// are the primary key of the VAA.
+//
+//   - VAAIDX:DIGEST:<digest>                       -> a VAA by its signing digest
+//   - VAAIDX:TX:<tx hash>/<chain>/<emitter>/<seq>  -> all VAAs emitted by a source transaction
+//   - VAAIDX:TXOF:<chain>/<emitter>/<seq>          -> the tx hash of a VAA, used to clean up the tx index
+//   - VAAIDX:TIME:<unix seconds>/<chain>/<emitter>/<seq> -> VAAs ordered by their timestamp
+const vaaIdxDigest = "VAAIDX:DIGEST:"
+const vaaIdxTx = "VAAIDX:TX:"
+const vaaIdxTxOf = "VAAIDX:TXOF:"
+const vaaIdxTime = "VAAIDX:TIME:"
+
+// vaaIdxVersionKey is set once the indexes have been backfilled for the VAAs that existed before they were enabled.
+// It is deleted whenever the node runs with the indexes disabled, so that the VAAs stored in the meantime are
+// backfilled the next time the indexes are enabled.
+const vaaIdxVersionKey = "VAAIDX:VERSION"
+const vaaIdxVersion = "1"
+
+// vaaIdxMigrationBatchSize is the number of VAAs read per transaction when backfilling the indexes.
+const vaaIdxMigrationBatchSize = 10000
+
+// MaxVAAIndexResults is the maximum number of VAAs returned by a single index lookup.
+const MaxVAAIndexResults = 1000
+
+func (i *VAAID) String() string {
+	return fmt.Sprintf("%d/%s/%d", i.EmitterChain, i.EmitterAddress, i.Sequence)
+}
+
+func vaaIdxDigestKey(digest []byte) []byte {
+	return []byte(vaaIdxDigest + hex.EncodeToString(digest))
+}
+
+func vaaIdxTxPrefix(txHash []byte) []byte {
+	return []byte(vaaIdxTx + hex.EncodeToString(txHash) + "/")
+}
+
+func vaaIdxTxKey(txHash []byte, id *VAAID) []byte {
+	return append(vaaIdxTxPrefix(txHash), []byte(id.String())...)
+}
+
+func vaaIdxTxOfKey(id *VAAID) []byte {
+	return []byte(vaaIdxTxOf + id.String())
+}
+
+// vaaIdxTimePrefix returns the time index prefix for a timestamp. The timestamp is zero padded so that the keys sort chronologically.
+func vaaIdxTimePrefix(t time.Time) []byte {
+	return []byte(fmt.Sprintf("%s%020d/", vaaIdxTime, t.Unix()))
+}
+
+func vaaIdxTimeKey(v *vaa.VAA) []byte {
+	return append(vaaIdxTimePrefix(v.Timestamp), []byte(VaaIDFromVAA(v).String())...)
+}
+
+// indexEntriesForVAA returns the index entries that need to be written for a VAA. txHash may be nil if it is not known.
+func indexEntriesForVAA(v *vaa.VAA, txHash []byte) map[string][]byte {
+	id := VaaIDFromVAA(v)
+	primary := id.Bytes()
+	entries := map[string][]byte{
+		string(vaaIdxDigestKey(v.SigningDigest().Bytes())): primary,
+		string(vaaIdxTimeKey(v)):                           primary,
+	}
+
+	if len(txHash) != 0 {
+		entries[string(vaaIdxTxKey(txHash, id))] = primary
+		entries[string(vaaIdxTxOfKey(id))] = txHash
+	}
+
+	return entries
+}
+
+// EnableVAAIndexes turns on the secondary VAA indexes. If the indexes were not enabled before, the digest and
+// time indexes are backfilled for all VAAs already in the database. The tx hash index cannot be backfilled
+// because the source transaction is not part of the VAA.
+func (d *Database) EnableVAAIndexes(logger *zap.Logger) error {
+	migrated := false
+	err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(vaaIdxVersionKey))
+		if err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return nil
+			}
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			if string(val) != vaaIdxVersion {
+				return fmt.Errorf("unsupported VAA index version %s", string(val))
+			}
+			migrated = true
+			return nil
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read VAA index version: %w", err)
+	}
+
+	if !migrated {
+		if err := d.backfillVAAIndexes(logger); err != nil {
+			return fmt.Errorf("failed to backfill VAA indexes: %w", err)
+		}
+	}
+
+	d.vaaIndexes = true
+	return nil
+}
+
+// DisableVAAIndexes records that the secondary VAA indexes are not being maintained. VAAs stored while the indexes
+// are disabled are missing from them, so they are backfilled again the next time EnableVAAIndexes is called.
+func (d *Database) DisableVAAIndexes(logger *zap.Logger) error {
+	d.vaaIndexes = false
+	cleared, err := d.clearVAAIndexVersion()
+	if cleared {
+		logger.Info("VAA indexes are disabled, they will be backfilled when they are enabled again")
+	}
+	return err
+}
+
+// clearVAAIndexVersion deletes the index version so that the indexes are backfilled when they are enabled next. It
+// returns true if the version was set.
+func (d *Database) clearVAAIndexVersion() (bool, error) {
+	cleared := false
+	err := d.db.Update(func(txn *badger.Txn) error {
+		if _, err := txn.Get([]byte(vaaIdxVersionKey)); err != nil {
+			if errors.Is(err, badger.ErrKeyNotFound) {
+				return nil
+			}
+			return err
+		}
+		cleared = true
+		return txn.Delete([]byte(vaaIdxVersionKey))
+	})
+	return cleared, err
+}
+
+// VAAIndexesEnabled returns true if the secondary VAA indexes are being maintained.
+func (d *Database) VAAIndexesEnabled() bool {
+	return d.vaaIndexes
+}
+
+// backfillVAAIndexes writes the index entries for all VAAs in the database and then sets the index version.
+func (d *Database) backfillVAAIndexes(logger *zap.Logger) error {
+	logger.Info("backfilling VAA indexes, this may take a while")
+	start := time.Now()
+
+	prefix := []byte("signed/")
+	seekKey := prefix
+	var lastKey []byte
+	numIndexed := 0
+	for {
+		// Index the VAAs in chunks so we don't hold a huge transaction open.
+		batch := d.db.NewWriteBatch()
+		count := 0
+		err := d.db.View(func(txn *badger.Txn) error {
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			defer it.Close()
+			it.Seek(seekKey)
+			if lastKey != nil && it.Valid() && bytes.Equal(it.Item().Key(), lastKey) {
+				// This one was indexed as part of the previous chunk.
+				it.Next()
+			}
+			for ; it.ValidForPrefix(prefix) && count < vaaIdxMigrationBatchSize; it.Next() {
+				item := it.Item()
+				key := item.KeyCopy(nil)
+				err := item.Value(func(val []byte) error {
+					v, err := vaa.Unmarshal(val)
+					if err != nil {
+						return fmt.Errorf("failed to unmarshal VAA for %s: %v", string(key), err)
+					}
+					for k, val := range indexEntriesForVAA(v, nil) {
+						if err := batch.Set([]byte(k), val); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+				lastKey = key
+				count++
+			}
+			return nil
+		})
+		if err != nil {
+			batch.Cancel()
+			return err
+		}
+
+		if err := batch.Flush(); err != nil {
+			return err
+		}
+
+		numIndexed += count
+		if count < vaaIdxMigrationBatchSize {
+			break
+		}
+
+		logger.Info("backfilling VAA indexes", zap.Int("numIndexed", numIndexed))
+		seekKey = lastKey
+	}
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set([]byte(vaaIdxVersionKey), []byte(vaaIdxVersion))
+	}); err != nil {
+		return err
+	}
+
+	logger.Info("finished backfilling VAA indexes", zap.Int("numIndexed", numIndexed), zap.Duration("duration", time.Since(start)))
+	return nil
+}
+
+// deleteVAAWithIndexes deletes a VAA and, if the indexes are enabled, all of its index entries.
+func (d *Database) deleteVAAWithIndexes(txn *badger.Txn, key []byte, v *vaa.VAA) error {
+	if err := txn.Delete(key); err != nil {
+		return err
+	}
+
+	if !d.vaaIndexes {
+		return nil
+	}
+
+	id := VaaIDFromVAA(v)
+	if err := txn.Delete(vaaIdxDigestKey(v.SigningDigest().Bytes())); err != nil {
+		return err
+	}
+	if err := txn.Delete(vaaIdxTimeKey(v)); err != nil {
+		return err
+	}
+
+	item, err := txn.Get(vaaIdxTxOfKey(id))
+	if err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return nil
+		}
+		return err
+	}
+	txHash, err := item.ValueCopy(nil)
+	if err != nil {
+		return err
+	}
+	if err := txn.Delete(vaaIdxTxKey(txHash, id)); err != nil {
+		return err
+	}
+	return txn.Delete(vaaIdxTxOfKey(id))
+}
+
+// GetSignedVAABytesByDigest returns the VAA with the given signing digest. The VAA indexes must be enabled.
+func (d *Database) GetSignedVAABytesByDigest(digest []byte) (b []byte, err error) {
+	if !d.vaaIndexes {
+		return nil, ErrVAAIndexesDisabled
+	}
+
+	err = d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(vaaIdxDigestKey(digest))
+		if err != nil {
+			return err
+		}
+		primary, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		b, err = getValue(txn, primary)
+		return err
+	})
+
+	if errors.Is(err, badger.ErrKeyNotFound) {
+		return nil, ErrVAANotFound
+	}
+	return b, err
+}
+
+// GetSignedVAABytesByTxHash returns all VAAs that were emitted by the given source transaction. Only VAAs that were
+// observed by this guardian after the indexes were enabled can be found. The VAA indexes must be enabled.
+func (d *Database) GetSignedVAABytesByTxHash(txHash []byte) ([][]byte, error) {
+	if !d.vaaIndexes {
+		return nil, ErrVAAIndexesDisabled
+	}
+
+	result, _, err := d.getSignedVAABytesByIndexPrefix(vaaIdxTxPrefix(txHash), nil, nil, MaxVAAIndexResults)
+	return result, err
+}
+
+// GetSignedVAABytesByTimeRange returns up to limit VAAs with a timestamp in [start, end), ordered by timestamp. The VAA
+// indexes must be enabled. If there are more VAAs in the range, a non-empty page token is returned which can be passed
+// to the next call to continue after the last returned VAA.
+func (d *Database) GetSignedVAABytesByTimeRange(start time.Time, end time.Time, limit int, pageToken string) ([][]byte, string, error) {
+	if !d.vaaIndexes {
+		return nil, "", ErrVAAIndexesDisabled
+	}
+
+	if limit <= 0 || limit > MaxVAAIndexResults {
+		limit = MaxVAAIndexResults
+	}
+
+	seek := vaaIdxTimePrefix(start)
+	endKey := vaaIdxTimePrefix(end)
+	var after []byte
+	if pageToken != "" {
+		after = []byte(vaaIdxTime + pageToken)
+		if bytes.Compare(after, seek) < 0 || bytes.Compare(after, endKey) >= 0 {
+			return nil, "", ErrInvalidPageToken
+		}
+		seek = after
+	}
+
+	result, last, err := d.getSignedVAABytesByIndexPrefix(seek, endKey, after, limit)
+	if err != nil || last == nil {
+		return result, "", err
+	}
+	return result, string(last[len(vaaIdxTime):]), nil
+}
+
+// getSignedVAABytesByIndexPrefix iterates over index entries starting at `seek` and returns the referenced VAAs. If
+// `end` is nil, iteration stops at the end of the `seek` prefix, otherwise it stops at the first key >= `end`. The
+// entry `after`, if any, is skipped. If the limit was reached before the end, the key of the last returned entry is
+// returned as well.
+func (d *Database) getSignedVAABytesByIndexPrefix(seek []byte, end []byte, after []byte, limit int) ([][]byte, []byte, error) {
+	prefix := seek
+	if end != nil {
+		prefix = []byte(vaaIdxTime)
+	}
+
+	result := [][]byte{}
+	var last []byte
+	more := false
+	err := d.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+		for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			if end != nil && bytes.Compare(item.Key(), end) >= 0 {
+				break
+			}
+			if after != nil && bytes.Equal(item.Key(), after) {
+				continue
+			}
+			if len(result) == limit {
+				more = true
+				break
+			}
+			primary, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			b, err := getValue(txn, primary)
+			if err != nil {
+				if errors.Is(err, badger.ErrKeyNotFound) {
+					// The VAA was deleted without cleaning up the index.
+					continue
+				}
+				return err
+			}
+			result = append(result, b)
+			last = item.KeyCopy(nil)
+		}
+		return nil
+	})
+
+	if !more {
+		last = nil
+	}
+	return result, last, err
+}
+
+func getValue(txn *badger.Txn, key []byte) ([]byte, error) {
+	item, err := txn.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return item.ValueCopy(nil)
+}
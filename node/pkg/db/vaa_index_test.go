@@ -0,0 +1,212 @@
+package db
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func signedVAAWithTimestamp(t *testing.T, seqNum uint64, timestamp time.Time) *vaa.VAA {
+	t.Helper()
+	v := getVAAWithSeqNum(seqNum)
+	v.Timestamp = timestamp
+	privKey, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	require.NoError(t, err)
+	v.AddSignature(privKey, 0)
+	return &v
+}
+
+func countIndexEntries(t *testing.T, d *Database) int {
+	t.Helper()
+	count := 0
+	require.NoError(t, d.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		prefix := []byte("VAAIDX:")
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			if string(it.Item().Key()) != vaaIdxVersionKey {
+				count++
+			}
+		}
+		return nil
+	}))
+	return count
+}
+
+func TestVAAIndexesDisabled(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	v := signedVAAWithTimestamp(t, 1, time.Unix(1000, 0))
+	require.NoError(t, db.StoreSignedVAA(v))
+
+	assert.False(t, db.VAAIndexesEnabled())
+	assert.Equal(t, 0, countIndexEntries(t, db))
+
+	_, err := db.GetSignedVAABytesByDigest(v.SigningDigest().Bytes())
+	assert.ErrorIs(t, err, ErrVAAIndexesDisabled)
+	_, err = db.GetSignedVAABytesByTxHash([]byte{1})
+	assert.ErrorIs(t, err, ErrVAAIndexesDisabled)
+	_, _, err = db.GetSignedVAABytesByTimeRange(time.Unix(0, 0), time.Unix(2000, 0), 0, "")
+	assert.ErrorIs(t, err, ErrVAAIndexesDisabled)
+}
+
+func TestVAAIndexLookups(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+
+	txHash1 := []byte{0x01, 0x02, 0x03}
+	txHash2 := []byte{0x04, 0x05, 0x06}
+	v1 := signedVAAWithTimestamp(t, 1, time.Unix(1000, 0))
+	v2 := signedVAAWithTimestamp(t, 2, time.Unix(2000, 0))
+	v3 := signedVAAWithTimestamp(t, 3, time.Unix(3000, 0))
+	require.NoError(t, db.StoreSignedVAABatchWithTxHashes([]*vaa.VAA{v1, v2, v3}, [][]byte{txHash1, txHash1, txHash2}))
+
+	b1, err := v1.Marshal()
+	require.NoError(t, err)
+	b2, err := v2.Marshal()
+	require.NoError(t, err)
+	b3, err := v3.Marshal()
+	require.NoError(t, err)
+
+	// By digest.
+	b, err := db.GetSignedVAABytesByDigest(v2.SigningDigest().Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, b2, b)
+
+	_, err = db.GetSignedVAABytesByDigest(make([]byte, 32))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+
+	// By tx hash.
+	vaas, err := db.GetSignedVAABytesByTxHash(txHash1)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b1, b2}, vaas)
+
+	vaas, err = db.GetSignedVAABytesByTxHash(txHash2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b3}, vaas)
+
+	vaas, err = db.GetSignedVAABytesByTxHash([]byte{0x07})
+	require.NoError(t, err)
+	assert.Empty(t, vaas)
+
+	// By time range, the end is exclusive.
+	vaas, pageToken, err := db.GetSignedVAABytesByTimeRange(time.Unix(1000, 0), time.Unix(3000, 0), 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b1, b2}, vaas)
+	assert.Empty(t, pageToken)
+
+	vaas, pageToken, err = db.GetSignedVAABytesByTimeRange(time.Unix(1500, 0), time.Unix(5000, 0), 0, "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b2, b3}, vaas)
+	assert.Empty(t, pageToken)
+
+	// Paginated.
+	vaas, pageToken, err = db.GetSignedVAABytesByTimeRange(time.Unix(0, 0), time.Unix(5000, 0), 2, "")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b1, b2}, vaas)
+	require.NotEmpty(t, pageToken)
+
+	vaas, pageToken, err = db.GetSignedVAABytesByTimeRange(time.Unix(0, 0), time.Unix(5000, 0), 2, pageToken)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{b3}, vaas)
+	assert.Empty(t, pageToken)
+
+	// A page token outside of the range is rejected.
+	_, pageToken, err = db.GetSignedVAABytesByTimeRange(time.Unix(0, 0), time.Unix(5000, 0), 1, "")
+	require.NoError(t, err)
+	_, _, err = db.GetSignedVAABytesByTimeRange(time.Unix(1500, 0), time.Unix(5000, 0), 1, pageToken)
+	assert.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestVAAIndexBackfill(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	v1 := signedVAAWithTimestamp(t, 1, time.Unix(1000, 0))
+	v2 := signedVAAWithTimestamp(t, 2, time.Unix(2000, 0))
+	require.NoError(t, db.StoreSignedVAA(v1))
+	require.NoError(t, db.StoreSignedVAA(v2))
+
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+
+	// Digest and time index entries for both VAAs.
+	assert.Equal(t, 4, countIndexEntries(t, db))
+
+	b, err := db.GetSignedVAABytesByDigest(v1.SigningDigest().Bytes())
+	require.NoError(t, err)
+	b1, err := v1.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, b1, b)
+
+	vaas, _, err := db.GetSignedVAABytesByTimeRange(time.Unix(0, 0), time.Unix(5000, 0), 0, "")
+	require.NoError(t, err)
+	assert.Len(t, vaas, 2)
+
+	// Enabling the indexes again should not backfill a second time.
+	db.vaaIndexes = false
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+	assert.Equal(t, 4, countIndexEntries(t, db))
+
+	// VAAs stored while the indexes are disabled are backfilled when they are enabled again.
+	require.NoError(t, db.DisableVAAIndexes(zap.NewNop()))
+	v3 := signedVAAWithTimestamp(t, 3, time.Unix(3000, 0))
+	require.NoError(t, db.StoreSignedVAA(v3))
+	assert.Equal(t, 4, countIndexEntries(t, db))
+
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+	assert.Equal(t, 6, countIndexEntries(t, db))
+	_, err = db.GetSignedVAABytesByDigest(v3.SigningDigest().Bytes())
+	require.NoError(t, err)
+}
+
+func TestVAAIndexPurge(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+	require.NoError(t, db.EnableVAAIndexes(zap.NewNop()))
+
+	txHash := []byte{0x01, 0x02, 0x03}
+	v1 := signedVAAWithTimestamp(t, 1, time.Unix(1000, 0))
+	v2 := signedVAAWithTimestamp(t, 2, time.Unix(2000, 0))
+	v3 := signedVAAWithTimestamp(t, 3, time.Unix(3000, 0))
+	require.NoError(t, db.StoreSignedVAABatchWithTxHashes([]*vaa.VAA{v1, v2, v3}, [][]byte{txHash, txHash, txHash}))
+	assert.Equal(t, 12, countIndexEntries(t, db))
+
+	// A log only purge should not delete anything.
+	_, err := db.PurgeVaas(VAAID{EmitterChain: vaa.ChainIDSolana}, time.Unix(2500, 0), true)
+	require.NoError(t, err)
+	assert.Equal(t, 12, countIndexEntries(t, db))
+
+	_, err = db.PurgeVaas(VAAID{EmitterChain: vaa.ChainIDSolana}, time.Unix(2500, 0), false)
+	require.NoError(t, err)
+
+	// Only the index entries of the remaining VAA are left.
+	assert.Equal(t, 4, countIndexEntries(t, db))
+
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(v1))
+	assert.ErrorIs(t, err, ErrVAANotFound)
+	_, err = db.GetSignedVAABytes(*VaaIDFromVAA(v3))
+	assert.NoError(t, err)
+
+	vaas, err := db.GetSignedVAABytesByTxHash(txHash)
+	require.NoError(t, err)
+	assert.Len(t, vaas, 1)
+
+	_, err = db.GetSignedVAABytesByDigest(v2.SigningDigest().Bytes())
+	assert.ErrorIs(t, err, ErrVAANotFound)
+}
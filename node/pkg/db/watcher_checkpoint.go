@@ -0,0 +1,62 @@
+package db
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+)
+
+// WatcherCheckpointDB stores the last block processed by a watcher, so it can backfill what it missed while it was down.
+type WatcherCheckpointDB interface {
+	StoreWatcherCheckpoint(watcher string, height uint64) error
+	GetWatcherCheckpoint(watcher string) (uint64, error)
+}
+
+const watcherCheckpointPrefix = "WATCHER:CHECKPOINT:"
+
+var ErrWatcherCheckpointNotFound = errors.New("watcher checkpoint not found")
+
+func watcherCheckpointKey(watcher string) []byte {
+	return []byte(watcherCheckpointPrefix + watcher)
+}
+
+// StoreWatcherCheckpoint records the height up to which a watcher has processed all blocks.
+func (d *Database) StoreWatcherCheckpoint(watcher string, height uint64) error {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(watcherCheckpointKey(watcher), b)
+	}); err != nil {
+		return fmt.Errorf("failed to commit checkpoint of watcher %s: %w", watcher, err)
+	}
+
+	return nil
+}
+
+// GetWatcherCheckpoint returns the height stored by StoreWatcherCheckpoint, or ErrWatcherCheckpointNotFound if there is none.
+func (d *Database) GetWatcherCheckpoint(watcher string) (uint64, error) {
+	var height uint64
+	if err := d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(watcherCheckpointKey(watcher))
+		if err != nil {
+			return err
+		}
+		return item.Value(func(val []byte) error {
+			if len(val) != 8 {
+				return fmt.Errorf("invalid checkpoint length %d", len(val))
+			}
+			height = binary.BigEndian.Uint64(val)
+			return nil
+		})
+	}); err != nil {
+		if errors.Is(err, badger.ErrKeyNotFound) {
+			return 0, ErrWatcherCheckpointNotFound
+		}
+		return 0, fmt.Errorf("failed to read checkpoint of watcher %s: %w", watcher, err)
+	}
+
+	return height, nil
+}
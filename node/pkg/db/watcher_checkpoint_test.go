@@ -0,0 +1,30 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestWatcherCheckpoint(t *testing.T) {
+	dbPath := t.TempDir()
+	db := OpenDb(zap.NewNop(), &dbPath)
+	defer db.Close()
+
+	_, err := db.GetWatcherCheckpoint("solana-finalized")
+	require.ErrorIs(t, err, ErrWatcherCheckpointNotFound)
+
+	require.NoError(t, db.StoreWatcherCheckpoint("solana-finalized", 1000))
+	require.NoError(t, db.StoreWatcherCheckpoint("solana-confirmed", 2000))
+	require.NoError(t, db.StoreWatcherCheckpoint("solana-finalized", 1500))
+
+	height, err := db.GetWatcherCheckpoint("solana-finalized")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1500), height)
+
+	height, err = db.GetWatcherCheckpoint("solana-confirmed")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2000), height)
+}
@@ -0,0 +1,520 @@
+// Package denylist implements a policy hook that refuses to sign the messages of specific emitters, or token bridge
+// transfers of specific tokens or to specific recipients, during an incident. Matching messages are held in the
+// database until they are released or dropped through the admin RPC.
+package denylist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// KindEmitter matches all messages of an emitter.
+	KindEmitter = "emitter"
+	// KindToken matches token bridge transfers of a token, identified by its origin chain and address.
+	KindToken = "token"
+	// KindRecipient matches token bridge transfers to a recipient, identified by the target chain and address.
+	KindRecipient = "recipient"
+)
+
+const (
+	// reloadInterval is how often the denylist file is checked for changes and expired entries are removed.
+	reloadInterval = 10 * time.Second
+	// releasedRetention is how long a released message is allowed, so that reobservations are not held again.
+	releasedRetention = 24 * time.Hour
+)
+
+var (
+	denylistEntries = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_denylist_entries",
+			Help: "Number of active denylist entries, grouped by kind",
+		}, []string{"kind"})
+	denylistHeldMessages = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_denylist_held_messages",
+			Help: "Number of message publications held by the denylist",
+		})
+	denylistHeldTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_denylist_held_messages_total",
+			Help: "Total number of message publications held by the denylist, grouped by the kind of the matching entry",
+		}, []string{"kind"})
+	denylistFileReloads = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_denylist_file_reloads_total",
+			Help: "Total number of denylist file reloads, grouped by outcome",
+		}, []string{"outcome"})
+)
+
+var (
+	ErrEntryNotFound       = errors.New("denylist entry not found")
+	ErrEntryFromFile       = errors.New("denylist entry is defined in the denylist file")
+	ErrHeldMessageNotFound = errors.New("message is not held by the denylist")
+)
+
+// NewEntry validates and creates a denylist entry.
+func NewEntry(kind string, chain vaa.ChainID, address string, expiry time.Time, reason string) (*db.DenylistEntry, error) {
+	switch kind {
+	case KindEmitter, KindToken, KindRecipient:
+	default:
+		return nil, fmt.Errorf("invalid kind %q, must be one of %s, %s or %s", kind, KindEmitter, KindToken, KindRecipient)
+	}
+
+	if chain == vaa.ChainIDUnset {
+		return nil, errors.New("chain must be set")
+	}
+
+	addr, err := vaa.StringToAddress(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+
+	return &db.DenylistEntry{Kind: kind, Chain: chain, Address: addr, Expiry: expiry, Reason: reason}, nil
+}
+
+// Denylist holds the message publications that match one of its entries. It implements processor.PolicyHook.
+type Denylist struct {
+	logger   *zap.Logger
+	db       db.DenylistDB
+	filePath string
+	// msgC is where released messages are published, so they go through the processor again.
+	msgC chan<- *common.MessagePublication
+
+	// tokenBridges are the token bridge emitters of the environment, whose transfers are matched against the token
+	// and recipient entries.
+	tokenBridges map[tokenBridgeKey]struct{}
+
+	mu sync.Mutex
+	// fileEntries are loaded from the denylist file and replaced when it changes.
+	fileEntries map[string]*db.DenylistEntry
+	// adminEntries are added through the admin RPC and stored in the database.
+	adminEntries map[string]*db.DenylistEntry
+	// held and released are keyed by message ID, and only cover messages with the same digest, so that a different
+	// message reusing the ID of a released message isn't allowed with it.
+	held        map[string]*db.DenylistHeldMessage
+	released    map[string]releasedMessage
+	fileModTime time.Time
+}
+
+// releasedMessage is a message that was released through the admin RPC.
+type releasedMessage struct {
+	digest     string
+	releasedAt time.Time
+}
+
+type tokenBridgeKey struct {
+	chain   vaa.ChainID
+	address vaa.Address
+}
+
+// NewDenylist creates a denylist. If filePath is set, entries are loaded from that file and reloaded when it
+// changes.
+func NewDenylist(logger *zap.Logger, denylistDB db.DenylistDB, env common.Environment, filePath string, msgC chan<- *common.MessagePublication) *Denylist {
+	emitterMap := sdk.KnownTokenbridgeEmitters
+	if env == common.TestNet {
+		emitterMap = sdk.KnownTestnetTokenbridgeEmitters
+	} else if env == common.UnsafeDevNet || env == common.GoTest || env == common.AccountantMock {
+		emitterMap = sdk.KnownDevnetTokenbridgeEmitters
+	}
+
+	tokenBridges := make(map[tokenBridgeKey]struct{}, len(emitterMap))
+	for chainID, emitterAddrBytes := range emitterMap {
+		emitterAddr, err := vaa.BytesToAddress(emitterAddrBytes)
+		if err != nil {
+			logger.Error("failed to convert token bridge emitter address", zap.Stringer("chain", chainID), zap.Error(err))
+			continue
+		}
+		tokenBridges[tokenBridgeKey{chain: chainID, address: emitterAddr}] = struct{}{}
+	}
+
+	return &Denylist{
+		logger:       logger.With(zap.String("component", "denylist")),
+		db:           denylistDB,
+		filePath:     filePath,
+		msgC:         msgC,
+		tokenBridges: tokenBridges,
+		fileEntries:  make(map[string]*db.DenylistEntry),
+		adminEntries: make(map[string]*db.DenylistEntry),
+		held:         make(map[string]*db.DenylistHeldMessage),
+		released:     make(map[string]releasedMessage),
+	}
+}
+
+// Load reads the entries and held messages from the database, and the entries from the denylist file.
+func (d *Denylist) Load() error {
+	entries, held, err := d.db.DenylistGetData(d.logger)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	for _, entry := range entries {
+		d.adminEntries[entry.Key()] = entry
+	}
+	for _, h := range held {
+		d.held[h.Msg.MessageIDString()] = h
+	}
+	d.mu.Unlock()
+
+	if d.filePath != "" {
+		if err := d.reloadFile(); err != nil {
+			return err
+		}
+	}
+
+	d.updateMetrics(time.Now())
+	d.logger.Info("loaded denylist", zap.Int("adminEntries", len(entries)), zap.Int("heldMessages", len(held)), zap.String("file", d.filePath))
+	return nil
+}
+
+// Run periodically reloads the denylist file if it changed, and removes expired entries.
+func (d *Denylist) Run(ctx context.Context) error {
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if d.filePath != "" {
+				if err := d.reloadFile(); err != nil {
+					// Keep using the previous entries until the file is fixed.
+					d.logger.Error("failed to reload denylist file", zap.String("file", d.filePath), zap.Error(err))
+				}
+			}
+			d.removeExpired(time.Now())
+			d.updateMetrics(time.Now())
+		}
+	}
+}
+
+// reloadFile replaces the file entries if the file was modified since it was last loaded.
+func (d *Denylist) reloadFile() error {
+	info, err := os.Stat(d.filePath)
+	if err != nil {
+		denylistFileReloads.WithLabelValues("error").Inc()
+		return fmt.Errorf("failed to stat denylist file: %w", err)
+	}
+
+	d.mu.Lock()
+	unchanged := info.ModTime().Equal(d.fileModTime)
+	d.mu.Unlock()
+	if unchanged {
+		return nil
+	}
+
+	entries, err := LoadFile(d.filePath)
+	if err != nil {
+		denylistFileReloads.WithLabelValues("error").Inc()
+		return err
+	}
+
+	fileEntries := make(map[string]*db.DenylistEntry, len(entries))
+	for _, entry := range entries {
+		fileEntries[entry.Key()] = entry
+	}
+
+	d.mu.Lock()
+	d.fileEntries = fileEntries
+	d.fileModTime = info.ModTime()
+	d.mu.Unlock()
+
+	denylistFileReloads.WithLabelValues("success").Inc()
+	d.logger.Info("loaded denylist file", zap.String("file", d.filePath), zap.Int("entries", len(entries)))
+	return nil
+}
+
+// removeExpired deletes the expired admin entries and forgets old released messages. Expired file entries are
+// ignored until they are removed from the file.
+func (d *Denylist) removeExpired(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for key, entry := range d.adminEntries {
+		if isExpired(entry, now) {
+			if err := d.db.DenylistDeleteEntry(key); err != nil {
+				d.logger.Error("failed to delete expired denylist entry", zap.String("entry", key), zap.Error(err))
+				continue
+			}
+			delete(d.adminEntries, key)
+			d.logger.Info("denylist entry expired", zap.String("entry", key))
+		}
+	}
+
+	for msgId, r := range d.released {
+		if now.Sub(r.releasedAt) > releasedRetention {
+			delete(d.released, msgId)
+		}
+	}
+}
+
+func (d *Denylist) updateMetrics(now time.Time) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	counts := map[string]int{KindEmitter: 0, KindToken: 0, KindRecipient: 0}
+	for _, entry := range d.activeEntriesAlreadyLocked(now) {
+		counts[entry.Kind]++
+	}
+	for kind, count := range counts {
+		denylistEntries.WithLabelValues(kind).Set(float64(count))
+	}
+	denylistHeldMessages.Set(float64(len(d.held)))
+}
+
+func isExpired(entry *db.DenylistEntry, now time.Time) bool {
+	return !entry.Expiry.IsZero() && now.After(entry.Expiry)
+}
+
+// activeEntriesAlreadyLocked returns the unexpired entries. It assumes the caller holds the lock.
+func (d *Denylist) activeEntriesAlreadyLocked(now time.Time) []*db.DenylistEntry {
+	entries := make([]*db.DenylistEntry, 0, len(d.fileEntries)+len(d.adminEntries))
+	for _, m := range []map[string]*db.DenylistEntry{d.fileEntries, d.adminEntries} {
+		for _, entry := range m {
+			if !isExpired(entry, now) {
+				entries = append(entries, entry)
+			}
+		}
+	}
+	return entries
+}
+
+// lookupAlreadyLocked returns the active entry with the given kind, chain and address, or nil. It assumes the caller
+// holds the lock.
+func (d *Denylist) lookupAlreadyLocked(kind string, chain vaa.ChainID, address vaa.Address, now time.Time) *db.DenylistEntry {
+	key := (&db.DenylistEntry{Kind: kind, Chain: chain, Address: address}).Key()
+	for _, m := range []map[string]*db.DenylistEntry{d.fileEntries, d.adminEntries} {
+		if entry, exists := m[key]; exists && !isExpired(entry, now) {
+			return entry
+		}
+	}
+	return nil
+}
+
+// matchAlreadyLocked returns the entry that matches the message, or nil. It assumes the caller holds the lock.
+func (d *Denylist) matchAlreadyLocked(msg *common.MessagePublication, now time.Time) *db.DenylistEntry {
+	if entry := d.lookupAlreadyLocked(KindEmitter, msg.EmitterChain, msg.EmitterAddress, now); entry != nil {
+		return entry
+	}
+
+	if _, isTokenBridge := d.tokenBridges[tokenBridgeKey{chain: msg.EmitterChain, address: msg.EmitterAddress}]; !isTokenBridge {
+		return nil
+	}
+	if !vaa.IsTransfer(msg.Payload) {
+		return nil
+	}
+	hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	if err != nil {
+		// The token bridge should never publish a malformed transfer, so this is worth a look. The message is
+		// handled as usual, the governor and the accountant reject it as well.
+		d.logger.Warn("failed to decode token bridge transfer", zap.String("message_id", msg.MessageIDString()), zap.Error(err))
+		return nil
+	}
+
+	if entry := d.lookupAlreadyLocked(KindToken, hdr.OriginChain, hdr.OriginAddress, now); entry != nil {
+		return entry
+	}
+	return d.lookupAlreadyLocked(KindRecipient, hdr.TargetChain, hdr.TargetAddress, now)
+}
+
+// Name implements processor.PolicyHook.
+func (d *Denylist) Name() string {
+	return "denylist"
+}
+
+// Evaluate implements processor.PolicyHook. Messages that match an entry are stored in the database and rejected.
+func (d *Denylist) Evaluate(ctx context.Context, msg *common.MessagePublication) processor.PolicyDecision {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	msgId := msg.MessageIDString()
+	digest := msg.CreateDigest()
+
+	if r, released := d.released[msgId]; released && r.digest == digest {
+		return processor.Allow()
+	}
+
+	if h, held := d.held[msgId]; held {
+		if h.Msg.CreateDigest() != digest {
+			// SECURITY: Only the held message can be released, so a different message with its ID is never signed.
+			return processor.Reject("message conflicts with the held message with the same ID: " + h.Reason)
+		}
+		return processor.Reject("message is already held: " + h.Reason)
+	}
+
+	entry := d.matchAlreadyLocked(msg, now)
+	if entry == nil {
+		return processor.Allow()
+	}
+
+	h := &db.DenylistHeldMessage{Msg: msg, HeldAt: now, Reason: describe(entry)}
+	if err := d.db.DenylistStoreHeldMessage(h); err != nil {
+		// The message is still held, but won't survive a restart. It can be reobserved after that.
+		d.logger.Error("failed to store held message", zap.String("message_id", msgId), zap.Error(err))
+	}
+	d.held[msgId] = h
+	denylistHeldTotal.WithLabelValues(entry.Kind).Inc()
+	denylistHeldMessages.Set(float64(len(d.held)))
+
+	return processor.Reject(h.Reason)
+}
+
+func describe(entry *db.DenylistEntry) string {
+	s := fmt.Sprintf("denylisted %s %s", entry.Kind, entry.Key())
+	if entry.Reason != "" {
+		s += ": " + entry.Reason
+	}
+	return s
+}
+
+// AddEntry adds an entry, or replaces the admin entry with the same kind, chain and address. The entry is stored in
+// the database.
+func (d *Denylist) AddEntry(entry *db.DenylistEntry) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.fileEntries[entry.Key()]; exists {
+		return ErrEntryFromFile
+	}
+
+	if err := d.db.DenylistStoreEntry(entry); err != nil {
+		return err
+	}
+	d.adminEntries[entry.Key()] = entry
+	d.logger.Info("added denylist entry", zap.String("entry", entry.Key()), zap.Time("expiry", entry.Expiry), zap.String("reason", entry.Reason))
+	return nil
+}
+
+// RemoveEntry removes an entry that was added through AddEntry. Messages that were held because of it remain held.
+func (d *Denylist) RemoveEntry(key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.adminEntries[key]; !exists {
+		if _, exists := d.fileEntries[key]; exists {
+			return ErrEntryFromFile
+		}
+		return ErrEntryNotFound
+	}
+
+	if err := d.db.DenylistDeleteEntry(key); err != nil {
+		return err
+	}
+	delete(d.adminEntries, key)
+	d.logger.Info("removed denylist entry", zap.String("entry", key))
+	return nil
+}
+
+// DropHeldMessage forgets a held message. It is not signed unless it is reobserved after the entry was removed.
+func (d *Denylist) DropHeldMessage(msgId string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.held[msgId]; !exists {
+		return ErrHeldMessageNotFound
+	}
+
+	if err := d.db.DenylistDeleteHeldMessage(msgId); err != nil {
+		return err
+	}
+	delete(d.held, msgId)
+	denylistHeldMessages.Set(float64(len(d.held)))
+	d.logger.Info("dropped held message", zap.String("message_id", msgId))
+	return nil
+}
+
+// ReleaseHeldMessage publishes a held message to the processor, bypassing the denylist. Reobservations of the
+// message with the same digest are allowed for a day.
+func (d *Denylist) ReleaseHeldMessage(ctx context.Context, msgId string) error {
+	d.mu.Lock()
+	h, exists := d.held[msgId]
+	if !exists {
+		d.mu.Unlock()
+		return ErrHeldMessageNotFound
+	}
+	delete(d.held, msgId)
+	d.released[msgId] = releasedMessage{digest: h.Msg.CreateDigest(), releasedAt: time.Now()}
+	d.mu.Unlock()
+
+	// The lock must not be held while publishing, since the processor evaluates the message against the denylist.
+	select {
+	case d.msgC <- h.Msg:
+	case <-ctx.Done():
+		d.mu.Lock()
+		d.held[msgId] = h
+		delete(d.released, msgId)
+		d.mu.Unlock()
+		return fmt.Errorf("failed to publish released message: %w", ctx.Err())
+	}
+
+	if err := d.db.DenylistDeleteHeldMessage(msgId); err != nil {
+		// The message is released again after a restart, which is harmless.
+		d.logger.Error("failed to delete released message", zap.String("message_id", msgId), zap.Error(err))
+	}
+	denylistHeldMessages.Set(float64(d.numHeld()))
+	d.logger.Info("released held message", zap.String("message_id", msgId))
+	return nil
+}
+
+func (d *Denylist) numHeld() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.held)
+}
+
+// Status returns a human-readable description of the active entries and the held messages.
+func (d *Denylist) Status() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	var sb strings.Builder
+
+	entries := d.activeEntriesAlreadyLocked(now)
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key() < entries[j].Key() })
+	fmt.Fprintf(&sb, "denylist entries: %d\n", len(entries))
+	for _, entry := range entries {
+		source := "admin"
+		if _, fromFile := d.fileEntries[entry.Key()]; fromFile {
+			source = "file"
+		}
+		expiry := "never"
+		if !entry.Expiry.IsZero() {
+			expiry = entry.Expiry.UTC().Format(time.RFC3339)
+		}
+		fmt.Fprintf(&sb, "%s: source: %s, expires: %s, reason: %s\n", entry.Key(), source, expiry, entry.Reason)
+	}
+
+	held := make([]*db.DenylistHeldMessage, 0, len(d.held))
+	for _, h := range d.held {
+		held = append(held, h)
+	}
+	sort.Slice(held, func(i, j int) bool { return held[i].HeldAt.Before(held[j].HeldAt) })
+	fmt.Fprintf(&sb, "held messages: %d\n", len(held))
+	for _, h := range held {
+		fmt.Fprintf(&sb, "%s: held at: %s, tx: %s, reason: %s\n", h.Msg.MessageIDString(), h.HeldAt.UTC().Format(time.RFC3339), h.Msg.TxIDString(), h.Reason)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,225 @@
+package denylist
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	testEmitter   = mustAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	testToken     = mustAddress("0xDDb64fE46a91D46ee29420539FC25FD07c5FEa3E")
+	testRecipient = mustAddress("0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1")
+)
+
+func mustAddress(s string) vaa.Address {
+	addr, err := vaa.StringToAddress(s)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// transferMsg creates a token bridge transfer of testToken on Ethereum to the given recipient on Solana.
+func transferMsg(t *testing.T, sequence uint64, recipient vaa.Address) *common.MessagePublication {
+	t.Helper()
+	tokenBridge, err := vaa.BytesToAddress(sdk.KnownDevnetTokenbridgeEmitters[vaa.ChainIDEthereum])
+	require.NoError(t, err)
+
+	payload := make([]byte, 133)
+	payload[0] = 1
+	payload[32] = 100 // amount
+	copy(payload[33:65], testToken[:])
+	binary.BigEndian.PutUint16(payload[65:67], uint16(vaa.ChainIDEthereum))
+	copy(payload[67:99], recipient[:])
+	binary.BigEndian.PutUint16(payload[99:101], uint16(vaa.ChainIDSolana))
+
+	return &common.MessagePublication{
+		TxID:           []byte{1},
+		Timestamp:      time.Unix(1_700_000_000, 0),
+		Sequence:       sequence,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: tokenBridge,
+		Payload:        payload,
+	}
+}
+
+func emitterMsg(sequence uint64) *common.MessagePublication {
+	return &common.MessagePublication{
+		TxID:           []byte{1},
+		Timestamp:      time.Unix(1_700_000_000, 0),
+		Sequence:       sequence,
+		EmitterChain:   vaa.ChainIDEthereum,
+		EmitterAddress: testEmitter,
+		Payload:        []byte{1, 2, 3},
+	}
+}
+
+func newTestDenylist(t *testing.T, database *db.Database, filePath string) (*Denylist, chan *common.MessagePublication) {
+	t.Helper()
+	msgC := make(chan *common.MessagePublication, 10)
+	d := NewDenylist(zap.NewNop(), database, common.GoTest, filePath, msgC)
+	require.NoError(t, d.Load())
+	return d, msgC
+}
+
+func openTestDb(t *testing.T) *db.Database {
+	t.Helper()
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	t.Cleanup(func() { database.Close() })
+	return database
+}
+
+func TestDenylistMatching(t *testing.T) {
+	ctx := context.Background()
+	d, _ := newTestDenylist(t, openTestDb(t), "")
+
+	// Nothing is denylisted yet.
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, emitterMsg(1)).Action)
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, transferMsg(t, 1, testRecipient)).Action)
+
+	entry, err := NewEntry(KindEmitter, vaa.ChainIDEthereum, testEmitter.String(), time.Time{}, "incident")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	decision := d.Evaluate(ctx, emitterMsg(2))
+	assert.Equal(t, processor.PolicyReject, decision.Action)
+	assert.Contains(t, decision.Reason, "incident")
+
+	// A token entry matches transfers of the token, a recipient entry transfers to the recipient on the target chain.
+	entry, err = NewEntry(KindToken, vaa.ChainIDEthereum, testToken.String(), time.Time{}, "")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, transferMsg(t, 2, testRecipient)).Action)
+	require.NoError(t, d.RemoveEntry(entry.Key()))
+
+	entry, err = NewEntry(KindRecipient, vaa.ChainIDSolana, testRecipient.String(), time.Time{}, "")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, transferMsg(t, 3, testRecipient)).Action)
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, transferMsg(t, 4, testEmitter)).Action)
+
+	// Token and recipient entries only apply to the token bridge.
+	msg := transferMsg(t, 5, testRecipient)
+	msg.EmitterAddress = mustAddress("0x01")
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, msg).Action)
+
+	// Expired entries no longer apply, and are removed.
+	entry, err = NewEntry(KindEmitter, vaa.ChainIDSolana, testEmitter.String(), time.Now().Add(-time.Second), "")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	msg = emitterMsg(6)
+	msg.EmitterChain = vaa.ChainIDSolana
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, msg).Action)
+	d.removeExpired(time.Now())
+	assert.ErrorIs(t, d.RemoveEntry(entry.Key()), ErrEntryNotFound)
+
+	assert.Len(t, d.held, 3)
+}
+
+func TestDenylistHeldMessages(t *testing.T) {
+	ctx := context.Background()
+	database := openTestDb(t)
+	d, _ := newTestDenylist(t, database, "")
+
+	entry, err := NewEntry(KindEmitter, vaa.ChainIDEthereum, testEmitter.String(), time.Time{}, "incident")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	for seq := uint64(1); seq <= 2; seq++ {
+		assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, emitterMsg(seq)).Action)
+	}
+
+	// The entries and held messages survive a restart.
+	d, msgC := newTestDenylist(t, database, "")
+	require.Len(t, d.held, 2)
+	assert.Contains(t, d.Status(), entry.Key())
+	assert.Contains(t, d.Status(), emitterMsg(1).MessageIDString())
+
+	// A reobservation of a held message is rejected without holding it again.
+	decision := d.Evaluate(ctx, emitterMsg(1))
+	assert.Equal(t, processor.PolicyReject, decision.Action)
+	assert.Contains(t, decision.Reason, "already held")
+
+	// A different message with the ID of a held message is rejected, and doesn't replace it.
+	conflicting := emitterMsg(1)
+	conflicting.Payload = []byte{4, 5, 6}
+	decision = d.Evaluate(ctx, conflicting)
+	assert.Equal(t, processor.PolicyReject, decision.Action)
+	assert.Contains(t, decision.Reason, "conflicts")
+	assert.Equal(t, emitterMsg(1).Payload, d.held[emitterMsg(1).MessageIDString()].Msg.Payload)
+
+	// A released message is published and then allowed, even though the entry still exists.
+	require.NoError(t, d.ReleaseHeldMessage(ctx, emitterMsg(1).MessageIDString()))
+	require.Len(t, msgC, 1)
+	released := <-msgC
+	assert.Equal(t, emitterMsg(1).MessageIDString(), released.MessageIDString())
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, released).Action)
+	assert.ErrorIs(t, d.ReleaseHeldMessage(ctx, emitterMsg(1).MessageIDString()), ErrHeldMessageNotFound)
+
+	// The release only covers the released message, a different message with its ID is held.
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, conflicting).Action)
+	assert.Equal(t, conflicting.Payload, d.held[emitterMsg(1).MessageIDString()].Msg.Payload)
+	require.NoError(t, d.DropHeldMessage(emitterMsg(1).MessageIDString()))
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, released).Action)
+
+	require.NoError(t, d.DropHeldMessage(emitterMsg(2).MessageIDString()))
+	assert.ErrorIs(t, d.DropHeldMessage(emitterMsg(2).MessageIDString()), ErrHeldMessageNotFound)
+	assert.Empty(t, d.held)
+
+	_, held, err := database.DenylistGetData(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, held)
+}
+
+func TestDenylistReleaseTimeout(t *testing.T) {
+	d := NewDenylist(zap.NewNop(), &db.MockDenylistDB{}, common.GoTest, "", make(chan *common.MessagePublication))
+	entry, err := NewEntry(KindEmitter, vaa.ChainIDEthereum, testEmitter.String(), time.Time{}, "")
+	require.NoError(t, err)
+	require.NoError(t, d.AddEntry(entry))
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(context.Background(), emitterMsg(1)).Action)
+
+	// If the processor doesn't accept the message, it remains held.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.Error(t, d.ReleaseHeldMessage(ctx, emitterMsg(1).MessageIDString()))
+	assert.Len(t, d.held, 1)
+	assert.Empty(t, d.released)
+}
+
+func TestDenylistFile(t *testing.T) {
+	ctx := context.Background()
+	filePath := filepath.Join(t.TempDir(), "denylist.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"entries": [{"kind": "emitter", "chain": "ethereum", "address": "0x3ee18B2214AFF97000D974cf647E7C347E8fa585"}]}`), 0600))
+
+	d, _ := newTestDenylist(t, openTestDb(t), filePath)
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, emitterMsg(1)).Action)
+
+	// File entries can't be changed through the admin RPC.
+	entry, err := NewEntry(KindEmitter, vaa.ChainIDEthereum, testEmitter.String(), time.Time{}, "")
+	require.NoError(t, err)
+	assert.ErrorIs(t, d.AddEntry(entry), ErrEntryFromFile)
+	assert.ErrorIs(t, d.RemoveEntry(entry.Key()), ErrEntryFromFile)
+
+	// An invalid file keeps the previous entries.
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"entries": [`), 0600))
+	require.NoError(t, os.Chtimes(filePath, time.Now(), time.Now().Add(time.Minute)))
+	require.Error(t, d.reloadFile())
+	assert.Equal(t, processor.PolicyReject, d.Evaluate(ctx, emitterMsg(2)).Action)
+
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"entries": []}`), 0600))
+	require.NoError(t, os.Chtimes(filePath, time.Now(), time.Now().Add(2*time.Minute)))
+	require.NoError(t, d.reloadFile())
+	assert.Equal(t, processor.PolicyAllow, d.Evaluate(ctx, emitterMsg(3)).Action)
+}
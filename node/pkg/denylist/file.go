@@ -0,0 +1,94 @@
+package denylist
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// fileConfig is the JSON representation of the denylist file.
+type fileConfig struct {
+	Entries []fileEntry `json:"entries"`
+}
+
+type fileEntry struct {
+	Kind string `json:"kind"`
+	// Chain may be a chain name or a chain ID.
+	Chain   string `json:"chain"`
+	Address string `json:"address"`
+	// Expiry is an RFC 3339 timestamp. If empty, the entry never expires.
+	Expiry string `json:"expiry"`
+	Reason string `json:"reason"`
+}
+
+// LoadFile reads the denylist entries from a JSON file.
+func LoadFile(path string) ([]*db.DenylistEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read denylist file: %w", err)
+	}
+	return ParseFile(data)
+}
+
+// ParseFile parses denylist entries in the following JSON format:
+//
+//	{
+//	  "entries": [
+//	    {"kind": "emitter", "chain": "ethereum", "address": "0x3ee18B2214AFF97000D974cf647E7C347E8fa585", "reason": "incident 42"},
+//	    {"kind": "token", "chain": "solana", "address": "069b8857feab8184fb687f634618c035dac439dc1aeb3b5598a0f00000000001", "expiry": "2026-12-01T00:00:00Z"},
+//	    {"kind": "recipient", "chain": "2", "address": "0x90F8bf6A479f320ead074411a4B0e7944Ea8c9C1"}
+//	  ]
+//	}
+func ParseFile(data []byte) ([]*db.DenylistEntry, error) {
+	var cfg fileConfig
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse denylist file: %w", err)
+	}
+
+	entries := make([]*db.DenylistEntry, 0, len(cfg.Entries))
+	seen := make(map[string]struct{}, len(cfg.Entries))
+	for idx, fe := range cfg.Entries {
+		chainID, err := ParseChain(fe.Chain)
+		if err != nil {
+			return nil, fmt.Errorf("invalid chain in entry %d: %w", idx, err)
+		}
+
+		var expiry time.Time
+		if fe.Expiry != "" {
+			expiry, err = time.Parse(time.RFC3339, fe.Expiry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiry in entry %d: %w", idx, err)
+			}
+		}
+
+		entry, err := NewEntry(fe.Kind, chainID, fe.Address, expiry, fe.Reason)
+		if err != nil {
+			return nil, fmt.Errorf("invalid entry %d: %w", idx, err)
+		}
+
+		if _, exists := seen[entry.Key()]; exists {
+			return nil, fmt.Errorf("duplicate entry %d: %s", idx, entry.Key())
+		}
+		seen[entry.Key()] = struct{}{}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ParseChain parses a chain name or a chain ID.
+func ParseChain(s string) (vaa.ChainID, error) {
+	if num, err := strconv.ParseUint(s, 10, 16); err == nil {
+		return vaa.ChainID(num), nil
+	}
+	return vaa.ChainIDFromString(s)
+}
@@ -0,0 +1,78 @@
+package denylist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestParseFile(t *testing.T) {
+	entries, err := ParseFile([]byte(`{
+	  "entries": [
+	    {"kind": "emitter", "chain": "ethereum", "address": "0x3ee18B2214AFF97000D974cf647E7C347E8fa585", "reason": "incident 42"},
+	    {"kind": "token", "chain": "1", "address": "069b8857feab8184fb687f634618c035dac439dc1aeb3b5598a0f00000000001", "expiry": "2026-12-01T00:00:00Z"}
+	  ]
+	}`))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, KindEmitter, entries[0].Kind)
+	assert.Equal(t, vaa.ChainIDEthereum, entries[0].Chain)
+	assert.Equal(t, "0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585", entries[0].Address.String())
+	assert.True(t, entries[0].Expiry.IsZero())
+	assert.Equal(t, "incident 42", entries[0].Reason)
+
+	assert.Equal(t, KindToken, entries[1].Kind)
+	assert.Equal(t, vaa.ChainIDSolana, entries[1].Chain)
+	assert.Equal(t, time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC), entries[1].Expiry.UTC())
+
+	entries, err = ParseFile([]byte(`{"entries": []}`))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestParseFileErrors(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		err  string
+	}{
+		"UnknownField": {
+			data: `{"entries": [{"kind": "emitter", "chain": "ethereum", "address": "0x01", "foo": 1}]}`,
+			err:  "unknown field",
+		},
+		"InvalidKind": {
+			data: `{"entries": [{"kind": "wallet", "chain": "ethereum", "address": "0x01"}]}`,
+			err:  "invalid kind",
+		},
+		"InvalidChain": {
+			data: `{"entries": [{"kind": "emitter", "chain": "foo", "address": "0x01"}]}`,
+			err:  "invalid chain in entry 0",
+		},
+		"UnsetChain": {
+			data: `{"entries": [{"kind": "emitter", "chain": "0", "address": "0x01"}]}`,
+			err:  "chain must be set",
+		},
+		"InvalidAddress": {
+			data: `{"entries": [{"kind": "emitter", "chain": "ethereum", "address": "xyz"}]}`,
+			err:  "invalid address",
+		},
+		"InvalidExpiry": {
+			data: `{"entries": [{"kind": "emitter", "chain": "ethereum", "address": "0x01", "expiry": "tomorrow"}]}`,
+			err:  "invalid expiry in entry 0",
+		},
+		"Duplicate": {
+			data: `{"entries": [{"kind": "emitter", "chain": "2", "address": "0x01"}, {"kind": "emitter", "chain": "ethereum", "address": "01"}]}`,
+			err:  "duplicate entry 1",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseFile([]byte(tc.data))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
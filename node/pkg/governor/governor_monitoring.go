@@ -416,6 +416,27 @@ func (gov *ChainGovernor) IsVAAEnqueued(msgId *publicrpcv1.MessageID) (bool, err
 	return false, nil
 }
 
+// MessageStatus reports whether the governor has seen a message, identified by its message ID and / or its
+// hex-encoded signing digest, and whether it is holding it. If the message is pending, its release time is returned.
+func (gov *ChainGovernor) MessageStatus(msgId string, digest string) (seen bool, pending bool, releaseTime time.Time) {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	if digest != "" {
+		_, seen = gov.msgsSeen[digest]
+	}
+
+	for _, ce := range gov.chains {
+		for _, pe := range ce.pending {
+			if (msgId != "" && pe.dbData.Msg.MessageIDString() == msgId) || (digest != "" && pe.hash == digest) {
+				return true, true, pe.dbData.ReleaseTime
+			}
+		}
+	}
+
+	return seen, false, time.Time{}
+}
+
 // availableNotionalValue calculates the available notional USD value for a chain entry based on the net value
 // of the chain.
 func (gov *ChainGovernor) availableNotionalValue(id vaa.ChainID, netUsage int64) uint64 {
@@ -601,9 +622,10 @@ func (gov *ChainGovernor) publishConfig(ctx context.Context, hb *gossipv1.Heartb
 
 	digest := ethCrypto.Keccak256Hash(append(governorMessagePrefixConfig, b...))
 
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeGovernorConfig), digest.Bytes())
 	if err != nil {
-		panic(err)
+		gov.logger.Error("failed to sign config message", zap.Error(err))
+		return
 	}
 
 	msg := gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedChainGovernorConfig{
@@ -686,9 +708,10 @@ func (gov *ChainGovernor) publishStatus(ctx context.Context, hb *gossipv1.Heartb
 
 	digest := ethCrypto.Keccak256Hash(append(governorMessagePrefixStatus, b...))
 
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeGovernorStatus), digest.Bytes())
 	if err != nil {
-		panic(err)
+		gov.logger.Error("failed to sign status message", zap.Error(err))
+		return
 	}
 
 	msg := gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedChainGovernorStatus{
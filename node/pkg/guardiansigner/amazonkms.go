@@ -163,6 +163,14 @@ func (a *AmazonKms) Sign(ctx context.Context, hash []byte) (signature []byte, er
 		return nil, fmt.Errorf("Failed to decode signature: %w", err)
 	}
 
+	return recoverableSignature(hash, r, s, a.PublicKey(ctx))
+}
+
+// recoverableSignature normalizes an ECDSA signature (r, s) over hash to the 65-byte [R || S || V] form
+// used by ethcrypto, where s is in the lower half of the curve order and V is the recovery id. Signers
+// backed by an HSM or KMS do not provide the recovery id, so both recovery ids are tried against the
+// expected public key, which also ensures that the signature is valid.
+func recoverableSignature(hash []byte, r []byte, s []byte, expectedPublicKey ecdsa.PublicKey) ([]byte, error) {
 	// if s is greater than secp256k1HalfN, we need to substract secp256k1N from it
 	sBigInt := new(big.Int).SetBytes(s)
 	if sBigInt.Cmp(secp256k1HalfN) > 0 {
@@ -173,29 +181,24 @@ func (a *AmazonKms) Sign(ctx context.Context, hash []byte) (signature []byte, er
 	r = adjustBufferSize(r)
 	s = adjustBufferSize(s)
 
-	// AWS KMS does not provide the recovery id. But that doesn't matter too much, since we can
-	// attempt recovery id's 0 and 1, and in the process ensure that the signature is valid.
-	expectedPublicKey := a.PublicKey(ctx)
-	signature = append(r, s...)
-
-	// try recovery id 0
-	ecSigWithRecid := append(signature, []byte{0}...)
-	pubkey, _ := ethcrypto.SigToPub(hash[:], ecSigWithRecid)
-
-	if bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(&expectedPublicKey)) {
-		return ecSigWithRecid, nil
-	}
+	signature := make([]byte, 0, 65)
+	signature = append(signature, r...)
+	signature = append(signature, s...)
 
-	ecSigWithRecid = append(signature, []byte{1}...)
-	pubkey, _ = ethcrypto.SigToPub(hash[:], ecSigWithRecid)
+	for _, recid := range []byte{0, 1} {
+		ecSigWithRecid := append(signature, recid)
+		pubkey, err := ethcrypto.SigToPub(hash, ecSigWithRecid)
+		if err != nil {
+			continue
+		}
 
-	// try recovery id 1
-	if bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(&expectedPublicKey)) {
-		return ecSigWithRecid, nil
+		if bytes.Equal(ethcrypto.CompressPubkey(pubkey), ethcrypto.CompressPubkey(&expectedPublicKey)) {
+			return ecSigWithRecid, nil
+		}
 	}
 
 	// Reaching this return implies that it wasn't possible to generate a valid signature. This shouldn't
-	// happen, unless there is something seriously wrong with the KMS service.
+	// happen, unless there is something seriously wrong with the signing backend.
 	return nil, fmt.Errorf("Failed to generate valid signature")
 }
 
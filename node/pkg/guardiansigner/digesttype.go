@@ -0,0 +1,62 @@
+package guardiansigner
+
+import "context"
+
+// DigestType describes the kind of message a digest passed to GuardianSigner.Sign was computed over. It is
+// attached to the context by the caller, so that signers that enforce a policy (like the remote signer) can
+// tell the digests apart. The digest type is informational, as it can't be derived from the digest itself: a
+// compromised caller can attach any digest type to any digest, so it must not be relied on for security.
+type DigestType string
+
+const (
+	// DigestTypeUnknown is used for digests that were signed without a digest type in the context.
+	DigestTypeUnknown DigestType = "unknown"
+	// DigestTypeObservation is the signing digest of a VAA body.
+	DigestTypeObservation DigestType = "observation"
+	// DigestTypeHeartbeat is the digest of a gossip heartbeat.
+	DigestTypeHeartbeat DigestType = "heartbeat"
+	// DigestTypeObservationRequest is the digest of a gossip re-observation request.
+	DigestTypeObservationRequest DigestType = "observation_request"
+	// DigestTypeGovernorConfig is the digest of a governor config gossip message.
+	DigestTypeGovernorConfig DigestType = "governor_config"
+	// DigestTypeGovernorStatus is the digest of a governor status gossip message.
+	DigestTypeGovernorStatus DigestType = "governor_status"
+	// DigestTypeAccountantObservation is the digest of an observation submitted to the accountant.
+	DigestTypeAccountantObservation DigestType = "accountant_observation"
+	// DigestTypeQueryResponse is the digest of a cross chain query response.
+	DigestTypeQueryResponse DigestType = "query_response"
+	// DigestTypeWormchainAddress is the digest of a wormchain address registration.
+	DigestTypeWormchainAddress DigestType = "wormchain_address"
+	// DigestTypeCanary is the digest of a signer health check, which is never published. Like any other digest
+	// type, it is only a label: signatures made for it are as valid as all others.
+	DigestTypeCanary DigestType = "canary"
+)
+
+// KnownDigestTypes lists all the digest types, in the order they are declared above.
+var KnownDigestTypes = []DigestType{
+	DigestTypeUnknown,
+	DigestTypeObservation,
+	DigestTypeHeartbeat,
+	DigestTypeObservationRequest,
+	DigestTypeGovernorConfig,
+	DigestTypeGovernorStatus,
+	DigestTypeAccountantObservation,
+	DigestTypeQueryResponse,
+	DigestTypeWormchainAddress,
+	DigestTypeCanary,
+}
+
+type digestTypeKey struct{}
+
+// WithDigestType returns a copy of ctx that carries the given digest type.
+func WithDigestType(ctx context.Context, digestType DigestType) context.Context {
+	return context.WithValue(ctx, digestTypeKey{}, digestType)
+}
+
+// DigestTypeFromContext returns the digest type carried by ctx, or DigestTypeUnknown if there is none.
+func DigestTypeFromContext(ctx context.Context) DigestType {
+	if digestType, ok := ctx.Value(digestTypeKey{}).(DigestType); ok {
+		return digestType
+	}
+	return DigestTypeUnknown
+}
@@ -17,6 +17,12 @@ const (
 	FileSignerType
 	// amazonkms://<arn>
 	AmazonKmsSignerType
+	// pkcs11://<module-path>?token=<label>&key=<label>&pin-file=<path>
+	Pkcs11SignerType
+	// remote://<host>:<port>?ca-cert=<path>&client-cert=<path>&client-key=<path>
+	RemoteSignerType
+	// threshold://<path-to-config>
+	ThresholdSignerType
 )
 
 // GuardianSigner interface. Each function in the GuardianSigner interface
@@ -45,6 +51,18 @@ type GuardianSigner interface {
 // external services during construction. For example, the Amazon KMS signer validates that
 // the ARN is valid and retrieves the public key from the service.
 func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMode bool) (GuardianSigner, error) {
+	guardianSigner, err := newGuardianSigner(ctx, signerUri, unsafeDevMode)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrap the guardian signer in a benchmark signer, which will record the
+	// time taken to sign and verify messages.
+	return BenchmarkWrappedSigner(guardianSigner), nil
+}
+
+// newGuardianSigner creates a new GuardianSigner from the given URI, without wrapping it.
+func newGuardianSigner(ctx context.Context, signerUri string, unsafeDevMode bool) (GuardianSigner, error) {
 	// Get the signer type and key configuration. The key configuration
 	// isn't interpreted as anything in particular here, as each signer
 	// implementation requires different configurations; i.e., the file
@@ -65,6 +83,12 @@ func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMo
 		guardianSigner, err = NewFileSigner(ctx, unsafeDevMode, signerKeyConfig)
 	case AmazonKmsSignerType:
 		guardianSigner, err = NewAmazonKmsSigner(ctx, unsafeDevMode, signerKeyConfig)
+	case Pkcs11SignerType:
+		guardianSigner, err = NewPkcs11Signer(ctx, unsafeDevMode, signerKeyConfig)
+	case RemoteSignerType:
+		guardianSigner, err = NewRemoteSigner(ctx, unsafeDevMode, signerKeyConfig)
+	case ThresholdSignerType:
+		guardianSigner, err = NewThresholdSigner(ctx, unsafeDevMode, signerKeyConfig)
 	default:
 		return nil, errors.New("unsupported guardian signer type")
 	}
@@ -73,9 +97,7 @@ func NewGuardianSignerFromUri(ctx context.Context, signerUri string, unsafeDevMo
 		return nil, err
 	}
 
-	// Wrap the guardian signer in a benchmark signer, which will record the
-	// time taken to sign and verify messages.
-	return BenchmarkWrappedSigner(guardianSigner), nil
+	return guardianSigner, nil
 }
 
 // Parse the signer URI and return the signer type and key configuration. The signer
@@ -104,6 +126,12 @@ func ParseSignerUri(signerUri string) (signerType SignerType, signerKeyConfig st
 		return FileSignerType, keyConfig, nil
 	case "amazonkms":
 		return AmazonKmsSignerType, keyConfig, nil
+	case "pkcs11":
+		return Pkcs11SignerType, keyConfig, nil
+	case "remote":
+		return RemoteSignerType, keyConfig, nil
+	case "threshold":
+		return ThresholdSignerType, keyConfig, nil
 	default:
 		return InvalidSignerType, "", fmt.Errorf("unsupported guardian signer type: %s", typeStr)
 	}
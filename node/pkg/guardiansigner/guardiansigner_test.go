@@ -26,6 +26,12 @@ func TestParseSignerUri(t *testing.T) {
 		{label: "FileUriTraversal", path: "file://../../../file", expectedType: FileSignerType},
 		// Amazon KMS
 		{label: "AmazonKmsURI", path: "amazonkms://some-arn", expectedType: AmazonKmsSignerType},
+		// PKCS#11
+		{label: "Pkcs11URI", path: "pkcs11:///usr/lib/softhsm/libsofthsm2.so?token=guardian&key=guardian&pin-env=PIN", expectedType: Pkcs11SignerType},
+		// Remote
+		{label: "RemoteURI", path: "remote://signer.internal:7090?ca-cert=ca.pem&client-cert=client.pem&client-key=client.key", expectedType: RemoteSignerType},
+		// Threshold
+		{label: "ThresholdURI", path: "threshold:///etc/guardiand/threshold.json", expectedType: ThresholdSignerType},
 	}
 
 	for _, testcase := range tests {
@@ -0,0 +1,351 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+)
+
+// The ASN.1 object identifier of the secp256k1 curve, as found in the CKA_EC_PARAMS attribute of the key.
+var secp256k1Oid = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+
+// Pkcs11Signer is a signer that signs using a secp256k1 key stored in a PKCS#11 token, like an HSM
+// or SoftHSM. The URI is expected to be in the format
+//
+//	pkcs11://<module-path>?token=<token-label>&key=<key-label>&pin-file=<path>
+//
+// The token can be selected by label (token=) and/or by slot id (slot=), at least one of which
+// is required. The user PIN is read from a file (pin-file=) or an environment variable (pin-env=),
+// so that it does not end up in the command line of the guardian.
+//
+// NOTE: PKCS#11 calls are blocking and can't be cancelled, so the context is ignored.
+type Pkcs11Signer struct {
+	config    pkcs11Config
+	publicKey ecdsa.PublicKey
+
+	// mu serializes access to the session, as PKCS#11 sessions must not be used concurrently.
+	mu         sync.Mutex
+	ctx        *pkcs11.Ctx
+	session    pkcs11.SessionHandle
+	privateKey pkcs11.ObjectHandle
+}
+
+// pkcs11Config is the parsed key configuration of a pkcs11:// signer URI.
+type pkcs11Config struct {
+	modulePath string
+	tokenLabel string
+	slot       *uint
+	keyLabel   string
+	pinFile    string
+	pinEnv     string
+}
+
+// parsePkcs11Config parses the key configuration of a pkcs11:// signer URI, which is everything after the scheme
+// separator; i.e., <module-path>?<query>.
+func parsePkcs11Config(keyConfig string) (pkcs11Config, error) {
+	modulePath, rawQuery, _ := strings.Cut(keyConfig, "?")
+	if modulePath == "" {
+		return pkcs11Config{}, errors.New("PKCS#11 signer URI is missing the module path")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return pkcs11Config{}, fmt.Errorf("invalid PKCS#11 signer URI query: %w", err)
+	}
+
+	config := pkcs11Config{
+		modulePath: modulePath,
+		tokenLabel: query.Get("token"),
+		keyLabel:   query.Get("key"),
+		pinFile:    query.Get("pin-file"),
+		pinEnv:     query.Get("pin-env"),
+	}
+
+	for param := range query {
+		switch param {
+		case "token", "slot", "key", "pin-file", "pin-env":
+		default:
+			return pkcs11Config{}, fmt.Errorf("unknown PKCS#11 signer URI parameter: %s", param)
+		}
+	}
+
+	if slotStr := query.Get("slot"); slotStr != "" {
+		slot, err := strconv.ParseUint(slotStr, 10, 32)
+		if err != nil {
+			return pkcs11Config{}, fmt.Errorf("invalid PKCS#11 slot %q: %w", slotStr, err)
+		}
+		slotId := uint(slot)
+		config.slot = &slotId
+	}
+
+	if config.tokenLabel == "" && config.slot == nil {
+		return pkcs11Config{}, errors.New("PKCS#11 signer URI must specify a token label or a slot")
+	}
+
+	if config.keyLabel == "" {
+		return pkcs11Config{}, errors.New("PKCS#11 signer URI must specify a key label")
+	}
+
+	if (config.pinFile == "") == (config.pinEnv == "") {
+		return pkcs11Config{}, errors.New("PKCS#11 signer URI must specify exactly one of pin-file or pin-env")
+	}
+
+	return config, nil
+}
+
+// pin returns the user PIN of the token, read from the configured file or environment variable.
+func (c *pkcs11Config) pin() (string, error) {
+	if c.pinEnv != "" {
+		pin, ok := os.LookupEnv(c.pinEnv)
+		if !ok || pin == "" {
+			return "", fmt.Errorf("PKCS#11 PIN environment variable %s is not set", c.pinEnv)
+		}
+		return pin, nil
+	}
+
+	b, err := os.ReadFile(c.pinFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read PKCS#11 PIN file: %w", err)
+	}
+
+	pin := strings.TrimSpace(string(b))
+	if pin == "" {
+		return "", errors.New("PKCS#11 PIN file is empty")
+	}
+
+	return pin, nil
+}
+
+// NewPkcs11Signer creates a new Pkcs11Signer. The PKCS#11 module is loaded, the token is looked up by
+// slot and/or label, and a session is opened and logged into for the lifetime of the signer.
+// NOTE: The public key is retrieved during signer creation, and stored as a property of the
+// signer. This is because the public key is not expected to change during runtime.
+func NewPkcs11Signer(ctx context.Context, unsafeDevMode bool, keyConfig string) (*Pkcs11Signer, error) {
+	config, err := parsePkcs11Config(keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	pin, err := config.pin()
+	if err != nil {
+		return nil, err
+	}
+
+	p := pkcs11.New(config.modulePath)
+	if p == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", config.modulePath)
+	}
+
+	// The module might already have been initialized by another user in this process.
+	if err := p.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		p.Destroy()
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	signer, err := openPkcs11Signer(p, config, pin)
+	if err != nil {
+		p.Destroy()
+		return nil, err
+	}
+
+	return signer, nil
+}
+
+func openPkcs11Signer(p *pkcs11.Ctx, config pkcs11Config, pin string) (*Pkcs11Signer, error) {
+	slot, err := findPkcs11Slot(p, config)
+	if err != nil {
+		return nil, err
+	}
+
+	session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+
+	if err := p.Login(session, pkcs11.CKU_USER, pin); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		_ = p.CloseSession(session)
+		return nil, fmt.Errorf("failed to log into PKCS#11 token: %w", err)
+	}
+
+	privateKey, err := findPkcs11Object(p, session, pkcs11.CKO_PRIVATE_KEY, config.keyLabel)
+	if err != nil {
+		_ = p.CloseSession(session)
+		return nil, err
+	}
+
+	publicKeyObject, err := findPkcs11Object(p, session, pkcs11.CKO_PUBLIC_KEY, config.keyLabel)
+	if err != nil {
+		_ = p.CloseSession(session)
+		return nil, err
+	}
+
+	attrs, err := p.GetAttributeValue(session, publicKeyObject, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, nil),
+		pkcs11.NewAttribute(pkcs11.CKA_EC_POINT, nil),
+	})
+	if err != nil {
+		_ = p.CloseSession(session)
+		return nil, fmt.Errorf("failed to read PKCS#11 public key: %w", err)
+	}
+
+	publicKey, err := pkcs11PublicKey(attrs[0].Value, attrs[1].Value)
+	if err != nil {
+		_ = p.CloseSession(session)
+		return nil, err
+	}
+
+	return &Pkcs11Signer{
+		config:     config,
+		publicKey:  *publicKey,
+		ctx:        p,
+		session:    session,
+		privateKey: privateKey,
+	}, nil
+}
+
+// findPkcs11Slot returns the slot of the token matching the configured slot id and/or token label.
+func findPkcs11Slot(p *pkcs11.Ctx, config pkcs11Config) (uint, error) {
+	slots, err := p.GetSlotList(true)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+
+	for _, slot := range slots {
+		if config.slot != nil && *config.slot != slot {
+			continue
+		}
+
+		if config.tokenLabel != "" {
+			info, err := p.GetTokenInfo(slot)
+			if err != nil {
+				return 0, fmt.Errorf("failed to read PKCS#11 token info of slot %d: %w", slot, err)
+			}
+
+			if strings.TrimRight(info.Label, " \x00") != config.tokenLabel {
+				continue
+			}
+		}
+
+		return slot, nil
+	}
+
+	return 0, fmt.Errorf("no PKCS#11 token found for slot %v and label %q", config.slot, config.tokenLabel)
+}
+
+// findPkcs11Object returns the single object of the given class with the given label.
+func findPkcs11Object(p *pkcs11.Ctx, session pkcs11.SessionHandle, class uint, label string) (pkcs11.ObjectHandle, error) {
+	if err := p.FindObjectsInit(session, []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, class),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, label),
+	}); err != nil {
+		return 0, fmt.Errorf("failed to search PKCS#11 objects: %w", err)
+	}
+
+	objects, _, err := p.FindObjects(session, 2)
+	if finalErr := p.FindObjectsFinal(session); err == nil {
+		err = finalErr
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to search PKCS#11 objects: %w", err)
+	}
+
+	switch len(objects) {
+	case 0:
+		return 0, fmt.Errorf("no PKCS#11 key found with label %q", label)
+	case 1:
+		return objects[0], nil
+	default:
+		return 0, fmt.Errorf("multiple PKCS#11 keys found with label %q", label)
+	}
+}
+
+// pkcs11PublicKey parses the CKA_EC_PARAMS and CKA_EC_POINT attributes of a PKCS#11 public key, and checks
+// that the key is a secp256k1 key.
+func pkcs11PublicKey(ecParams []byte, ecPoint []byte) (*ecdsa.PublicKey, error) {
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(ecParams, &curve); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal PKCS#11 key curve: %w", err)
+	}
+
+	if !curve.Equal(secp256k1Oid) {
+		return nil, fmt.Errorf("PKCS#11 key is not a secp256k1 key (curve %s)", curve)
+	}
+
+	// The PKCS#11 specification requires the point to be a DER-encoded octet string, but some modules return
+	// the raw uncompressed point.
+	point := ecPoint
+	if len(ecPoint) != 65 {
+		if _, err := asn1.Unmarshal(ecPoint, &point); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal PKCS#11 public key: %w", err)
+		}
+	}
+
+	publicKey, err := ethcrypto.UnmarshalPubkey(point)
+	if err != nil {
+		return nil, fmt.Errorf("invalid PKCS#11 public key: %w", err)
+	}
+
+	return publicKey, nil
+}
+
+// pkcs11SignatureToRS splits a CKM_ECDSA signature into r and s. The PKCS#11 specification defines the
+// signature as r || s, but some modules return a DER-encoded signature like AWS KMS does.
+func pkcs11SignatureToRS(signature []byte) ([]byte, []byte, error) {
+	if len(signature) == 64 {
+		return signature[:32], signature[32:], nil
+	}
+
+	return derSignatureToRS(signature)
+}
+
+func (p *Pkcs11Signer) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// CKM_ECDSA signs the digest as-is, so the keccak256 hash is passed in directly.
+	if err := p.ctx.SignInit(p.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_ECDSA, nil)}, p.privateKey); err != nil {
+		return nil, fmt.Errorf("PKCS#11 signing failed: %w", err)
+	}
+
+	res, err := p.ctx.Sign(p.session, hash)
+	if err != nil {
+		return nil, fmt.Errorf("PKCS#11 signing failed: %w", err)
+	}
+
+	r, s, err := pkcs11SignatureToRS(res)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode signature: %w", err)
+	}
+
+	return recoverableSignature(hash, r, s, p.publicKey)
+}
+
+func (p *Pkcs11Signer) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return p.publicKey
+}
+
+func (p *Pkcs11Signer) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	// Use ethcrypto to recover the public key
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&p.publicKey), nil
+}
+
+// Return the signer type as "pkcs11".
+func (p *Pkcs11Signer) TypeAsString() string {
+	return "pkcs11"
+}
@@ -0,0 +1,254 @@
+package guardiansigner
+
+import (
+	"context"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/miekg/pkcs11"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePkcs11Config(t *testing.T) {
+	config, err := parsePkcs11Config("/usr/lib/softhsm/libsofthsm2.so?token=guardian&slot=3&key=signing&pin-file=/run/secrets/pin")
+	require.NoError(t, err)
+	assert.Equal(t, "/usr/lib/softhsm/libsofthsm2.so", config.modulePath)
+	assert.Equal(t, "guardian", config.tokenLabel)
+	require.NotNil(t, config.slot)
+	assert.Equal(t, uint(3), *config.slot)
+	assert.Equal(t, "signing", config.keyLabel)
+	assert.Equal(t, "/run/secrets/pin", config.pinFile)
+
+	tests := []struct {
+		label         string
+		keyConfig     string
+		expectedError string
+	}{
+		{label: "NoModule", keyConfig: "?token=guardian&key=signing&pin-env=PIN", expectedError: "missing the module path"},
+		{label: "NoToken", keyConfig: "/lib.so?key=signing&pin-env=PIN", expectedError: "token label or a slot"},
+		{label: "InvalidSlot", keyConfig: "/lib.so?slot=abc&key=signing&pin-env=PIN", expectedError: "invalid PKCS#11 slot"},
+		{label: "NoKey", keyConfig: "/lib.so?token=guardian&pin-env=PIN", expectedError: "key label"},
+		{label: "NoPin", keyConfig: "/lib.so?token=guardian&key=signing", expectedError: "exactly one of pin-file or pin-env"},
+		{label: "TwoPins", keyConfig: "/lib.so?token=guardian&key=signing&pin-env=PIN&pin-file=/pin", expectedError: "exactly one of pin-file or pin-env"},
+		{label: "UnknownParameter", keyConfig: "/lib.so?token=guardian&key=signing&pin-env=PIN&pin=1234", expectedError: "unknown PKCS#11 signer URI parameter"},
+	}
+
+	for _, testcase := range tests {
+		t.Run(testcase.label, func(t *testing.T) {
+			_, err := parsePkcs11Config(testcase.keyConfig)
+			assert.ErrorContains(t, err, testcase.expectedError)
+		})
+	}
+}
+
+func TestPkcs11ConfigPin(t *testing.T) {
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte("1234\n"), 0600))
+
+	config := pkcs11Config{pinFile: pinFile}
+	pin, err := config.pin()
+	require.NoError(t, err)
+	assert.Equal(t, "1234", pin)
+
+	t.Setenv("GUARDIAN_TEST_PKCS11_PIN", "5678")
+	config = pkcs11Config{pinEnv: "GUARDIAN_TEST_PKCS11_PIN"}
+	pin, err = config.pin()
+	require.NoError(t, err)
+	assert.Equal(t, "5678", pin)
+
+	config = pkcs11Config{pinEnv: "GUARDIAN_TEST_PKCS11_PIN_UNSET"}
+	_, err = config.pin()
+	assert.Error(t, err)
+}
+
+func TestPkcs11PublicKey(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	ecParams, err := asn1.Marshal(secp256k1Oid)
+	require.NoError(t, err)
+	rawPoint := ethcrypto.FromECDSAPub(&key.PublicKey)
+	derPoint, err := asn1.Marshal(rawPoint)
+	require.NoError(t, err)
+
+	publicKey, err := pkcs11PublicKey(ecParams, derPoint)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(&key.PublicKey))
+
+	publicKey, err = pkcs11PublicKey(ecParams, rawPoint)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(&key.PublicKey))
+
+	// P-256 keys are rejected
+	p256Params, err := asn1.Marshal(asn1.ObjectIdentifier{1, 2, 840, 10045, 3, 1, 7})
+	require.NoError(t, err)
+	_, err = pkcs11PublicKey(p256Params, derPoint)
+	assert.ErrorContains(t, err, "not a secp256k1 key")
+}
+
+func TestPkcs11SignatureNormalization(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	hash := ethcrypto.Keccak256([]byte("data"))
+	expectedSig, err := ethcrypto.Sign(hash, key)
+	require.NoError(t, err)
+
+	r := new(big.Int).SetBytes(expectedSig[:32])
+	s := new(big.Int).SetBytes(expectedSig[32:64])
+	highS := new(big.Int).Sub(secp256k1N, s)
+
+	derSig, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+	require.NoError(t, err)
+	derSigHighS, err := asn1.Marshal(struct{ R, S *big.Int }{r, highS})
+	require.NoError(t, err)
+
+	tests := []struct {
+		label     string
+		signature []byte
+	}{
+		{label: "Raw", signature: expectedSig[:64]},
+		{label: "RawHighS", signature: append(adjustBufferSize(r.Bytes()), adjustBufferSize(highS.Bytes())...)},
+		{label: "DER", signature: derSig},
+		{label: "DERHighS", signature: derSigHighS},
+	}
+
+	for _, testcase := range tests {
+		t.Run(testcase.label, func(t *testing.T) {
+			sigR, sigS, err := pkcs11SignatureToRS(testcase.signature)
+			require.NoError(t, err)
+
+			sig, err := recoverableSignature(hash, sigR, sigS, key.PublicKey)
+			require.NoError(t, err)
+			assert.Equal(t, expectedSig, sig)
+
+			_, err = recoverableSignature(hash, sigR, sigS, otherKey.PublicKey)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// softHsmModule returns the path of the SoftHSM PKCS#11 module, or skips the test if SoftHSM isn't installed.
+// The path can be overridden with the SOFTHSM2_MODULE environment variable.
+func softHsmModule(t *testing.T) string {
+	candidates := []string{
+		os.Getenv("SOFTHSM2_MODULE"),
+		"/usr/lib/softhsm/libsofthsm2.so",
+		"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/lib64/pkcs11/libsofthsm2.so",
+		"/usr/local/lib/softhsm/libsofthsm2.so",
+		"/opt/homebrew/lib/softhsm/libsofthsm2.so",
+	}
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+	}
+
+	t.Skip("SoftHSM is not installed, set SOFTHSM2_MODULE to the path of libsofthsm2.so to run this test")
+	return ""
+}
+
+// provisionSoftHsm initializes a SoftHSM token in a temporary directory, and generates a secp256k1 key pair on it.
+func provisionSoftHsm(t *testing.T, module string, tokenLabel string, keyLabel string, userPin string) {
+	tokenDir := t.TempDir()
+	conf := filepath.Join(t.TempDir(), "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte(fmt.Sprintf("directories.tokendir = %s\nobjectstore.backend = file\nlog.level = ERROR\n", tokenDir)), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	p := pkcs11.New(module)
+	require.NotNil(t, p)
+	defer p.Destroy()
+	require.NoError(t, p.Initialize())
+	defer func() { _ = p.Finalize() }()
+
+	slots, err := p.GetSlotList(true)
+	require.NoError(t, err)
+	require.NotEmpty(t, slots)
+	require.NoError(t, p.InitToken(slots[0], "so-pin", tokenLabel))
+
+	// SoftHSM moves the initialized token to a new slot.
+	slot, err := findPkcs11Slot(p, pkcs11Config{tokenLabel: tokenLabel})
+	require.NoError(t, err)
+
+	session, err := p.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	require.NoError(t, err)
+	defer func() { _ = p.CloseSession(session) }()
+
+	require.NoError(t, p.Login(session, pkcs11.CKU_SO, "so-pin"))
+	require.NoError(t, p.InitPIN(session, userPin))
+	require.NoError(t, p.Logout(session))
+	require.NoError(t, p.Login(session, pkcs11.CKU_USER, userPin))
+
+	ecParams, err := asn1.Marshal(secp256k1Oid)
+	require.NoError(t, err)
+
+	_, _, err = p.GenerateKeyPair(session,
+		[]*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_EC_KEY_PAIR_GEN, nil)},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_VERIFY, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EC_PARAMS, ecParams),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+		},
+		[]*pkcs11.Attribute{
+			pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+			pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+			pkcs11.NewAttribute(pkcs11.CKA_SIGN, true),
+			pkcs11.NewAttribute(pkcs11.CKA_LABEL, keyLabel),
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestPkcs11SignerSoftHsm(t *testing.T) {
+	ctx := context.Background()
+	module := softHsmModule(t)
+	provisionSoftHsm(t, module, "guardian", "guardian-key", "1234")
+
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte("1234"), 0600))
+
+	signer, err := NewGuardianSignerFromUri(ctx, fmt.Sprintf("pkcs11://%s?token=guardian&key=guardian-key&pin-file=%s", module, pinFile), false)
+	require.NoError(t, err)
+	assert.Equal(t, "benchmark", signer.TypeAsString())
+
+	publicKey := signer.PublicKey(ctx)
+
+	// Sign enough hashes that both recovery ids and high s values are hit.
+	for i := 0; i < 32; i++ {
+		hash := ethcrypto.Keccak256([]byte(fmt.Sprintf("data %d", i)))
+		sig, err := signer.Sign(ctx, hash)
+		require.NoError(t, err)
+		require.Len(t, sig, 65)
+
+		recovered, err := ethcrypto.SigToPub(hash, sig)
+		require.NoError(t, err)
+		assert.True(t, recovered.Equal(&publicKey))
+
+		valid, err := signer.Verify(ctx, sig, hash)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		// Signatures must be in the lower half of the curve order to be accepted by the contracts.
+		assert.True(t, new(big.Int).SetBytes(sig[32:64]).Cmp(secp256k1HalfN) <= 0)
+	}
+
+	// An unknown key is rejected.
+	_, err = NewPkcs11Signer(ctx, false, fmt.Sprintf("%s?token=guardian&key=unknown&pin-file=%s", module, pinFile))
+	assert.Error(t, err)
+}
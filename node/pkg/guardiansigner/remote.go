@@ -0,0 +1,226 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	// The timeout for remote signer operations. This is necessary to avoid situations where
+	// the signing or verification is blocked indefinitely.
+	REMOTE_SIGNER_TIMEOUT = time.Second * 15
+)
+
+// RemoteSigner is a signer that delegates signing to a remote signer (see `guardiand signer-server`)
+// over gRPC with mutual TLS. The URI is expected to be in the format
+//
+//	remote://<host>:<port>?ca-cert=<path>&client-cert=<path>&client-key=<path>
+//
+// The ca-cert is used to verify the certificate of the remote signer, and the client-cert and
+// client-key are presented to the remote signer to authenticate the guardian. The server name
+// used to verify the certificate of the remote signer defaults to the host, and can be overridden
+// with server-name=<name>.
+type RemoteSigner struct {
+	address   string
+	conn      *grpc.ClientConn
+	client    signerv1.SignerServiceClient
+	publicKey ecdsa.PublicKey
+}
+
+// remoteSignerConfig is the parsed key configuration of a remote:// signer URI.
+type remoteSignerConfig struct {
+	address    string
+	caCert     string
+	clientCert string
+	clientKey  string
+	serverName string
+}
+
+// parseRemoteSignerConfig parses the key configuration of a remote:// signer URI, which is everything after the
+// scheme separator; i.e., <host>:<port>?<query>.
+func parseRemoteSignerConfig(keyConfig string) (remoteSignerConfig, error) {
+	address, rawQuery, _ := strings.Cut(keyConfig, "?")
+	if address == "" {
+		return remoteSignerConfig{}, errors.New("remote signer URI is missing the address")
+	}
+
+	query, err := url.ParseQuery(rawQuery)
+	if err != nil {
+		return remoteSignerConfig{}, fmt.Errorf("invalid remote signer URI query: %w", err)
+	}
+
+	for param := range query {
+		switch param {
+		case "ca-cert", "client-cert", "client-key", "server-name":
+		default:
+			return remoteSignerConfig{}, fmt.Errorf("unknown remote signer URI parameter: %s", param)
+		}
+	}
+
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return remoteSignerConfig{}, fmt.Errorf("remote signer address must be in the format <host>:<port>: %w", err)
+	}
+	if host == "" || port == "" {
+		return remoteSignerConfig{}, errors.New("remote signer address must be in the format <host>:<port>")
+	}
+
+	config := remoteSignerConfig{
+		address:    address,
+		caCert:     query.Get("ca-cert"),
+		clientCert: query.Get("client-cert"),
+		clientKey:  query.Get("client-key"),
+		serverName: query.Get("server-name"),
+	}
+
+	if config.caCert == "" || config.clientCert == "" || config.clientKey == "" {
+		return remoteSignerConfig{}, errors.New("remote signer URI must specify ca-cert, client-cert and client-key")
+	}
+
+	if config.serverName == "" {
+		config.serverName = host
+	}
+
+	return config, nil
+}
+
+// tlsConfig returns the TLS configuration used to connect to the remote signer.
+func (c *remoteSignerConfig) tlsConfig() (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(c.clientCert, c.clientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load remote signer client certificate: %w", err)
+	}
+
+	rootCAs, err := loadCertPool(c.caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      rootCAs,
+		ServerName:   c.serverName,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// loadCertPool loads a PEM-encoded certificate bundle into a certificate pool.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+
+	return pool, nil
+}
+
+// NewRemoteSigner creates a new RemoteSigner. A connection to the remote signer is set up, and the
+// public key is retrieved from the remote signer.
+// NOTE: The public key is retrieved during signer creation, and stored as a property of the
+// signer. This is because the public key is not expected to change during runtime.
+func NewRemoteSigner(ctx context.Context, unsafeDevMode bool, keyConfig string) (*RemoteSigner, error) {
+	config, err := parseRemoteSignerConfig(keyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := config.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, config.address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to remote signer: %w", err)
+	}
+
+	remoteSigner := &RemoteSigner{
+		address: config.address,
+		conn:    conn,
+		client:  signerv1.NewSignerServiceClient(conn),
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, REMOTE_SIGNER_TIMEOUT)
+	defer cancel()
+
+	res, err := remoteSigner.client.PublicKey(timeoutCtx, &signerv1.PublicKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to retrieve public key from remote signer: %w", err)
+	}
+
+	publicKey, err := ethcrypto.UnmarshalPubkey(res.PublicKey)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("invalid public key from remote signer: %w", err)
+	}
+
+	remoteSigner.publicKey = *publicKey
+
+	return remoteSigner, nil
+}
+
+// Sign sends the hash to the remote signer, along with the digest type in the context. The signature
+// returned by the remote signer is checked against the public key before it is returned.
+func (r *RemoteSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, REMOTE_SIGNER_TIMEOUT)
+	defer cancel()
+
+	res, err := r.client.Sign(timeoutCtx, &signerv1.SignRequest{
+		Digest:     hash,
+		DigestType: string(DigestTypeFromContext(ctx)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("remote signing failed: %w", err)
+	}
+
+	valid, err := r.Verify(ctx, res.Signature, hash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from remote signer: %w", err)
+	}
+
+	if !valid {
+		return nil, errors.New("remote signer signed with an unexpected key")
+	}
+
+	return res.Signature, nil
+}
+
+func (r *RemoteSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return r.publicKey
+}
+
+func (r *RemoteSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	// Use ethcrypto to recover the public key
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&r.publicKey), nil
+}
+
+// Return the signer type as "remote".
+func (r *RemoteSigner) TypeAsString() string {
+	return "remote"
+}
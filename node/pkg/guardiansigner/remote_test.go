@@ -0,0 +1,284 @@
+package guardiansigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// testCA is a certificate authority that issues certificates for the remote signer tests.
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir()}
+	writePem(t, ca.path("ca.pem"), "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) path(name string) string {
+	return filepath.Join(ca.dir, name)
+}
+
+// issue issues a certificate and writes it to <name>.pem and <name>-key.pem.
+func (ca *testCA) issue(t *testing.T, name string, server bool) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if server {
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		template.IPAddresses = []net.IP{net.ParseIP("127.0.0.1")}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writePem(t, ca.path(name+".pem"), "CERTIFICATE", der)
+	writePem(t, ca.path(name+"-key.pem"), "EC PRIVATE KEY", keyDer)
+}
+
+func writePem(t *testing.T, path string, blockType string, der []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0600))
+}
+
+// startRemoteSigner starts a remote signer for signer on a random port, and returns its address.
+func startRemoteSigner(t *testing.T, ca *testCA, signer GuardianSigner, policy RemoteSignerPolicy, audit *bytes.Buffer) string {
+	ca.issue(t, "signer", true)
+
+	server, err := NewRemoteSignerServer(zap.NewNop(), signer, policy, audit)
+	require.NoError(t, err)
+
+	tlsConfig, err := NewRemoteSignerServerTLSConfig(ca.path("signer.pem"), ca.path("signer-key.pem"), ca.path("ca.pem"))
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	grpcServer := grpc.NewServer(grpc.Creds(credentials.NewTLS(tlsConfig)))
+	signerv1.RegisterSignerServiceServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	return listener.Addr().String()
+}
+
+func remoteSignerUri(ca *testCA, address string, client string) string {
+	return fmt.Sprintf("remote://%s?ca-cert=%s&client-cert=%s&client-key=%s", address, ca.path("ca.pem"), ca.path(client+".pem"), ca.path(client+"-key.pem"))
+}
+
+func readAuditLog(t *testing.T, audit *bytes.Buffer) []RemoteSignerAuditEntry {
+	entries := []RemoteSignerAuditEntry{}
+	for _, line := range strings.Split(strings.TrimSpace(audit.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry RemoteSignerAuditEntry
+		require.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func TestParseRemoteSignerConfig(t *testing.T) {
+	config, err := parseRemoteSignerConfig("signer.internal:7090?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem")
+	require.NoError(t, err)
+	assert.Equal(t, "signer.internal:7090", config.address)
+	assert.Equal(t, "signer.internal", config.serverName)
+
+	config, err = parseRemoteSignerConfig("10.0.0.1:7090?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem&server-name=signer")
+	require.NoError(t, err)
+	assert.Equal(t, "signer", config.serverName)
+
+	config, err = parseRemoteSignerConfig("[::1]:7090?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem")
+	require.NoError(t, err)
+	assert.Equal(t, "[::1]:7090", config.address)
+	assert.Equal(t, "::1", config.serverName)
+
+	for _, address := range []string{"signer.internal", "signer.internal:", ":7090", "::1:7090"} {
+		_, err = parseRemoteSignerConfig(address + "?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem")
+		assert.ErrorContains(t, err, "must be in the format <host>:<port>", address)
+	}
+
+	_, err = parseRemoteSignerConfig("?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem")
+	assert.ErrorContains(t, err, "missing the address")
+
+	_, err = parseRemoteSignerConfig("signer.internal:7090?ca-cert=/ca.pem")
+	assert.ErrorContains(t, err, "must specify ca-cert, client-cert and client-key")
+
+	_, err = parseRemoteSignerConfig("signer.internal:7090?ca-cert=/ca.pem&client-cert=/client.pem&client-key=/client-key.pem&insecure=true")
+	assert.ErrorContains(t, err, "unknown remote signer URI parameter")
+}
+
+func TestDigestTypeFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, DigestTypeUnknown, DigestTypeFromContext(ctx))
+	assert.Equal(t, DigestTypeHeartbeat, DigestTypeFromContext(WithDigestType(ctx, DigestTypeHeartbeat)))
+}
+
+func TestRemoteSigner(t *testing.T) {
+	ctx := context.Background()
+	ca := newTestCA(t, "test-ca")
+	ca.issue(t, "guardian", false)
+
+	inner, err := NewGeneratedSigner(nil)
+	require.NoError(t, err)
+
+	var audit bytes.Buffer
+	address := startRemoteSigner(t, ca, inner, RemoteSignerPolicy{
+		AllowedDigestTypes: []DigestType{DigestTypeObservation, DigestTypeHeartbeat},
+	}, &audit)
+
+	signer, err := NewGuardianSignerFromUri(ctx, remoteSignerUri(ca, address, "guardian"), false)
+	require.NoError(t, err)
+	assert.Equal(t, "benchmark", signer.TypeAsString())
+
+	innerPublicKey := inner.PublicKey(ctx)
+	publicKey := signer.PublicKey(ctx)
+	assert.True(t, publicKey.Equal(&innerPublicKey))
+
+	digest := ethcrypto.Keccak256([]byte("data"))
+	sig, err := signer.Sign(WithDigestType(ctx, DigestTypeObservation), digest)
+	require.NoError(t, err)
+	valid, err := signer.Verify(ctx, sig, digest)
+	require.NoError(t, err)
+	assert.True(t, valid)
+
+	// Digest types that are not allowed by the policy are refused.
+	_, err = signer.Sign(WithDigestType(ctx, DigestTypeGovernorStatus), digest)
+	assert.ErrorContains(t, err, "is not allowed")
+	_, err = signer.Sign(ctx, digest)
+	assert.ErrorContains(t, err, "is not allowed")
+
+	// Every request is written to the audit log.
+	entries := readAuditLog(t, &audit)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "guardian", entries[0].Client)
+	assert.Equal(t, string(DigestTypeObservation), entries[0].DigestType)
+	assert.Equal(t, fmt.Sprintf("%x", digest), entries[0].Digest)
+	assert.Equal(t, "signed", entries[0].Result)
+	assert.Equal(t, "denied", entries[1].Result)
+	assert.Equal(t, string(DigestTypeUnknown), entries[2].DigestType)
+	assert.Equal(t, "denied", entries[2].Result)
+}
+
+func TestRemoteSignerRateLimit(t *testing.T) {
+	ctx := context.Background()
+	ca := newTestCA(t, "test-ca")
+	ca.issue(t, "guardian", false)
+
+	inner, err := NewGeneratedSigner(nil)
+	require.NoError(t, err)
+
+	var audit bytes.Buffer
+	address := startRemoteSigner(t, ca, inner, RemoteSignerPolicy{RateLimit: 0.001, RateBurst: 2}, &audit)
+
+	signer, err := NewRemoteSigner(ctx, false, strings.TrimPrefix(remoteSignerUri(ca, address, "guardian"), "remote://"))
+	require.NoError(t, err)
+
+	digest := ethcrypto.Keccak256([]byte("data"))
+	for i := 0; i < 2; i++ {
+		_, err = signer.Sign(ctx, digest)
+		require.NoError(t, err)
+	}
+
+	_, err = signer.Sign(ctx, digest)
+	assert.ErrorContains(t, err, "rate limit exceeded")
+
+	entries := readAuditLog(t, &audit)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "rate_limited", entries[2].Result)
+}
+
+func TestRemoteSignerRejectsUnknownClients(t *testing.T) {
+	ctx := context.Background()
+	ca := newTestCA(t, "test-ca")
+
+	// The client certificate is issued by another CA, which trusts the same server.
+	otherCA := newTestCA(t, "other-ca")
+	otherCA.issue(t, "guardian", false)
+	caCert, err := os.ReadFile(ca.path("ca.pem"))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(otherCA.path("ca.pem"), caCert, 0600))
+
+	inner, err := NewGeneratedSigner(nil)
+	require.NoError(t, err)
+
+	var audit bytes.Buffer
+	address := startRemoteSigner(t, ca, inner, RemoteSignerPolicy{}, &audit)
+
+	_, err = NewGuardianSignerFromUri(ctx, remoteSignerUri(otherCA, address, "guardian"), false)
+	assert.Error(t, err)
+	assert.Empty(t, audit.String())
+}
+
+func TestRemoteSignerServerPolicy(t *testing.T) {
+	inner, err := NewGeneratedSigner(nil)
+	require.NoError(t, err)
+
+	_, err = NewRemoteSignerServer(zap.NewNop(), inner, RemoteSignerPolicy{AllowedDigestTypes: []DigestType{"transfer"}}, &bytes.Buffer{})
+	assert.ErrorContains(t, err, "unknown digest type")
+
+	_, err = NewRemoteSignerServer(zap.NewNop(), inner, RemoteSignerPolicy{RateLimit: -1}, &bytes.Buffer{})
+	assert.Error(t, err)
+
+	_, err = NewRemoteSignerServer(zap.NewNop(), inner, RemoteSignerPolicy{}, nil)
+	assert.ErrorContains(t, err, "audit log")
+
+	// Digests must be 32 bytes.
+	server, err := NewRemoteSignerServer(zap.NewNop(), inner, RemoteSignerPolicy{}, &bytes.Buffer{})
+	require.NoError(t, err)
+	_, err = server.Sign(context.Background(), &signerv1.SignRequest{Digest: []byte{1, 2, 3}, DigestType: string(DigestTypeObservation)})
+	assert.ErrorContains(t, err, "32 bytes")
+}
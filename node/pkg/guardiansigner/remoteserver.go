@@ -0,0 +1,240 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	// SECURITY: the digest_type label is reported by the client, so unknown digest types are recorded as "invalid".
+	remoteSignerRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_remote_signer_requests_total",
+			Help: "Total number of signing requests handled by the remote signer",
+		}, []string{"digest_type", "result"})
+)
+
+const (
+	remoteSignerResultSigned   = "signed"
+	remoteSignerResultDenied   = "denied"
+	remoteSignerResultLimited  = "rate_limited"
+	remoteSignerResultFailed   = "failed"
+	remoteSignerInvalidDigest  = "invalid"
+	remoteSignerUnknownSubject = "unknown"
+)
+
+// RemoteSignerPolicy is the policy enforced by the RemoteSignerServer.
+//
+// SECURITY: only the rate limit is enforced against a compromised client. The digest type of a request is asserted by
+// the client and cannot be checked against the digest, so AllowedDigestTypes only protects against misconfigured
+// clients. The audit log is what allows detecting misuse after the fact.
+type RemoteSignerPolicy struct {
+	// AllowedDigestTypes are the digest types that may be signed. If empty, all digest types are allowed.
+	AllowedDigestTypes []DigestType
+	// RateLimit is the maximum number of signatures per second. If zero, the number of signatures is not limited.
+	RateLimit float64
+	// RateBurst is the number of signatures that may be made at once, in excess of the rate limit.
+	RateBurst int
+}
+
+// RemoteSignerAuditEntry is a line of the audit log of the RemoteSignerServer, which records every signing request.
+type RemoteSignerAuditEntry struct {
+	Time       time.Time `json:"time"`
+	Client     string    `json:"client"`
+	DigestType string    `json:"digestType"`
+	Digest     string    `json:"digest"`
+	Result     string    `json:"result"`
+	Error      string    `json:"error,omitempty"`
+}
+
+// RemoteSignerServer implements the SignerService on top of a GuardianSigner, for use by the remote:// signer
+// of a guardian node. Every request is checked against the policy, and written to the audit log. See
+// RemoteSignerPolicy for what the policy can and cannot prevent.
+type RemoteSignerServer struct {
+	signerv1.UnimplementedSignerServiceServer
+	logger  *zap.Logger
+	signer  GuardianSigner
+	allowed map[DigestType]struct{}
+	limiter *rate.Limiter
+
+	auditMu sync.Mutex
+	audit   *json.Encoder
+}
+
+// NewRemoteSignerServer creates a new RemoteSignerServer, which signs with signer according to policy and writes
+// the audit log to audit.
+func NewRemoteSignerServer(logger *zap.Logger, signer GuardianSigner, policy RemoteSignerPolicy, audit io.Writer) (*RemoteSignerServer, error) {
+	if audit == nil {
+		return nil, fmt.Errorf("the remote signer requires an audit log")
+	}
+
+	s := &RemoteSignerServer{
+		logger: logger,
+		signer: signer,
+		audit:  json.NewEncoder(audit),
+	}
+
+	if len(policy.AllowedDigestTypes) != 0 {
+		s.allowed = make(map[DigestType]struct{}, len(policy.AllowedDigestTypes))
+		for _, digestType := range policy.AllowedDigestTypes {
+			if !isKnownDigestType(digestType) {
+				return nil, fmt.Errorf("unknown digest type: %s", digestType)
+			}
+			s.allowed[digestType] = struct{}{}
+		}
+	}
+
+	if policy.RateLimit < 0 || policy.RateBurst < 0 {
+		return nil, fmt.Errorf("the rate limit and burst must not be negative")
+	}
+
+	if policy.RateLimit > 0 {
+		burst := policy.RateBurst
+		if burst == 0 {
+			burst = 1
+		}
+		s.limiter = rate.NewLimiter(rate.Limit(policy.RateLimit), burst)
+	}
+
+	return s, nil
+}
+
+func isKnownDigestType(digestType DigestType) bool {
+	for _, known := range KnownDigestTypes {
+		if known == digestType {
+			return true
+		}
+	}
+	return false
+}
+
+// NewRemoteSignerServerTLSConfig returns the TLS configuration of a remote signer, which requires clients to present
+// a certificate signed by the CA in clientCAFile.
+func NewRemoteSignerServerTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	clientCAs, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+// clientSubject returns the common name of the verified client certificate of the request.
+func clientSubject(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return remoteSignerUnknownSubject
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return remoteSignerUnknownSubject
+	}
+
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
+
+// writeAudit appends an entry to the audit log.
+func (s *RemoteSignerServer) writeAudit(entry *RemoteSignerAuditEntry) error {
+	s.auditMu.Lock()
+	defer s.auditMu.Unlock()
+	return s.audit.Encode(entry)
+}
+
+// deny records a request that was not signed, and returns err.
+func (s *RemoteSignerServer) deny(entry *RemoteSignerAuditEntry, metricDigestType string, result string, err error) error {
+	entry.Result = result
+	entry.Error = err.Error()
+	remoteSignerRequests.WithLabelValues(metricDigestType, result).Inc()
+
+	s.logger.Warn("refused signing request",
+		zap.String("client", entry.Client),
+		zap.String("digestType", entry.DigestType),
+		zap.String("digest", entry.Digest),
+		zap.String("result", result),
+		zap.Error(err),
+	)
+
+	if auditErr := s.writeAudit(entry); auditErr != nil {
+		s.logger.Error("failed to write audit log", zap.Error(auditErr))
+	}
+
+	return err
+}
+
+func (s *RemoteSignerServer) Sign(ctx context.Context, req *signerv1.SignRequest) (*signerv1.SignResponse, error) {
+	digestType := DigestType(req.DigestType)
+	metricDigestType := string(digestType)
+	if !isKnownDigestType(digestType) {
+		metricDigestType = remoteSignerInvalidDigest
+	}
+
+	entry := &RemoteSignerAuditEntry{
+		Time:       time.Now(),
+		Client:     clientSubject(ctx),
+		DigestType: req.DigestType,
+		Digest:     hex.EncodeToString(req.Digest),
+	}
+
+	if len(req.Digest) != 32 {
+		return nil, s.deny(entry, metricDigestType, remoteSignerResultDenied, status.Error(codes.InvalidArgument, "the digest must be 32 bytes"))
+	}
+
+	if s.allowed != nil {
+		if _, ok := s.allowed[digestType]; !ok {
+			return nil, s.deny(entry, metricDigestType, remoteSignerResultDenied, status.Errorf(codes.PermissionDenied, "digest type %q is not allowed", req.DigestType))
+		}
+	}
+
+	if s.limiter != nil && !s.limiter.Allow() {
+		return nil, s.deny(entry, metricDigestType, remoteSignerResultLimited, status.Error(codes.ResourceExhausted, "rate limit exceeded"))
+	}
+
+	sig, err := s.signer.Sign(WithDigestType(ctx, digestType), req.Digest)
+	if err != nil {
+		return nil, s.deny(entry, metricDigestType, remoteSignerResultFailed, status.Errorf(codes.Internal, "failed to sign: %v", err))
+	}
+
+	// The signature is only handed out once it has been recorded in the audit log.
+	entry.Result = remoteSignerResultSigned
+	if err := s.writeAudit(entry); err != nil {
+		s.logger.Error("failed to write audit log, refusing to return signature", zap.Error(err))
+		remoteSignerRequests.WithLabelValues(metricDigestType, remoteSignerResultFailed).Inc()
+		return nil, status.Error(codes.Internal, "failed to write audit log")
+	}
+
+	remoteSignerRequests.WithLabelValues(metricDigestType, remoteSignerResultSigned).Inc()
+	return &signerv1.SignResponse{Signature: sig}, nil
+}
+
+func (s *RemoteSignerServer) PublicKey(ctx context.Context, req *signerv1.PublicKeyRequest) (*signerv1.PublicKeyResponse, error) {
+	publicKey := s.signer.PublicKey(ctx)
+	return &signerv1.PublicKeyResponse{PublicKey: ethcrypto.FromECDSAPub(&publicKey)}, nil
+}
@@ -0,0 +1,320 @@
+package guardiansigner
+
+/*
+	The Supervised signer is a type of signer that wraps one or more signers of
+	the same key, listed in order of preference. It periodically checks that the
+	signers work by signing a canary digest, fails over to the next signer if
+	signing fails, and enforces a budget on the number of signatures per second,
+	so that a misbehaving node can't sign arbitrarily many messages. Signing
+	requests in excess of the budget wait until the budget allows them.
+*/
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// The timeout for a single health check of a signer.
+	SIGNER_HEALTH_CHECK_TIMEOUT = time.Second * 10
+)
+
+// ErrSignatureBudgetExceeded is returned by SupervisedSigner.Sign when the context of a signing request that exceeds the
+// maximum number of signatures per second is done before the budget allows it.
+var ErrSignatureBudgetExceeded = errors.New("guardian signer signature budget exceeded")
+
+var (
+	guardianSignerHealthy = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_signer_healthy",
+			Help: "Whether the guardian signer passed its last health check or signing request (1) or not (0)",
+		}, []string{"signer_index", "signer_type"})
+	guardianSignerActiveIndex = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_signer_active_index",
+			Help: "Index of the guardian signer that produced the last signature (0 is the primary signer)",
+		})
+	guardianSignerFailovers = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_signer_failovers_total",
+			Help: "Total number of signing requests that failed over to the next guardian signer",
+		})
+	guardianSignerSignatures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_signer_signatures_total",
+			Help: "Total number of signatures made by the guardian signers, excluding health checks",
+		})
+	guardianSignerBudget = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_signer_budget_per_second",
+			Help: "Maximum number of signatures per second (0 if unlimited)",
+		})
+	guardianSignerBudgetExceeded = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_guardian_signer_budget_exceeded_total",
+			Help: "Total number of signing requests that were delayed because the signature budget was exceeded",
+		})
+)
+
+// SupervisedSignerConfig configures the health checks and the signature budget of a SupervisedSigner.
+type SupervisedSignerConfig struct {
+	// HealthCheckInterval is the interval between health checks of the signers. Zero disables the health checks.
+	HealthCheckInterval time.Duration
+	// MaxSignaturesPerSecond is the maximum sustained number of signatures per second. Zero disables the budget.
+	MaxSignaturesPerSecond float64
+	// SignatureBurst is the number of signatures that may be made at once in excess of MaxSignaturesPerSecond.
+	SignatureBurst int
+}
+
+// supervisedBackend is one of the signers of a SupervisedSigner.
+type supervisedBackend struct {
+	index  int
+	signer GuardianSigner
+
+	mu      sync.Mutex
+	healthy bool
+}
+
+func (b *supervisedBackend) isHealthy() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.healthy
+}
+
+// setHealthy records the health of the signer, and returns whether it changed.
+func (b *supervisedBackend) setHealthy(healthy bool) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	value := 0.0
+	if healthy {
+		value = 1.0
+	}
+	guardianSignerHealthy.WithLabelValues(strconv.Itoa(b.index), b.signer.TypeAsString()).Set(value)
+
+	changed := b.healthy != healthy
+	b.healthy = healthy
+	return changed
+}
+
+// SupervisedSigner is a signer that wraps an ordered list of signers of the same key. Signing requests go to the
+// first healthy signer, and fail over to the next signer if signing fails. Signers that failed are only used as a
+// last resort, until they pass a health check or sign successfully again.
+type SupervisedSigner struct {
+	backends  []*supervisedBackend
+	publicKey ecdsa.PublicKey
+	config    SupervisedSignerConfig
+	limiter   *rate.Limiter
+}
+
+// NewSupervisedSigner creates a new SupervisedSigner from the given signer URIs, in order of preference. All the
+// signers must have the same public key. See NewGuardianSignerFromUri for the meaning of unsafeDevMode.
+func NewSupervisedSigner(ctx context.Context, signerUris []string, unsafeDevMode bool, config SupervisedSignerConfig) (*SupervisedSigner, error) {
+	if len(signerUris) == 0 {
+		return nil, errors.New("no guardian signer URI")
+	}
+
+	signers := make([]GuardianSigner, 0, len(signerUris))
+	for i, signerUri := range signerUris {
+		signer, err := newGuardianSigner(ctx, signerUri, unsafeDevMode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create guardian signer %d: %w", i, err)
+		}
+		signers = append(signers, signer)
+	}
+
+	return newSupervisedSigner(ctx, signers, config)
+}
+
+func newSupervisedSigner(ctx context.Context, signers []GuardianSigner, config SupervisedSignerConfig) (*SupervisedSigner, error) {
+	if config.MaxSignaturesPerSecond < 0 {
+		return nil, errors.New("the maximum number of signatures per second must not be negative")
+	}
+	if config.MaxSignaturesPerSecond > 0 && config.SignatureBurst < 1 {
+		return nil, errors.New("the signature burst must be at least 1")
+	}
+
+	s := &SupervisedSigner{
+		config: config,
+	}
+
+	for i, signer := range signers {
+		publicKey := signer.PublicKey(ctx)
+		if i == 0 {
+			s.publicKey = publicKey
+		} else if !s.publicKey.Equal(&publicKey) {
+			return nil, fmt.Errorf("guardian signer %d has the key of %s, but the primary signer has the key of %s", i,
+				ethcrypto.PubkeyToAddress(publicKey).Hex(), ethcrypto.PubkeyToAddress(s.publicKey).Hex())
+		}
+
+		// Signers are assumed to be healthy until they fail.
+		backend := &supervisedBackend{index: i, signer: signer}
+		backend.setHealthy(true)
+		s.backends = append(s.backends, backend)
+	}
+
+	if config.MaxSignaturesPerSecond > 0 {
+		s.limiter = rate.NewLimiter(rate.Limit(config.MaxSignaturesPerSecond), config.SignatureBurst)
+	}
+	guardianSignerBudget.Set(config.MaxSignaturesPerSecond)
+
+	return s, nil
+}
+
+// candidates returns the signers in the order they should be tried: healthy signers first, in order of preference.
+func (s *SupervisedSigner) candidates() []*supervisedBackend {
+	healthy := make([]*supervisedBackend, 0, len(s.backends))
+	var unhealthy []*supervisedBackend
+	for _, b := range s.backends {
+		if b.isHealthy() {
+			healthy = append(healthy, b)
+		} else {
+			unhealthy = append(unhealthy, b)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (s *SupervisedSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	// Requests in excess of the budget wait for it rather than fail, so that a burst of messages is delayed instead of dropped.
+	if s.limiter != nil && !s.limiter.Allow() {
+		guardianSignerBudgetExceeded.Inc()
+		if err := s.limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSignatureBudgetExceeded, err)
+		}
+	}
+
+	var errs []error
+	for i, b := range s.candidates() {
+		if i > 0 {
+			guardianSignerFailovers.Inc()
+		}
+
+		sig, err := b.signer.Sign(ctx, hash)
+		if err != nil {
+			b.setHealthy(false)
+			errs = append(errs, fmt.Errorf("guardian signer %d: %w", b.index, err))
+			if ctx.Err() != nil {
+				break
+			}
+			continue
+		}
+
+		b.setHealthy(true)
+		guardianSignerActiveIndex.Set(float64(b.index))
+		guardianSignerSignatures.Inc()
+		return sig, nil
+	}
+
+	return nil, errors.Join(errs...)
+}
+
+func (s *SupervisedSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return s.publicKey
+}
+
+func (s *SupervisedSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	// Use ethcrypto to recover the public key
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&s.publicKey), nil
+}
+
+// Return the type of the primary signer, so that the metrics of the wrapping signers keep
+// their labels regardless of whether failover signers are configured.
+func (s *SupervisedSigner) TypeAsString() string {
+	return s.backends[0].signer.TypeAsString()
+}
+
+// canaryDigest returns a digest for a health check. The digest is domain separated from the digests of all the
+// messages that guardians sign, and the resulting signature is discarded.
+func canaryDigest(now time.Time) []byte {
+	return ethcrypto.Keccak256([]byte(fmt.Sprintf("wormhole_guardian_signer_canary|%d", now.UnixNano())))
+}
+
+// checkHealth signs a canary digest with the signer and verifies the signature.
+func (s *SupervisedSigner) checkHealth(ctx context.Context, b *supervisedBackend) error {
+	ctx, cancel := context.WithTimeout(WithDigestType(ctx, DigestTypeCanary), SIGNER_HEALTH_CHECK_TIMEOUT)
+	defer cancel()
+
+	digest := canaryDigest(time.Now())
+	sig, err := b.signer.Sign(ctx, digest)
+	if err != nil {
+		return fmt.Errorf("failed to sign: %w", err)
+	}
+
+	recoveredPubKey, err := ethcrypto.SigToPub(digest, sig)
+	if err != nil {
+		return fmt.Errorf("failed to recover public key: %w", err)
+	}
+	if !recoveredPubKey.Equal(&s.publicKey) {
+		return errors.New("signature does not match the public key")
+	}
+
+	return nil
+}
+
+// CheckHealth runs a health check of every signer, and returns whether at least one of them is healthy.
+func (s *SupervisedSigner) CheckHealth(ctx context.Context, logger *zap.Logger) bool {
+	anyHealthy := false
+	for _, b := range s.backends {
+		err := s.checkHealth(ctx, b)
+		changed := b.setHealthy(err == nil)
+		if err != nil {
+			logger.Error("guardian signer failed health check", zap.Int("signerIndex", b.index), zap.String("signerType", b.signer.TypeAsString()), zap.Error(err))
+			continue
+		}
+
+		anyHealthy = true
+		if changed {
+			logger.Info("guardian signer recovered", zap.Int("signerIndex", b.index), zap.String("signerType", b.signer.TypeAsString()))
+		}
+	}
+	return anyHealthy
+}
+
+// Run is a runnable that periodically checks the health of the signers. The readiness component
+// common.ReadinessGuardianSigner is set once any signer passes a health check.
+func (s *SupervisedSigner) Run(ctx context.Context) error {
+	logger := supervisor.Logger(ctx)
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	if s.config.HealthCheckInterval <= 0 {
+		readiness.SetReady(common.ReadinessGuardianSigner)
+		<-ctx.Done()
+		return nil
+	}
+
+	ticker := time.NewTicker(s.config.HealthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		if s.CheckHealth(ctx, logger) {
+			readiness.SetReady(common.ReadinessGuardianSigner)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package guardiansigner
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// flakySigner is a signer that fails while failing is set, and counts its signatures.
+type flakySigner struct {
+	*GeneratedSigner
+	failing atomic.Bool
+	signed  atomic.Int32
+}
+
+func (f *flakySigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	if f.failing.Load() {
+		return nil, errors.New("signer is down")
+	}
+	f.signed.Add(1)
+	return f.GeneratedSigner.Sign(ctx, hash)
+}
+
+func newFlakySigners(t *testing.T, n int) []*flakySigner {
+	key, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	signers := make([]*flakySigner, n)
+	for i := range signers {
+		generated, err := NewGeneratedSigner(key)
+		require.NoError(t, err)
+		signers[i] = &flakySigner{GeneratedSigner: generated}
+	}
+	return signers
+}
+
+func newTestSupervisedSigner(t *testing.T, config SupervisedSignerConfig, signers ...*flakySigner) *SupervisedSigner {
+	guardianSigners := make([]GuardianSigner, len(signers))
+	for i, signer := range signers {
+		guardianSigners[i] = signer
+	}
+	s, err := newSupervisedSigner(context.Background(), guardianSigners, config)
+	require.NoError(t, err)
+	return s
+}
+
+func TestSupervisedSignerFailover(t *testing.T) {
+	ctx := context.Background()
+	signers := newFlakySigners(t, 2)
+	s := newTestSupervisedSigner(t, SupervisedSignerConfig{}, signers...)
+
+	digest := ethcrypto.Keccak256([]byte("data"))
+	sign := func() {
+		sig, err := s.Sign(ctx, digest)
+		require.NoError(t, err)
+		valid, err := s.Verify(ctx, sig, digest)
+		require.NoError(t, err)
+		assert.True(t, valid)
+	}
+
+	// The primary signer is used while it works.
+	sign()
+	assert.Equal(t, int32(1), signers[0].signed.Load())
+	assert.Equal(t, int32(0), signers[1].signed.Load())
+
+	// When the primary signer fails, the request fails over to the secondary signer, which is then preferred.
+	signers[0].failing.Store(true)
+	sign()
+	sign()
+	assert.Equal(t, int32(1), signers[0].signed.Load())
+	assert.Equal(t, int32(2), signers[1].signed.Load())
+	assert.False(t, s.backends[0].isHealthy())
+
+	// When all signers fail, the errors of all of them are returned.
+	signers[1].failing.Store(true)
+	_, err := s.Sign(ctx, digest)
+	require.ErrorContains(t, err, "guardian signer 0")
+	require.ErrorContains(t, err, "guardian signer 1")
+
+	// Once the primary signer passes a health check, it is preferred again. The health check signs with both signers.
+	signers[0].failing.Store(false)
+	signers[1].failing.Store(false)
+	assert.True(t, s.CheckHealth(ctx, zap.NewNop()))
+	sign()
+	assert.Equal(t, int32(3), signers[0].signed.Load())
+	assert.Equal(t, int32(3), signers[1].signed.Load())
+}
+
+func TestSupervisedSignerHealthCheck(t *testing.T) {
+	ctx := context.Background()
+	signers := newFlakySigners(t, 2)
+	s := newTestSupervisedSigner(t, SupervisedSignerConfig{}, signers...)
+
+	signers[1].failing.Store(true)
+	assert.True(t, s.CheckHealth(ctx, zap.NewNop()))
+	assert.True(t, s.backends[0].isHealthy())
+	assert.False(t, s.backends[1].isHealthy())
+
+	signers[0].failing.Store(true)
+	assert.False(t, s.CheckHealth(ctx, zap.NewNop()))
+	assert.False(t, s.backends[0].isHealthy())
+
+	// A signer that signs with a different key fails the health check.
+	other, err := NewGeneratedSigner(nil)
+	require.NoError(t, err)
+	assert.Error(t, s.checkHealth(ctx, &supervisedBackend{signer: other}))
+}
+
+func TestSupervisedSignerBudget(t *testing.T) {
+	ctx := context.Background()
+	signers := newFlakySigners(t, 1)
+	s := newTestSupervisedSigner(t, SupervisedSignerConfig{MaxSignaturesPerSecond: 0.001, SignatureBurst: 2}, signers...)
+
+	digest := ethcrypto.Keccak256([]byte("data"))
+	for i := 0; i < 2; i++ {
+		_, err := s.Sign(ctx, digest)
+		require.NoError(t, err)
+	}
+
+	// Requests in excess of the budget wait for it, and fail if their context is done first.
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	_, err := s.Sign(timeoutCtx, digest)
+	require.ErrorIs(t, err, ErrSignatureBudgetExceeded)
+	assert.Equal(t, int32(2), signers[0].signed.Load())
+
+	s = newTestSupervisedSigner(t, SupervisedSignerConfig{MaxSignaturesPerSecond: 20, SignatureBurst: 1}, signers...)
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		_, err := s.Sign(ctx, digest)
+		require.NoError(t, err)
+	}
+	assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	assert.Equal(t, int32(5), signers[0].signed.Load())
+
+	// Health checks are not subject to the budget.
+	assert.True(t, s.CheckHealth(ctx, zap.NewNop()))
+}
+
+func TestSupervisedSignerConfig(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := NewSupervisedSigner(ctx, nil, true, SupervisedSignerConfig{})
+	require.Error(t, err)
+
+	_, err = NewSupervisedSigner(ctx, []string{"file://../query/dev.guardian.key"}, true, SupervisedSignerConfig{MaxSignaturesPerSecond: 10})
+	require.ErrorContains(t, err, "burst")
+
+	s, err := NewSupervisedSigner(ctx, []string{"file://../query/dev.guardian.key", "file://../query/dev.guardian.key"}, true, SupervisedSignerConfig{})
+	require.NoError(t, err)
+	assert.Equal(t, "0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe", ethcrypto.PubkeyToAddress(s.PublicKey(ctx)).Hex())
+	assert.Equal(t, "file", s.TypeAsString())
+
+	// All signers must have the same key.
+	signers := []GuardianSigner{}
+	for i := 0; i < 2; i++ {
+		signer, err := NewGeneratedSigner(nil)
+		require.NoError(t, err)
+		signers = append(signers, signer)
+	}
+	_, err = newSupervisedSigner(ctx, signers, SupervisedSignerConfig{})
+	require.ErrorContains(t, err, "guardian signer 1 has the key")
+}
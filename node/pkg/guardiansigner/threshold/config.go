@@ -0,0 +1,173 @@
+package threshold
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// Config is the configuration of a threshold key, which is shared by the guardian node (see the threshold://
+// guardian signer) and the participants. It lists the participants with their addresses and identities. The
+// public key is filled in by the key generation ceremony.
+type Config struct {
+	// Threshold is the number of participants that are required to sign.
+	Threshold uint32 `json:"threshold"`
+	// PublicKey is the hex-encoded uncompressed public key of the guardian key, which is empty until the key
+	// generation ceremony completed.
+	PublicKey string `json:"publicKey"`
+	// Participants are the participants of the threshold key.
+	Participants []ConfigParticipant `json:"participants"`
+	// TLS is the client certificate of the guardian node, and the CA the participant certificates are verified
+	// against. It is required if any participant is reachable over TCP.
+	TLS *ConfigTLS `json:"tls,omitempty"`
+}
+
+// ConfigParticipant is a participant of a threshold key.
+type ConfigParticipant struct {
+	// Index of the participant, starting at 1.
+	Index uint32 `json:"index"`
+	// Address is the gRPC address of the participant, which is either a UNIX socket (unix:///path/to/socket) or
+	// a TCP address (host:port) that is connected to with mutual TLS.
+	Address string `json:"address"`
+	// ServerName overrides the name that the TLS certificate of the participant is verified against.
+	ServerName string `json:"serverName,omitempty"`
+	// SigningKey and EncryptionKey are the hex-encoded public identity keys of the participant, as printed by
+	// `guardiand threshold-signer init`.
+	SigningKey    string `json:"signingKey"`
+	EncryptionKey string `json:"encryptionKey"`
+}
+
+// ConfigTLS are the TLS settings of the connections of the guardian node to the participants.
+type ConfigTLS struct {
+	CACert     string `json:"caCert"`
+	ClientCert string `json:"clientCert"`
+	ClientKey  string `json:"clientKey"`
+}
+
+// LoadConfig reads and validates a threshold signer configuration.
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read threshold signer config: %w", err)
+	}
+
+	var config Config
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("failed to parse threshold signer config: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid threshold signer config: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Validate checks that the configuration is complete and consistent.
+func (c *Config) Validate() error {
+	if len(c.Participants) > MaxParties {
+		return fmt.Errorf("the number of participants must not exceed %d", MaxParties)
+	}
+	parties := uint32(len(c.Participants)) // #nosec G115 -- checked against MaxParties above
+	if c.Threshold < 2 || c.Threshold > parties {
+		return errors.New("the threshold must be between 2 and the number of participants")
+	}
+
+	if c.PublicKey != "" {
+		if _, err := c.ECDSAPublicKey(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.Identities(); err != nil {
+		return err
+	}
+
+	for _, p := range c.Participants {
+		if strings.HasPrefix(p.Address, "unix://") {
+			continue
+		}
+
+		// The participants serve anyone that can reach them, so TCP connections must be authenticated.
+		if _, _, err := net.SplitHostPort(p.Address); err != nil {
+			return fmt.Errorf("participant %d: the address must be a UNIX socket (unix:///path/to/socket) or host:port", p.Index)
+		}
+		if c.TLS == nil || c.TLS.CACert == "" || c.TLS.ClientCert == "" || c.TLS.ClientKey == "" {
+			return fmt.Errorf("participant %d: TCP addresses require the tls settings", p.Index)
+		}
+	}
+
+	return nil
+}
+
+// Identities returns the identities of the participants, keyed by index.
+func (c *Config) Identities() (map[uint32]*PeerIdentity, error) {
+	identities := make(map[uint32]*PeerIdentity, len(c.Participants))
+	for _, p := range c.Participants {
+		if p.Index < 1 || p.Index > MaxParties {
+			return nil, fmt.Errorf("invalid participant index %d", p.Index)
+		}
+		if _, exists := identities[p.Index]; exists {
+			return nil, fmt.Errorf("duplicate participant index %d", p.Index)
+		}
+
+		identity, err := ParsePeerIdentity(p.SigningKey, p.EncryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("participant %d: %w", p.Index, err)
+		}
+		for other, existing := range identities {
+			if existing.SigningKey.Equal(identity.SigningKey) || existing.EncryptionKey == identity.EncryptionKey {
+				return nil, fmt.Errorf("participants %d and %d have the same identity", other, p.Index)
+			}
+		}
+
+		identities[p.Index] = identity
+	}
+	return identities, nil
+}
+
+// ECDSAPublicKey returns the parsed public key of the guardian key.
+func (c *Config) ECDSAPublicKey() (*ecdsa.PublicKey, error) {
+	if c.PublicKey == "" {
+		return nil, errors.New("the config has no public key, the key generation ceremony has not completed")
+	}
+	b, err := hex.DecodeString(strings.TrimPrefix(c.PublicKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	publicKey, err := ethcrypto.UnmarshalPubkey(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// SaveConfig writes the configuration to a new file at path.
+func (c *Config) SaveConfig(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create threshold signer config: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(b, '\n')); err != nil {
+		return fmt.Errorf("failed to write threshold signer config: %w", err)
+	}
+
+	return nil
+}
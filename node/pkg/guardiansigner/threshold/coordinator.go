@@ -0,0 +1,234 @@
+package threshold
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// maxSessionMessages bounds the number of envelopes that are queued for a participant in a session, which is
+// far more than the protocols send.
+const maxSessionMessages = 1024
+
+// ParticipantError is returned by Keygen and Sign when a participant fails, so that the caller can pick other
+// participants. If a participant reports that the messages of another participant failed verification, the
+// error names the other participant.
+type ParticipantError struct {
+	Index uint32
+	Err   error
+}
+
+func (e *ParticipantError) Error() string {
+	return fmt.Sprintf("threshold participant %d: %v", e.Index, e.Err)
+}
+
+func (e *ParticipantError) Unwrap() error {
+	return e.Err
+}
+
+// Keygen runs the key generation among all participants of the key, keyed by index, and returns the public key of
+// the generated key. Every participant stores its share of the key.
+func Keygen(ctx context.Context, participants map[uint32]signerv1.ThresholdSignerServiceClient) (*ecdsa.PublicKey, error) {
+	results, err := runSession(ctx, participants, func(sessionId []byte) *signerv1.ThresholdSessionStart {
+		return &signerv1.ThresholdSessionStart{
+			SessionId: sessionId,
+			Session:   &signerv1.ThresholdSessionStart_Keygen{Keygen: &signerv1.ThresholdKeygenStart{}},
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var publicKey []byte
+	for index, result := range results {
+		if publicKey == nil {
+			publicKey = result.PublicKey
+		} else if !bytes.Equal(publicKey, result.PublicKey) {
+			return nil, &ParticipantError{Index: index, Err: errors.New("generated a different public key")}
+		}
+	}
+
+	return ethcrypto.UnmarshalPubkey(publicKey)
+}
+
+// Sign runs a signing session over digest among the given participants, keyed by index, and returns the r and s
+// values of the signature. The signature is not normalized, and must be checked by the caller.
+func Sign(ctx context.Context, participants map[uint32]signerv1.ThresholdSignerServiceClient, digest []byte) (r []byte, s []byte, err error) {
+	if len(digest) != 32 {
+		return nil, nil, errors.New("the digest must be 32 bytes")
+	}
+
+	signers := make([]uint32, 0, len(participants))
+	for index := range participants {
+		signers = append(signers, index)
+	}
+
+	results, err := runSession(ctx, participants, func(sessionId []byte) *signerv1.ThresholdSessionStart {
+		return &signerv1.ThresholdSessionStart{
+			SessionId: sessionId,
+			Session:   &signerv1.ThresholdSessionStart_Sign{Sign: &signerv1.ThresholdSignStart{Digest: digest, Signers: signers}},
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for index, result := range results {
+		if r == nil {
+			r, s = result.R, result.S
+		} else if !bytes.Equal(r, result.R) || !bytes.Equal(s, result.S) {
+			return nil, nil, &ParticipantError{Index: index, Err: errors.New("computed a different signature")}
+		}
+	}
+
+	return r, s, nil
+}
+
+// sessionEvent is a response or error from the session stream of a participant.
+type sessionEvent struct {
+	index uint32
+	res   *signerv1.ThresholdSessionResponse
+	err   error
+}
+
+// runSession starts a session with every participant, and relays the envelopes of the participants between them
+// until all of them returned a result. The envelopes are authenticated end-to-end by the participants, so they are
+// only routed here.
+func runSession(ctx context.Context, participants map[uint32]signerv1.ThresholdSignerServiceClient, start func(sessionId []byte) *signerv1.ThresholdSessionStart) (map[uint32]*signerv1.ThresholdSessionResult, error) {
+	sessionId := make([]byte, 32)
+	if _, err := rand.Read(sessionId); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan sessionEvent)
+	outboxes := make(map[uint32]chan *signerv1.ThresholdSessionRequest, len(participants))
+
+	for index, client := range participants {
+		stream, err := client.Session(ctx)
+		if err != nil {
+			return nil, &ParticipantError{Index: index, Err: err}
+		}
+
+		outbox := make(chan *signerv1.ThresholdSessionRequest, maxSessionMessages)
+		outbox <- &signerv1.ThresholdSessionRequest{Request: &signerv1.ThresholdSessionRequest_Start{Start: start(sessionId)}}
+		outboxes[index] = outbox
+
+		// The envelopes are sent from a separate goroutine, so a participant that is slow to read can't block
+		// the relaying of the messages of the other participants.
+		go func(index uint32, stream signerv1.ThresholdSignerService_SessionClient) {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case req := <-outbox:
+					if err := stream.Send(req); err != nil {
+						select {
+						case events <- sessionEvent{index: index, err: err}:
+						case <-ctx.Done():
+						}
+						return
+					}
+				}
+			}
+		}(index, stream)
+
+		go func(index uint32, stream signerv1.ThresholdSignerService_SessionClient) {
+			for {
+				res, err := stream.Recv()
+				select {
+				case events <- sessionEvent{index: index, res: res, err: err}:
+				case <-ctx.Done():
+					return
+				}
+				// The participant ends the stream after its result or failure.
+				if err != nil || res.GetEnvelope() == nil {
+					return
+				}
+			}
+		}(index, stream)
+	}
+
+	results := make(map[uint32]*signerv1.ThresholdSessionResult, len(participants))
+	for len(results) < len(participants) {
+		var ev sessionEvent
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case ev = <-events:
+		}
+
+		if ev.err != nil {
+			if errors.Is(ev.err, io.EOF) {
+				ev.err = errors.New("ended the session without a result")
+			}
+			return nil, &ParticipantError{Index: ev.index, Err: ev.err}
+		}
+
+		switch res := ev.res.Response.(type) {
+		case *signerv1.ThresholdSessionResponse_Envelope:
+			if err := relay(ev.index, res.Envelope, outboxes, results); err != nil {
+				return nil, err
+			}
+		case *signerv1.ThresholdSessionResponse_Result:
+			results[ev.index] = res.Result
+		case *signerv1.ThresholdSessionResponse_Failure:
+			err := fmt.Errorf("participant %d failed the session: %s", ev.index, res.Failure.Error)
+			for _, culprit := range res.Failure.Culprits {
+				if _, ok := participants[culprit]; ok && culprit != ev.index {
+					return nil, &ParticipantError{Index: culprit, Err: err}
+				}
+			}
+			return nil, &ParticipantError{Index: ev.index, Err: err}
+		default:
+			return nil, &ParticipantError{Index: ev.index, Err: errors.New("unexpected response")}
+		}
+	}
+
+	return results, nil
+}
+
+// relay queues an envelope sent by a participant for its recipients.
+func relay(from uint32, env *signerv1.ThresholdEnvelope, outboxes map[uint32]chan *signerv1.ThresholdSessionRequest, results map[uint32]*signerv1.ThresholdSessionResult) error {
+	if env.From != from {
+		return &ParticipantError{Index: from, Err: fmt.Errorf("sent an envelope on behalf of %d", env.From)}
+	}
+
+	var recipients []uint32
+	if env.To == 0 {
+		for index := range outboxes {
+			if index != from {
+				recipients = append(recipients, index)
+			}
+		}
+	} else {
+		if _, ok := outboxes[env.To]; !ok || env.To == from {
+			return &ParticipantError{Index: from, Err: fmt.Errorf("sent an envelope to unknown participant %d", env.To)}
+		}
+		recipients = append(recipients, env.To)
+	}
+
+	req := &signerv1.ThresholdSessionRequest{Request: &signerv1.ThresholdSessionRequest_Envelope{Envelope: env}}
+	for _, index := range recipients {
+		// A participant that returned its result has all the messages it needs.
+		if _, done := results[index]; done {
+			continue
+		}
+		select {
+		case outboxes[index] <- req:
+		default:
+			return &ParticipantError{Index: from, Err: errors.New("sent too many envelopes")}
+		}
+	}
+
+	return nil
+}
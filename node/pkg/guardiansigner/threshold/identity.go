@@ -0,0 +1,161 @@
+package threshold
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/nacl/box"
+	"google.golang.org/protobuf/proto"
+)
+
+// envelopeDomain separates the signatures of envelopes from other uses of the identity keys.
+const envelopeDomain = "wormhole threshold signer envelope v1"
+
+// Identity is the long-term identity of a participant. The participant signs its messages with the ed25519
+// signing key, and the messages that are addressed to it are encrypted to the X25519 encryption key. The public
+// identities of all participants are part of the threshold signer config, and are fixed by the key generation.
+type Identity struct {
+	SigningKey    ed25519.PrivateKey
+	EncryptionKey [32]byte
+}
+
+// PeerIdentity is the public identity of a participant.
+type PeerIdentity struct {
+	SigningKey    ed25519.PublicKey
+	EncryptionKey [32]byte
+}
+
+// GenerateIdentity generates a new participant identity.
+func GenerateIdentity() (*Identity, error) {
+	_, signingKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	_, encryptionKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Identity{SigningKey: signingKey, EncryptionKey: *encryptionKey}, nil
+}
+
+// Public returns the public identity.
+func (id *Identity) Public() *PeerIdentity {
+	peer := &PeerIdentity{SigningKey: id.SigningKey.Public().(ed25519.PublicKey)}
+	encryptionKey, err := curve25519.X25519(id.EncryptionKey[:], curve25519.Basepoint)
+	if err != nil {
+		// X25519 only fails for low order points, which the base point is not.
+		panic(err)
+	}
+	copy(peer.EncryptionKey[:], encryptionKey)
+	return peer
+}
+
+// Equal reports whether both identities are the same.
+func (p *PeerIdentity) Equal(other *PeerIdentity) bool {
+	return p.SigningKey.Equal(other.SigningKey) && p.EncryptionKey == other.EncryptionKey
+}
+
+// ParsePeerIdentity parses a public identity from the hex encoding of its keys.
+func ParsePeerIdentity(signingKey string, encryptionKey string) (*PeerIdentity, error) {
+	s, err := hex.DecodeString(signingKey)
+	if err != nil || len(s) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid signing key")
+	}
+
+	e, err := hex.DecodeString(encryptionKey)
+	if err != nil || len(e) != 32 {
+		return nil, errors.New("invalid encryption key")
+	}
+
+	peer := &PeerIdentity{SigningKey: s}
+	copy(peer.EncryptionKey[:], e)
+	return peer, nil
+}
+
+// envelopeSigningBytes returns the bytes an envelope signature is computed over. The session context binds the
+// signature to the session and its parameters, so envelopes can't be replayed into other sessions.
+func envelopeSigningBytes(sessionContext []byte, env *signerv1.ThresholdEnvelope) []byte {
+	b := make([]byte, 0, len(envelopeDomain)+len(sessionContext)+12+len(env.Payload))
+	b = append(b, envelopeDomain...)
+	b = append(b, sessionContext...)
+	b = binary.BigEndian.AppendUint32(b, env.From)
+	b = binary.BigEndian.AppendUint32(b, env.To)
+	b = binary.BigEndian.AppendUint32(b, env.Round)
+	return append(b, env.Payload...)
+}
+
+// sealEnvelope serializes and signs a message of the participant. If to is set, the message is encrypted to the
+// recipient.
+func (id *Identity) sealEnvelope(sessionContext []byte, from uint32, round int, to uint32, recipient *PeerIdentity, msg *signerv1.ThresholdMessage) (*signerv1.ThresholdEnvelope, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if recipient != nil {
+		var nonce [24]byte
+		if _, err := rand.Read(nonce[:]); err != nil {
+			return nil, err
+		}
+		payload = box.Seal(nonce[:], payload, &nonce, &recipient.EncryptionKey, &id.EncryptionKey)
+	}
+
+	env := &signerv1.ThresholdEnvelope{
+		From:    from,
+		To:      to,
+		Round:   uint32(round), // #nosec G115 -- the number of rounds is a small constant
+		Payload: payload,
+	}
+	env.Signature = ed25519.Sign(id.SigningKey, envelopeSigningBytes(sessionContext, env))
+	return env, nil
+}
+
+// openEnvelope verifies the signature of an envelope sent by sender, decrypts it if it is addressed to the
+// participant and parses the message.
+func (id *Identity) openEnvelope(sessionContext []byte, sender *PeerIdentity, env *signerv1.ThresholdEnvelope) (*signerv1.ThresholdMessage, error) {
+	if !ed25519.Verify(sender.SigningKey, envelopeSigningBytes(sessionContext, env), env.Signature) {
+		return nil, errors.New("invalid envelope signature")
+	}
+
+	payload := env.Payload
+	if env.To != 0 {
+		if len(payload) < 24 {
+			return nil, errors.New("invalid encrypted payload")
+		}
+		var nonce [24]byte
+		copy(nonce[:], payload[:24])
+
+		var ok bool
+		payload, ok = box.Open(nil, payload[24:], &nonce, &sender.EncryptionKey, &id.EncryptionKey)
+		if !ok {
+			return nil, errors.New("failed to decrypt payload")
+		}
+	}
+
+	var msg signerv1.ThresholdMessage
+	if err := proto.Unmarshal(payload, &msg); err != nil {
+		return nil, fmt.Errorf("invalid payload: %w", err)
+	}
+	return &msg, nil
+}
+
+// sessionContext returns the hash that binds the envelopes of a session to its id and parameters. The
+// participants compute it from their own view of the session, so a coordinator that gives different parameters
+// to different participants makes the session fail.
+func sessionContext(sessionId []byte, parts ...[]byte) []byte {
+	h := sha256.New()
+	for _, part := range append([][]byte{sessionId}, parts...) {
+		h.Write(binary.BigEndian.AppendUint32(nil, uint32(len(part)))) // #nosec G115 -- the parts are small
+		h.Write(part)
+	}
+	return h.Sum(nil)
+}
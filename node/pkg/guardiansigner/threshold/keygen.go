@@ -0,0 +1,312 @@
+package threshold
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	tsscrypto "github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/dlnproof"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/vss"
+	"github.com/binance-chain/tss-lib/tss"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// keygen is a participant's side of the GG18 key generation, following rounds 1 to 3 of tss-lib's ecdsa/keygen.
+// Every party deals a random secret with Feldman VSS, and the key is the sum of the secrets, so no party learns
+// the key. The parties also exchange their Paillier keys and range proof parameters with the proofs that they
+// are well formed, which are needed by the signing protocol.
+type keygen struct {
+	share     *Share
+	threshold uint32
+	// identities are the identities of all parties, including the local party.
+	identities map[uint32]*PeerIdentity
+
+	// Round 1
+	vs           vss.Vs
+	shares       map[uint32]*vss.Share
+	decommitment cmts.HashDeCommitment
+	commitments  map[uint32]cmts.HashCommitment
+
+	// Round 3
+	parties   map[uint32]*KeyParty
+	secret    *big.Int
+	publicKey *tsscrypto.ECPoint
+
+	// key is the resulting key, once the protocol finished.
+	key *Key
+}
+
+func newKeygen(share *Share, threshold uint32, identities map[uint32]*PeerIdentity) *keygen {
+	return &keygen{
+		share:       share,
+		threshold:   threshold,
+		identities:  identities,
+		shares:      make(map[uint32]*vss.Share, len(identities)),
+		commitments: make(map[uint32]cmts.HashCommitment, len(identities)),
+		parties:     make(map[uint32]*KeyParty, len(identities)),
+	}
+}
+
+func (k *keygen) rounds() []roundSpec {
+	return []roundSpec{
+		{broadcast: true},
+		{broadcast: true, p2p: true},
+		{broadcast: true},
+	}
+}
+
+func (k *keygen) round(r int, in map[uint32]*roundMessages) (*roundOutput, error) {
+	switch r {
+	case 1:
+		return k.round1()
+	case 2:
+		return k.round2(in)
+	case 3:
+		return k.round3(in)
+	}
+	return nil, fmt.Errorf("invalid round %d", r)
+}
+
+// round1 deals the party's secret and commits to the polynomial, and sends the party's Paillier key and range
+// proof parameters.
+func (k *keygen) round1() (*roundOutput, error) {
+	ids := make([]*big.Int, 0, len(k.identities))
+	indexes := make([]uint32, 0, len(k.identities))
+	for index := range k.identities {
+		indexes = append(indexes, index)
+		ids = append(ids, big.NewInt(int64(index)))
+	}
+
+	ui := common.GetRandomPositiveInt(tss.EC().Params().N)
+	vs, shares, err := vss.Create(int(k.threshold)-1, ui, ids)
+	if err != nil {
+		return nil, err
+	}
+	k.vs = vs
+	for i, index := range indexes {
+		k.shares[index] = shares[i]
+	}
+
+	flat, err := tsscrypto.FlattenECPoints(vs)
+	if err != nil {
+		return nil, err
+	}
+	commitment := cmts.NewHashCommitment(flat...)
+	k.decommitment = commitment.D
+
+	pp := k.share.PreParams
+	dlnProof1, err := dlnproof.NewDLNProof(pp.H1, pp.H2, pp.Alpha, pp.P, pp.Q, pp.NTilde).Serialize()
+	if err != nil {
+		return nil, err
+	}
+	dlnProof2, err := dlnproof.NewDLNProof(pp.H2, pp.H1, pp.Beta, pp.P, pp.Q, pp.NTilde).Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	k.parties[k.share.Index] = &KeyParty{
+		Identity:  k.identities[k.share.Index],
+		PaillierN: pp.PaillierKey.N,
+		NTilde:    pp.NTilde,
+		H1:        pp.H1,
+		H2:        pp.H2,
+	}
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_KeygenCommitment{
+		KeygenCommitment: &signerv1.ThresholdKeygenCommitment{
+			Commitment: commitment.C.Bytes(),
+			PaillierN:  pp.PaillierKey.N.Bytes(),
+			NTilde:     pp.NTilde.Bytes(),
+			H1:         pp.H1.Bytes(),
+			H2:         pp.H2.Bytes(),
+			DlnProof_1: dlnProof1,
+			DlnProof_2: dlnProof2,
+		},
+	}}}, nil
+}
+
+// round2 verifies the Paillier keys and range proof parameters of the other parties, sends every party its share
+// of the party's secret, and opens the commitment to the polynomial.
+func (k *keygen) round2(in map[uint32]*roundMessages) (*roundOutput, error) {
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetKeygenCommitment()
+		if msg == nil {
+			return errors.New("expected a keygen commitment")
+		}
+
+		paillierN := new(big.Int).SetBytes(msg.PaillierN)
+		nTilde := new(big.Int).SetBytes(msg.NTilde)
+		h1 := new(big.Int).SetBytes(msg.H1)
+		h2 := new(big.Int).SetBytes(msg.H2)
+
+		if paillierN.BitLen() < paillierBits-1 || nTilde.BitLen() < 2*safePrimeBits-1 {
+			return errors.New("the Paillier modulus or NTilde is too small")
+		}
+		if h1.Cmp(big.NewInt(1)) <= 0 || h1.Cmp(nTilde) >= 0 || h2.Cmp(big.NewInt(1)) <= 0 || h2.Cmp(nTilde) >= 0 || h1.Cmp(h2) == 0 {
+			return errors.New("invalid h1 or h2")
+		}
+		for other, party := range k.parties {
+			if party.H1.Cmp(h1) == 0 || party.H2.Cmp(h2) == 0 || party.PaillierN.Cmp(paillierN) == 0 {
+				return fmt.Errorf("reused the range proof parameters or Paillier key of participant %d", other)
+			}
+		}
+
+		dlnProof1, err := dlnproof.UnmarshalDLNProof(msg.DlnProof_1)
+		if err != nil {
+			return err
+		}
+		dlnProof2, err := dlnproof.UnmarshalDLNProof(msg.DlnProof_2)
+		if err != nil {
+			return err
+		}
+		if !dlnProof1.Verify(h1, h2, nTilde) || !dlnProof2.Verify(h2, h1, nTilde) {
+			return errors.New("invalid proof of the range proof parameters")
+		}
+
+		k.commitments[index] = new(big.Int).SetBytes(msg.Commitment)
+		k.parties[index] = &KeyParty{
+			Identity:  k.identities[index],
+			PaillierN: paillierN,
+			NTilde:    nTilde,
+			H1:        h1,
+			H2:        h2,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := &roundOutput{
+		broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_KeygenDecommitment{
+			KeygenDecommitment: &signerv1.ThresholdDecommitment{Decommitment: common.BigIntsToBytes(k.decommitment)},
+		}},
+		p2p: make(map[uint32]*signerv1.ThresholdMessage, len(in)),
+	}
+	for index := range in {
+		out.p2p[index] = &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_KeygenShare{
+			KeygenShare: &signerv1.ThresholdKeygenShare{Share: k.shares[index].Share.Bytes()},
+		}}
+	}
+	return out, nil
+}
+
+// round3 verifies the shares of the other parties against their polynomials, computes the party's share of the
+// key, the share public keys and the public key, and proves that the party's Paillier modulus is well formed.
+func (k *keygen) round3(in map[uint32]*roundMessages) (*roundOutput, error) {
+	n := tss.EC().Params().N
+	self := big.NewInt(int64(k.share.Index))
+	t := int(k.threshold) - 1
+
+	secret := new(big.Int).Set(k.shares[k.share.Index].Share)
+	vc := append(vss.Vs{}, k.vs...)
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		shareMsg := msgs.p2p.GetKeygenShare()
+		decommitmentMsg := msgs.broadcast.GetKeygenDecommitment()
+		if shareMsg == nil || decommitmentMsg == nil {
+			return errors.New("expected a keygen share and decommitment")
+		}
+
+		commitment := cmts.HashCommitDecommit{C: k.commitments[index], D: cmts.NewHashDeCommitmentFromBytes(decommitmentMsg.Decommitment)}
+		ok, flat := commitment.DeCommit()
+		if !ok {
+			return errors.New("the polynomial does not match its commitment")
+		}
+		vs, err := tsscrypto.UnFlattenECPoints(tss.EC(), flat)
+		if err != nil {
+			return err
+		}
+		if len(vs) != t+1 {
+			return errors.New("the polynomial has the wrong degree")
+		}
+
+		value := new(big.Int).SetBytes(shareMsg.Share)
+		if value.Cmp(n) >= 0 {
+			return errors.New("invalid share")
+		}
+		share := &vss.Share{Threshold: t, ID: self, Share: value}
+		if !share.Verify(t, vs) {
+			return errors.New("the share does not match the polynomial")
+		}
+
+		secret.Add(secret, value)
+		for c := range vc {
+			if vc[c], err = vc[c].Add(vs[c]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	k.secret = secret.Mod(secret, n)
+	k.publicKey = vc[0]
+
+	// The share public key of party j is the sum of the polynomials evaluated at j, in the exponent.
+	for index, party := range k.parties {
+		id := big.NewInt(int64(index))
+		x, z := vc[0], big.NewInt(1)
+		for c := 1; c <= t; c++ {
+			z = common.ModInt(n).Mul(z, id)
+			if x, err = x.Add(vc[c].ScalarMult(z)); err != nil {
+				return nil, err
+			}
+		}
+		party.SharePublicKey = toECDSAPublicKey(x)
+	}
+
+	if !scalarBaseMult(k.secret).Equal(k.parties[k.share.Index].SharePublicKey) {
+		return nil, errors.New("the share secret does not match its public key")
+	}
+
+	proof := k.share.PreParams.PaillierKey.Proof(self, k.publicKey)
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_KeygenPaillierProof{
+		KeygenPaillierProof: &signerv1.ThresholdKeygenPaillierProof{Proof: common.BigIntsToBytes(proof[:])},
+	}}}, nil
+}
+
+// finish verifies the proofs of the Paillier moduli of the other parties.
+func (k *keygen) finish(in map[uint32]*roundMessages) (*signerv1.ThresholdSessionResult, error) {
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetKeygenPaillierProof()
+		if msg == nil || len(msg.Proof) != paillier.ProofIters {
+			return errors.New("expected a Paillier proof")
+		}
+
+		var proof paillier.Proof
+		for i, b := range msg.Proof {
+			proof[i] = new(big.Int).SetBytes(b)
+		}
+		ok, err := proof.Verify(k.parties[index].PaillierN, big.NewInt(int64(index)), k.publicKey)
+		if err != nil || !ok {
+			return errors.New("invalid proof of the Paillier modulus")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	k.key = &Key{
+		Threshold: k.threshold,
+		Secret:    k.secret,
+		PublicKey: toECDSAPublicKey(k.publicKey),
+		Parties:   k.parties,
+	}
+
+	return &signerv1.ThresholdSessionResult{PublicKey: ethcrypto.FromECDSAPub(k.key.PublicKey)}, nil
+}
+
+// toECDSAPublicKey converts a tss-lib point to a public key.
+func toECDSAPublicKey(p *tsscrypto.ECPoint) *ecdsa.PublicKey {
+	return &ecdsa.PublicKey{Curve: curve, X: p.X(), Y: p.Y()}
+}
@@ -0,0 +1,385 @@
+// Package threshold implements a t-of-n threshold ECDSA signer for the guardian key, which produces ordinary
+// secp256k1 signatures that are indistinguishable from those of a single key.
+//
+// The key generation and signing protocols are GG18 (Gennaro and Goldfeder, "Fast Multiparty Threshold ECDSA with
+// Fast Trustless Setup"), ported round by round from tss-lib (github.com/binance-chain/tss-lib), and built on its
+// primitives: Feldman VSS, the Paillier based MtA share conversion with its range proofs, the proofs of the
+// Paillier moduli and range proof parameters, Schnorr proofs and hash commitments. tss-lib's own protocol
+// packages can't be used, as their generated protobuf messages conflict with the protobuf runtime of the node.
+//
+// The key is generated by the participants (see Keygen), so it never exists in one place, and a new threshold key
+// needs a guardian set upgrade. The protocols are secure with abort against up to t-1 malicious participants: they
+// can make a session fail, but can't learn anything about the key shares of the other participants.
+//
+// The guardian node coordinates the sessions by relaying the messages of the participants between them. The
+// messages are signed with the identity keys of the participants, which are fixed by the config at the key
+// generation, and the messages to a single participant are encrypted to it. A compromised coordinator can
+// therefore get digests signed, but it can't read or forge the messages of the participants, or extract their
+// shares. The coordinator can also show different broadcast messages to different participants, which GG18
+// tolerates without losing secrecy, but which makes the session fail. The participants only serve clients with
+// a certificate of the configured CA over TCP, or local clients over UNIX sockets.
+package threshold
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// SessionTimeout is the time after which an unfinished session is aborted.
+	SessionTimeout = 30 * time.Second
+	// KeygenTimeout is the time after which an unfinished key generation session is aborted.
+	KeygenTimeout = 5 * time.Minute
+	// maxSessions is the maximum number of concurrent sessions of a participant.
+	maxSessions = 64
+	// sessionIdRetention is the time the ids of past sessions are remembered, so they can't be reused.
+	sessionIdRetention = 2 * KeygenTimeout
+)
+
+// Participant is a participant of a threshold signer, which holds a key share and takes part in the key
+// generation and signing sessions coordinated by the guardian node.
+type Participant struct {
+	signerv1.UnimplementedThresholdSignerServiceServer
+
+	sharePath string
+	config    *Config
+
+	mu    sync.Mutex
+	share *Share
+	// sessions are the ids of the active and recent sessions, with the time they started.
+	sessions map[string]time.Time
+	active   int
+	keygen   bool
+}
+
+// NewParticipant creates a participant for the share file at sharePath. The config provides the threshold and the
+// identities of the participants for the key generation, and must match the key of the share after it.
+func NewParticipant(sharePath string, config *Config) (*Participant, error) {
+	share, err := LoadShare(sharePath)
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := config.Identities()
+	if err != nil {
+		return nil, err
+	}
+	if identity, ok := identities[share.Index]; !ok || !identity.Equal(share.Identity.Public()) {
+		return nil, fmt.Errorf("the identity of participant %d does not match the config", share.Index)
+	}
+
+	if share.Key != nil {
+		if share.Key.Threshold != config.Threshold || len(share.Key.Parties) != len(identities) {
+			return nil, errors.New("the key of the share does not match the config")
+		}
+		for index, party := range share.Key.Parties {
+			if identity, ok := identities[index]; !ok || !identity.Equal(party.Identity) {
+				return nil, fmt.Errorf("the identity of participant %d does not match the key of the share", index)
+			}
+		}
+	}
+
+	return &Participant{
+		sharePath: sharePath,
+		config:    config,
+		share:     share,
+		sessions:  make(map[string]time.Time),
+	}, nil
+}
+
+func (p *Participant) Info(ctx context.Context, req *signerv1.ThresholdInfoRequest) (*signerv1.ThresholdInfoResponse, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	identity := p.share.Identity.Public()
+	res := &signerv1.ThresholdInfoResponse{
+		Index:         p.share.Index,
+		SigningKey:    identity.SigningKey,
+		EncryptionKey: identity.EncryptionKey[:],
+	}
+	if key := p.share.Key; key != nil {
+		res.Threshold = key.Threshold
+		res.Parties = uint32(len(key.Parties)) // #nosec G115 -- checked against MaxParties by Share.Validate
+		res.PublicKey = ethcrypto.FromECDSAPub(key.PublicKey)
+	}
+	return res, nil
+}
+
+// beginSession registers a new session. Session ids can't be reused, so envelopes of a session can't be replayed
+// into another one.
+func (p *Participant) beginSession(id []byte, keygen bool) error {
+	if len(id) < 16 || len(id) > 64 {
+		return status.Error(codes.InvalidArgument, "invalid session id")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for key, started := range p.sessions {
+		if time.Since(started) > sessionIdRetention {
+			delete(p.sessions, key)
+		}
+	}
+
+	key := hex.EncodeToString(id)
+	if _, exists := p.sessions[key]; exists {
+		return status.Error(codes.AlreadyExists, "the session id was already used")
+	}
+	if p.active >= maxSessions {
+		return status.Error(codes.ResourceExhausted, "too many sessions")
+	}
+	if keygen {
+		if p.share.Key != nil {
+			return status.Error(codes.FailedPrecondition, "the participant already holds a key share")
+		}
+		if p.keygen {
+			return status.Error(codes.FailedPrecondition, "a key generation is already running")
+		}
+		p.keygen = true
+	}
+
+	p.sessions[key] = time.Now()
+	p.active++
+	return nil
+}
+
+func (p *Participant) endSession(keygen bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.active--
+	if keygen {
+		p.keygen = false
+	}
+}
+
+// newKeygenSession sets up the key generation among all participants of the config.
+func (p *Participant) newKeygenSession(id []byte) (*session, *keygen, error) {
+	identities, err := p.config.Identities()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	indexes := make([]uint32, 0, len(identities))
+	for index := range identities {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	parts := [][]byte{[]byte("keygen"), binary.BigEndian.AppendUint32(nil, p.config.Threshold)}
+	peers := make(map[uint32]*PeerIdentity, len(identities)-1)
+	for _, index := range indexes {
+		identity := identities[index]
+		parts = append(parts, binary.BigEndian.AppendUint32(nil, index), identity.SigningKey, identity.EncryptionKey[:])
+		if index != p.share.Index {
+			peers[index] = identity
+		}
+	}
+
+	k := newKeygen(p.share, p.config.Threshold, identities)
+	return newSession(sessionContext(id, parts...), p.share.Index, p.share.Identity, peers, k), k, nil
+}
+
+// newSigningSession sets up a signing session over digest among signers.
+func (p *Participant) newSigningSession(id []byte, req *signerv1.ThresholdSignStart) (*session, error) {
+	p.mu.Lock()
+	share := p.share
+	p.mu.Unlock()
+
+	key := share.Key
+	if key == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the key generation has not completed")
+	}
+	if len(req.Digest) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "the digest must be 32 bytes")
+	}
+
+	signers, err := validateSigners(key, share.Index, req.Signers)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	parts := [][]byte{[]byte("sign"), req.Digest, ethcrypto.FromECDSAPub(key.PublicKey)}
+	peers := make(map[uint32]*PeerIdentity, len(signers)-1)
+	for _, index := range signers {
+		parts = append(parts, binary.BigEndian.AppendUint32(nil, index))
+		if index != share.Index {
+			peers[index] = key.Parties[index].Identity
+		}
+	}
+
+	return newSession(sessionContext(id, parts...), share.Index, share.Identity, peers, newSigning(share, req.Digest, signers)), nil
+}
+
+// validateSigners checks that the signers are distinct parties of the key that include the participant, and that
+// there are enough of them. The signers are returned in ascending order.
+func validateSigners(key *Key, self uint32, signers []uint32) ([]uint32, error) {
+	if len(signers) < int(key.Threshold) || len(signers) > len(key.Parties) {
+		return nil, fmt.Errorf("the number of signers must be between %d and %d", key.Threshold, len(key.Parties))
+	}
+
+	sorted := append([]uint32{}, signers...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	found := false
+	for i, index := range sorted {
+		if _, ok := key.Parties[index]; !ok {
+			return nil, fmt.Errorf("invalid signer index %d", index)
+		}
+		if i > 0 && sorted[i-1] == index {
+			return nil, fmt.Errorf("duplicate signer index %d", index)
+		}
+		if index == self {
+			found = true
+		}
+	}
+
+	if !found {
+		return nil, fmt.Errorf("participant %d is not one of the signers", self)
+	}
+
+	return sorted, nil
+}
+
+func (p *Participant) Session(stream signerv1.ThresholdSignerService_SessionServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, "the first request must start the session")
+	}
+
+	isKeygen := start.GetKeygen() != nil
+	if !isKeygen && start.GetSign() == nil {
+		return status.Error(codes.InvalidArgument, "unknown session type")
+	}
+	if err := p.beginSession(start.SessionId, isKeygen); err != nil {
+		return err
+	}
+	defer p.endSession(isKeygen)
+
+	timeout := SessionTimeout
+	var s *session
+	var k *keygen
+	if isKeygen {
+		timeout = KeygenTimeout
+		s, k, err = p.newKeygenSession(start.SessionId)
+	} else {
+		s, err = p.newSigningSession(start.SessionId, start.GetSign())
+	}
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), timeout)
+	defer cancel()
+
+	type recvResult struct {
+		req *signerv1.ThresholdSessionRequest
+		err error
+	}
+	requests := make(chan recvResult)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			select {
+			case requests <- recvResult{req, err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	envelopes, err := s.start()
+	if err != nil {
+		return sendFailure(stream, err)
+	}
+
+	for {
+		if err := sendEnvelopes(stream, envelopes); err != nil {
+			return err
+		}
+
+		var r recvResult
+		select {
+		case <-ctx.Done():
+			return status.Error(codes.DeadlineExceeded, "the session timed out")
+		case r = <-requests:
+		}
+		if r.err != nil {
+			return r.err
+		}
+
+		env := r.req.GetEnvelope()
+		if env == nil {
+			return status.Error(codes.InvalidArgument, "expected an envelope")
+		}
+
+		var result *signerv1.ThresholdSessionResult
+		envelopes, result, err = s.handle(env)
+		if err != nil {
+			return sendFailure(stream, err)
+		}
+
+		if result != nil {
+			if k != nil {
+				if err := p.saveKey(k.key); err != nil {
+					return status.Error(codes.Internal, err.Error())
+				}
+			}
+			return stream.Send(&signerv1.ThresholdSessionResponse{Response: &signerv1.ThresholdSessionResponse_Result{Result: result}})
+		}
+	}
+}
+
+// saveKey stores the key share generated by a key generation session.
+func (p *Participant) saveKey(key *Key) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	share := *p.share
+	share.Key = key
+	if err := share.Validate(); err != nil {
+		return fmt.Errorf("invalid key: %w", err)
+	}
+	if err := share.replaceShare(p.sharePath); err != nil {
+		return err
+	}
+
+	p.share = &share
+	return nil
+}
+
+func sendEnvelopes(stream signerv1.ThresholdSignerService_SessionServer, envelopes []*signerv1.ThresholdEnvelope) error {
+	for _, env := range envelopes {
+		if err := stream.Send(&signerv1.ThresholdSessionResponse{Response: &signerv1.ThresholdSessionResponse_Envelope{Envelope: env}}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendFailure ends the session with a failure, naming the participant that caused it if it is known.
+func sendFailure(stream signerv1.ThresholdSignerService_SessionServer, err error) error {
+	failure := &signerv1.ThresholdSessionFailure{Error: err.Error()}
+	var culprit *culpritError
+	if errors.As(err, &culprit) {
+		failure.Culprits = []uint32{culprit.index}
+	}
+	return stream.Send(&signerv1.ThresholdSessionResponse{Response: &signerv1.ThresholdSessionResponse_Failure{Failure: failure}})
+}
@@ -0,0 +1,140 @@
+package threshold
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Serve serves the participant on address until ctx is cancelled. The address is either a UNIX socket
+// (unix:///path/to/socket), which replaces an existing socket at that path, or a TCP address (host:port). TCP
+// connections require tlsConfig, which must verify client certificates (see NewServerTLSConfig), as anyone that
+// can connect to a participant can run signing sessions with it.
+func Serve(ctx context.Context, participant *Participant, address string, tlsConfig *tls.Config) error {
+	var (
+		l    net.Listener
+		opts []grpc.ServerOption
+		err  error
+	)
+
+	if socketPath, ok := strings.CutPrefix(address, "unix://"); ok {
+		l, err = listenUnix(socketPath)
+		if err != nil {
+			return err
+		}
+	} else {
+		if tlsConfig == nil || tlsConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+			return errors.New("TCP addresses require TLS with client certificate verification")
+		}
+		l, err = net.Listen("tcp", address)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", address, err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+
+	grpcServer := grpc.NewServer(opts...)
+	signerv1.RegisterThresholdSignerServiceServer(grpcServer, participant)
+
+	go func() {
+		<-ctx.Done()
+		grpcServer.GracefulStop()
+	}()
+
+	return grpcServer.Serve(l)
+}
+
+func listenUnix(socketPath string) (net.Listener, error) {
+	// Delete existing UNIX socket, if present.
+	fi, err := os.Stat(socketPath)
+	if err == nil {
+		if fi.Mode()&os.ModeType != os.ModeSocket {
+			return nil, fmt.Errorf("%s is not a UNIX socket", socketPath)
+		}
+		if err := os.Remove(socketPath); err != nil {
+			return nil, fmt.Errorf("failed to remove existing socket at %s: %w", socketPath, err)
+		}
+	}
+
+	// The socket is created with the umask of the process, which should be restrictive (see common.SetRestrictiveUmask).
+	laddr, err := net.ResolveUnixAddr("unix", socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid listen address: %w", err)
+	}
+	l, err := net.ListenUnix("unix", laddr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", socketPath, err)
+	}
+	return l, nil
+}
+
+// NewServerTLSConfig returns the TLS config of a participant that is served over TCP, which only accepts clients
+// with a certificate issued by the CA at clientCAFile.
+func NewServerTLSConfig(certFile string, keyFile string, clientCAFile string) (*tls.Config, error) {
+	certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	clientCAs, err := loadCertPool(clientCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
+
+func loadCertPool(path string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// Dial returns a connection to a participant of the config. Participants on TCP addresses are connected to with
+// mutual TLS.
+func (c *Config) Dial(ctx context.Context, participant ConfigParticipant) (*grpc.ClientConn, error) {
+	if strings.HasPrefix(participant.Address, "unix://") {
+		return grpc.DialContext(ctx, participant.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	if c.TLS == nil {
+		return nil, errors.New("TCP addresses require the tls settings")
+	}
+	certificate, err := tls.LoadX509KeyPair(c.TLS.ClientCert, c.TLS.ClientKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	rootCAs, err := loadCertPool(c.TLS.CACert)
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		RootCAs:      rootCAs,
+		ServerName:   participant.ServerName,
+		MinVersion:   tls.VersionTLS13,
+	}
+	return grpc.DialContext(ctx, participant.Address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+}
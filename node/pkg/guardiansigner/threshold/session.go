@@ -0,0 +1,239 @@
+package threshold
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+)
+
+// roundSpec describes the messages that every party sends in a round of a protocol.
+type roundSpec struct {
+	// broadcast is set if every party sends a message to all other parties.
+	broadcast bool
+	// p2p is set if every party sends a separate message to every other party.
+	p2p bool
+}
+
+// roundMessages are the messages that a party received from another party in a round.
+type roundMessages struct {
+	broadcast *signerv1.ThresholdMessage
+	p2p       *signerv1.ThresholdMessage
+}
+
+// roundOutput are the messages that a party sends in a round.
+type roundOutput struct {
+	broadcast *signerv1.ThresholdMessage
+	p2p       map[uint32]*signerv1.ThresholdMessage
+}
+
+// protocol is the local side of a multi-party protocol, which is driven round by round by a session.
+type protocol interface {
+	// rounds returns the messages that are sent in each round, starting with round 1.
+	rounds() []roundSpec
+	// round runs round r, given the messages that the other parties sent in round r-1 (none for round 1), and
+	// returns the messages of the party in round r.
+	round(r int, in map[uint32]*roundMessages) (*roundOutput, error)
+	// finish completes the protocol, given the messages that the other parties sent in the last round.
+	finish(in map[uint32]*roundMessages) (*signerv1.ThresholdSessionResult, error)
+}
+
+// culpritError is an error caused by the messages of another party.
+type culpritError struct {
+	index uint32
+	err   error
+}
+
+func (e *culpritError) Error() string {
+	return fmt.Sprintf("participant %d: %v", e.index, e.err)
+}
+
+func (e *culpritError) Unwrap() error {
+	return e.err
+}
+
+// forEachPeer calls f with the messages of every other party in ascending index order, and attributes its errors
+// to the party. The proof verifiers of tss-lib are not hardened against every malformed input, so a panic while
+// processing the messages of a party is attributed to it as well.
+func forEachPeer(in map[uint32]*roundMessages, f func(index uint32, msgs *roundMessages) error) error {
+	indexes := make([]uint32, 0, len(in))
+	for index := range in {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+
+	for _, index := range indexes {
+		if err := callPeer(index, in[index], f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func callPeer(index uint32, msgs *roundMessages, f func(uint32, *roundMessages) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &culpritError{index: index, err: fmt.Errorf("malformed message: %v", r)}
+		}
+	}()
+
+	if err := f(index, msgs); err != nil {
+		return &culpritError{index: index, err: err}
+	}
+	return nil
+}
+
+// session runs a protocol among a set of parties. It seals the messages of the local party into envelopes, and
+// opens, checks and buffers the envelopes of the other parties until a round is complete.
+type session struct {
+	context  []byte
+	self     uint32
+	identity *Identity
+	// peers are the identities of the other parties.
+	peers    map[uint32]*PeerIdentity
+	protocol protocol
+	specs    []roundSpec
+
+	// current is the last round that was run.
+	current int
+	inbox   map[int]map[uint32]*roundMessages
+}
+
+func newSession(context []byte, self uint32, identity *Identity, peers map[uint32]*PeerIdentity, protocol protocol) *session {
+	return &session{
+		context:  context,
+		self:     self,
+		identity: identity,
+		peers:    peers,
+		protocol: protocol,
+		specs:    protocol.rounds(),
+		inbox:    make(map[int]map[uint32]*roundMessages),
+	}
+}
+
+// start runs the first round, and returns its envelopes.
+func (s *session) start() ([]*signerv1.ThresholdEnvelope, error) {
+	out, err := s.protocol.round(1, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.current = 1
+	return s.seal(1, out)
+}
+
+// handle processes an envelope of another party. Once the messages of the current round are complete, the next
+// round is run and its envelopes are returned, or the result is returned after the last round.
+func (s *session) handle(env *signerv1.ThresholdEnvelope) ([]*signerv1.ThresholdEnvelope, *signerv1.ThresholdSessionResult, error) {
+	sender, ok := s.peers[env.From]
+	if !ok {
+		return nil, nil, fmt.Errorf("envelope from unknown participant %d", env.From)
+	}
+	if env.To != 0 && env.To != s.self {
+		return nil, nil, fmt.Errorf("envelope from participant %d is addressed to %d", env.From, env.To)
+	}
+
+	// The other parties can be at most one round ahead, as they need the messages of the current round to
+	// proceed.
+	round := int(env.Round)
+	if round < s.current || round > s.current+1 || round > len(s.specs) {
+		return nil, nil, &culpritError{index: env.From, err: fmt.Errorf("unexpected message of round %d", round)}
+	}
+	spec := s.specs[round-1]
+	if (env.To == 0 && !spec.broadcast) || (env.To != 0 && !spec.p2p) {
+		return nil, nil, &culpritError{index: env.From, err: fmt.Errorf("unexpected message type in round %d", round)}
+	}
+
+	msg, err := s.identity.openEnvelope(s.context, sender, env)
+	if err != nil {
+		return nil, nil, &culpritError{index: env.From, err: err}
+	}
+
+	if s.inbox[round] == nil {
+		s.inbox[round] = make(map[uint32]*roundMessages, len(s.peers))
+	}
+	msgs := s.inbox[round][env.From]
+	if msgs == nil {
+		msgs = &roundMessages{}
+		s.inbox[round][env.From] = msgs
+	}
+
+	slot := &msgs.broadcast
+	if env.To != 0 {
+		slot = &msgs.p2p
+	}
+	if *slot != nil {
+		return nil, nil, &culpritError{index: env.From, err: fmt.Errorf("duplicate message in round %d", round)}
+	}
+	*slot = msg
+
+	var envelopes []*signerv1.ThresholdEnvelope
+	for s.complete(s.current) {
+		in := s.inbox[s.current]
+		delete(s.inbox, s.current)
+
+		if s.current == len(s.specs) {
+			result, err := s.protocol.finish(in)
+			return nil, result, err
+		}
+
+		out, err := s.protocol.round(s.current+1, in)
+		if err != nil {
+			return nil, nil, err
+		}
+		s.current++
+
+		sealed, err := s.seal(s.current, out)
+		if err != nil {
+			return nil, nil, err
+		}
+		envelopes = append(envelopes, sealed...)
+	}
+
+	return envelopes, nil, nil
+}
+
+// complete reports whether all the messages of the other parties in a round were received.
+func (s *session) complete(round int) bool {
+	spec := s.specs[round-1]
+	for index := range s.peers {
+		msgs := s.inbox[round][index]
+		if msgs == nil || (spec.broadcast && msgs.broadcast == nil) || (spec.p2p && msgs.p2p == nil) {
+			return false
+		}
+	}
+	return true
+}
+
+// seal returns the envelopes of the messages of the local party in a round.
+func (s *session) seal(round int, out *roundOutput) ([]*signerv1.ThresholdEnvelope, error) {
+	spec := s.specs[round-1]
+	var envelopes []*signerv1.ThresholdEnvelope
+
+	if spec.broadcast {
+		if out.broadcast == nil {
+			return nil, errors.New("missing broadcast message")
+		}
+		env, err := s.identity.sealEnvelope(s.context, s.self, round, 0, nil, out.broadcast)
+		if err != nil {
+			return nil, err
+		}
+		envelopes = append(envelopes, env)
+	}
+
+	if spec.p2p {
+		for index, peer := range s.peers {
+			msg, ok := out.p2p[index]
+			if !ok {
+				return nil, fmt.Errorf("missing message for participant %d", index)
+			}
+			env, err := s.identity.sealEnvelope(s.context, s.self, round, index, peer, msg)
+			if err != nil {
+				return nil, err
+			}
+			envelopes = append(envelopes, env)
+		}
+	}
+
+	return envelopes, nil
+}
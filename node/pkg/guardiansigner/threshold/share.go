@@ -0,0 +1,508 @@
+package threshold
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/binance-chain/tss-lib/common"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// MaxParties is the maximum number of participants of a threshold key.
+	MaxParties = 32
+
+	// The sizes of the Paillier modulus and of the safe primes of the range proof parameters recommended by GG18.
+	paillierBits  = 2048
+	safePrimeBits = 1024
+
+	shareFileVersion = 2
+)
+
+var (
+	curve  = ethcrypto.S256()
+	curveN = curve.Params().N
+)
+
+// PreParams are the Paillier key of a participant and its parameters for the range proofs of the MtA share
+// conversion (an RSA modulus NTilde of two safe primes, and h1 and h2 with h2 = h1^alpha). They take a while to
+// generate, so they are generated when the participant is initialized.
+type PreParams struct {
+	PaillierKey *paillier.PrivateKey
+	NTilde      *big.Int
+	H1          *big.Int
+	H2          *big.Int
+	Alpha       *big.Int
+	Beta        *big.Int
+	// P and Q are the Sophie Germain primes of the safe primes 2P+1 and 2Q+1 of NTilde.
+	P *big.Int
+	Q *big.Int
+}
+
+// GeneratePreParams generates new pre-parameters, following tss-lib's keygen.GeneratePreParams.
+func GeneratePreParams(timeout time.Duration) (*PreParams, error) {
+	concurrency := runtime.NumCPU()
+
+	type paillierResult struct {
+		key *paillier.PrivateKey
+		err error
+	}
+	paillierC := make(chan paillierResult, 1)
+	go func() {
+		key, _, err := paillier.GenerateKeyPair(paillierBits, timeout, concurrency)
+		paillierC <- paillierResult{key, err}
+	}()
+
+	primes, err := common.GetRandomSafePrimesConcurrent(safePrimeBits, 2, timeout, concurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate safe primes: %w", err)
+	}
+	for _, prime := range primes {
+		if prime == nil || !prime.Validate() {
+			return nil, errors.New("failed to generate safe primes")
+		}
+	}
+
+	res := <-paillierC
+	if res.err != nil {
+		return nil, fmt.Errorf("failed to generate Paillier key: %w", res.err)
+	}
+
+	nTilde := new(big.Int).Mul(primes[0].SafePrime(), primes[1].SafePrime())
+	p, q := primes[0].Prime(), primes[1].Prime()
+	modNTilde := common.ModInt(nTilde)
+
+	f1 := common.GetRandomPositiveRelativelyPrimeInt(nTilde)
+	alpha := common.GetRandomPositiveRelativelyPrimeInt(nTilde)
+	beta := common.ModInt(new(big.Int).Mul(p, q)).ModInverse(alpha)
+	h1 := modNTilde.Mul(f1, f1)
+	h2 := modNTilde.Exp(h1, alpha)
+
+	return &PreParams{
+		PaillierKey: res.key,
+		NTilde:      nTilde,
+		H1:          h1,
+		H2:          h2,
+		Alpha:       alpha,
+		Beta:        beta,
+		P:           p,
+		Q:           q,
+	}, nil
+}
+
+// Validate checks that the pre-parameters are consistent.
+func (p *PreParams) Validate() error {
+	if p.PaillierKey == nil || p.PaillierKey.N == nil || p.PaillierKey.LambdaN == nil || p.PaillierKey.PhiN == nil {
+		return errors.New("missing Paillier key")
+	}
+	for _, v := range []*big.Int{p.NTilde, p.H1, p.H2, p.Alpha, p.Beta, p.P, p.Q} {
+		if v == nil || v.Sign() <= 0 {
+			return errors.New("missing range proof parameters")
+		}
+	}
+
+	P := new(big.Int).Add(new(big.Int).Lsh(p.P, 1), big.NewInt(1))
+	Q := new(big.Int).Add(new(big.Int).Lsh(p.Q, 1), big.NewInt(1))
+	if new(big.Int).Mul(P, Q).Cmp(p.NTilde) != 0 {
+		return errors.New("NTilde is not the product of the safe primes")
+	}
+
+	modNTilde := common.ModInt(p.NTilde)
+	if modNTilde.Exp(p.H1, p.Alpha).Cmp(p.H2) != 0 || modNTilde.Exp(p.H2, p.Beta).Cmp(p.H1) != 0 {
+		return errors.New("h1 and h2 do not match alpha and beta")
+	}
+
+	return nil
+}
+
+// Key is a participant's share of a threshold key, which is the output of the key generation.
+type Key struct {
+	// Threshold is the number of participants that are required to sign.
+	Threshold uint32
+	// Secret is the participant's share of the key, the value of the Shamir polynomial at its index.
+	Secret *big.Int
+	// PublicKey is the public key of the guardian key.
+	PublicKey *ecdsa.PublicKey
+	// Parties are the participants of the key, including the participant itself, keyed by index.
+	Parties map[uint32]*KeyParty
+}
+
+// KeyParty is what a participant knows about a participant of a key.
+type KeyParty struct {
+	Identity *PeerIdentity
+	// SharePublicKey is the public key of the participant's share of the key.
+	SharePublicKey *ecdsa.PublicKey
+	// The participant's Paillier modulus and range proof parameters.
+	PaillierN *big.Int
+	NTilde    *big.Int
+	H1        *big.Int
+	H2        *big.Int
+}
+
+// indexes returns the indexes of the parties of the key in ascending order.
+func (k *Key) indexes() []uint32 {
+	indexes := make([]uint32, 0, len(k.Parties))
+	for index := range k.Parties {
+		indexes = append(indexes, index)
+	}
+	sort.Slice(indexes, func(i, j int) bool { return indexes[i] < indexes[j] })
+	return indexes
+}
+
+// Share is the state of a participant: its identity and pre-parameters, which are generated when the
+// participant is initialized (see NewShare), and its share of the threshold key, once the key is generated.
+type Share struct {
+	// Index of the participant, starting at 1.
+	Index     uint32
+	Identity  *Identity
+	PreParams *PreParams
+	// Key is nil until the key generation completed.
+	Key *Key
+}
+
+// NewShare initializes a participant, generating its identity and pre-parameters.
+func NewShare(index uint32, timeout time.Duration) (*Share, error) {
+	if index < 1 || index > MaxParties {
+		return nil, fmt.Errorf("the index must be between 1 and %d", MaxParties)
+	}
+
+	identity, err := GenerateIdentity()
+	if err != nil {
+		return nil, err
+	}
+
+	preParams, err := GeneratePreParams(timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Share{Index: index, Identity: identity, PreParams: preParams}, nil
+}
+
+// Validate checks that the share is consistent; i.e., that the pre-parameters are well formed, and that the key
+// share matches the participant's share public key, and that the share public keys interpolate to the public key.
+func (s *Share) Validate() error {
+	if s.Index < 1 || s.Index > MaxParties {
+		return errors.New("invalid share index")
+	}
+	if s.Identity == nil || len(s.Identity.SigningKey) != ed25519.PrivateKeySize {
+		return errors.New("invalid identity")
+	}
+	if s.PreParams == nil {
+		return errors.New("missing pre-parameters")
+	}
+	if err := s.PreParams.Validate(); err != nil {
+		return err
+	}
+
+	if s.Key == nil {
+		return nil
+	}
+
+	k := s.Key
+	parties := uint32(len(k.Parties)) // #nosec G115 -- checked against MaxParties below
+	if len(k.Parties) > MaxParties || k.Threshold < 2 || k.Threshold > parties {
+		return errors.New("invalid threshold parameters")
+	}
+
+	self, ok := k.Parties[s.Index]
+	if !ok || !self.Identity.Equal(s.Identity.Public()) || self.PaillierN.Cmp(s.PreParams.PaillierKey.N) != 0 {
+		return errors.New("the key does not match the participant")
+	}
+	if k.Secret == nil || k.Secret.Sign() <= 0 || k.Secret.Cmp(curveN) >= 0 {
+		return errors.New("invalid share secret")
+	}
+	if !scalarBaseMult(k.Secret).Equal(self.SharePublicKey) {
+		return errors.New("the share secret does not match its public key")
+	}
+
+	// Any threshold share public keys must interpolate to the public key. Checking two different sets covers
+	// every share public key, which means that all the shares are on the same polynomial.
+	indexes := k.indexes()
+	for _, start := range []uint32{0, parties - k.Threshold} {
+		subset := indexes[start : start+k.Threshold]
+
+		var x, y *big.Int
+		for _, i := range subset {
+			lambda := lagrangeCoefficient(i, subset)
+			pk := k.Parties[i].SharePublicKey
+			px, py := curve.ScalarMult(pk.X, pk.Y, lambda.Bytes())
+			if x == nil {
+				x, y = px, py
+			} else {
+				x, y = curve.Add(x, y, px, py)
+			}
+		}
+
+		if x.Cmp(k.PublicKey.X) != 0 || y.Cmp(k.PublicKey.Y) != 0 {
+			return errors.New("the share public keys do not match the public key")
+		}
+	}
+
+	return nil
+}
+
+// lagrangeCoefficient returns the Lagrange coefficient of index at 0 over the given indexes, modulo the curve order.
+func lagrangeCoefficient(index uint32, indexes []uint32) *big.Int {
+	num := big.NewInt(1)
+	den := big.NewInt(1)
+	for _, j := range indexes {
+		if j == index {
+			continue
+		}
+		num.Mul(num, big.NewInt(int64(j)))
+		num.Mod(num, curveN)
+		den.Mul(den, big.NewInt(int64(j)-int64(index)))
+		den.Mod(den, curveN)
+	}
+	return num.Mul(num, den.ModInverse(den, curveN)).Mod(num, curveN)
+}
+
+// shareFile is the JSON encoding of a Share.
+type shareFile struct {
+	Version       int           `json:"version"`
+	Index         uint32        `json:"index"`
+	SigningKey    string        `json:"signingKey"`
+	EncryptionKey string        `json:"encryptionKey"`
+	PreParams     preParamsFile `json:"preParams"`
+	Key           *keyFile      `json:"key,omitempty"`
+}
+
+type preParamsFile struct {
+	PaillierN       *big.Int `json:"paillierN"`
+	PaillierLambdaN *big.Int `json:"paillierLambdaN"`
+	PaillierPhiN    *big.Int `json:"paillierPhiN"`
+	NTilde          *big.Int `json:"nTilde"`
+	H1              *big.Int `json:"h1"`
+	H2              *big.Int `json:"h2"`
+	Alpha           *big.Int `json:"alpha"`
+	Beta            *big.Int `json:"beta"`
+	P               *big.Int `json:"p"`
+	Q               *big.Int `json:"q"`
+}
+
+type keyFile struct {
+	Threshold uint32         `json:"threshold"`
+	Secret    string         `json:"secret"`
+	PublicKey string         `json:"publicKey"`
+	Parties   []keyPartyFile `json:"parties"`
+}
+
+type keyPartyFile struct {
+	Index          uint32   `json:"index"`
+	SigningKey     string   `json:"signingKey"`
+	EncryptionKey  string   `json:"encryptionKey"`
+	SharePublicKey string   `json:"sharePublicKey"`
+	PaillierN      *big.Int `json:"paillierN"`
+	NTilde         *big.Int `json:"nTilde"`
+	H1             *big.Int `json:"h1"`
+	H2             *big.Int `json:"h2"`
+}
+
+func (s *Share) marshal() ([]byte, error) {
+	pp := s.PreParams
+	f := shareFile{
+		Version:       shareFileVersion,
+		Index:         s.Index,
+		SigningKey:    hex.EncodeToString(s.Identity.SigningKey.Seed()),
+		EncryptionKey: hex.EncodeToString(s.Identity.EncryptionKey[:]),
+		PreParams: preParamsFile{
+			PaillierN:       pp.PaillierKey.N,
+			PaillierLambdaN: pp.PaillierKey.LambdaN,
+			PaillierPhiN:    pp.PaillierKey.PhiN,
+			NTilde:          pp.NTilde,
+			H1:              pp.H1,
+			H2:              pp.H2,
+			Alpha:           pp.Alpha,
+			Beta:            pp.Beta,
+			P:               pp.P,
+			Q:               pp.Q,
+		},
+	}
+
+	if k := s.Key; k != nil {
+		f.Key = &keyFile{
+			Threshold: k.Threshold,
+			Secret:    hex.EncodeToString(scalarBytes(k.Secret)),
+			PublicKey: hex.EncodeToString(ethcrypto.FromECDSAPub(k.PublicKey)),
+		}
+		for _, index := range k.indexes() {
+			party := k.Parties[index]
+			f.Key.Parties = append(f.Key.Parties, keyPartyFile{
+				Index:          index,
+				SigningKey:     hex.EncodeToString(party.Identity.SigningKey),
+				EncryptionKey:  hex.EncodeToString(party.Identity.EncryptionKey[:]),
+				SharePublicKey: hex.EncodeToString(ethcrypto.FromECDSAPub(party.SharePublicKey)),
+				PaillierN:      party.PaillierN,
+				NTilde:         party.NTilde,
+				H1:             party.H1,
+				H2:             party.H2,
+			})
+		}
+	}
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// SaveShare writes the share to a new file at path, which is only readable by the current user.
+func (s *Share) SaveShare(path string) error {
+	b, err := s.marshal()
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create share file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(b); err != nil {
+		return fmt.Errorf("failed to write share file: %w", err)
+	}
+
+	return file.Sync()
+}
+
+// replaceShare atomically replaces the share file at path with the share.
+func (s *Share) replaceShare(path string) error {
+	tmpPath := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	_ = os.Remove(tmpPath)
+	if err := s.SaveShare(tmpPath); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		_ = os.Remove(tmpPath)
+		return fmt.Errorf("failed to replace share file: %w", err)
+	}
+	return nil
+}
+
+// LoadShare reads and validates a share written by SaveShare.
+func LoadShare(path string) (*Share, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read share file: %w", err)
+	}
+
+	var f shareFile
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("failed to parse share file: %w", err)
+	}
+	if f.Version != shareFileVersion {
+		return nil, fmt.Errorf("unsupported share file version %d", f.Version)
+	}
+
+	seed, err := hex.DecodeString(f.SigningKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		return nil, errors.New("invalid signing key")
+	}
+	encryptionKey, err := hex.DecodeString(f.EncryptionKey)
+	if err != nil || len(encryptionKey) != 32 {
+		return nil, errors.New("invalid encryption key")
+	}
+
+	share := &Share{
+		Index:    f.Index,
+		Identity: &Identity{SigningKey: ed25519.NewKeyFromSeed(seed)},
+		PreParams: &PreParams{
+			PaillierKey: &paillier.PrivateKey{
+				PublicKey: paillier.PublicKey{N: f.PreParams.PaillierN},
+				LambdaN:   f.PreParams.PaillierLambdaN,
+				PhiN:      f.PreParams.PaillierPhiN,
+			},
+			NTilde: f.PreParams.NTilde,
+			H1:     f.PreParams.H1,
+			H2:     f.PreParams.H2,
+			Alpha:  f.PreParams.Alpha,
+			Beta:   f.PreParams.Beta,
+			P:      f.PreParams.P,
+			Q:      f.PreParams.Q,
+		},
+	}
+	copy(share.Identity.EncryptionKey[:], encryptionKey)
+
+	if f.Key != nil {
+		secret, err := hex.DecodeString(f.Key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("invalid share secret: %w", err)
+		}
+		publicKey, err := unmarshalPublicKeyHex(f.Key.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+
+		share.Key = &Key{
+			Threshold: f.Key.Threshold,
+			Secret:    new(big.Int).SetBytes(secret),
+			PublicKey: publicKey,
+			Parties:   make(map[uint32]*KeyParty, len(f.Key.Parties)),
+		}
+		for _, p := range f.Key.Parties {
+			identity, err := ParsePeerIdentity(p.SigningKey, p.EncryptionKey)
+			if err != nil {
+				return nil, fmt.Errorf("party %d: %w", p.Index, err)
+			}
+			sharePublicKey, err := unmarshalPublicKeyHex(p.SharePublicKey)
+			if err != nil {
+				return nil, fmt.Errorf("party %d: %w", p.Index, err)
+			}
+			if _, exists := share.Key.Parties[p.Index]; exists || p.PaillierN == nil || p.NTilde == nil || p.H1 == nil || p.H2 == nil {
+				return nil, fmt.Errorf("invalid or duplicate party %d", p.Index)
+			}
+			share.Key.Parties[p.Index] = &KeyParty{
+				Identity:       identity,
+				SharePublicKey: sharePublicKey,
+				PaillierN:      p.PaillierN,
+				NTilde:         p.NTilde,
+				H1:             p.H1,
+				H2:             p.H2,
+			}
+		}
+	}
+
+	if err := share.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid share: %w", err)
+	}
+
+	return share, nil
+}
+
+func unmarshalPublicKeyHex(s string) (*ecdsa.PublicKey, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	pk, err := ethcrypto.UnmarshalPubkey(b)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return pk, nil
+}
+
+// scalarBaseMult returns k*G as a public key.
+func scalarBaseMult(k *big.Int) *ecdsa.PublicKey {
+	x, y := curve.ScalarBaseMult(k.Bytes())
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+}
+
+// scalarBytes returns the 32-byte big-endian encoding of a scalar.
+func scalarBytes(k *big.Int) []byte {
+	b := make([]byte, 32)
+	return k.FillBytes(b)
+}
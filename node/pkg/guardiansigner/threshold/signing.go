@@ -0,0 +1,596 @@
+package threshold
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/binance-chain/tss-lib/common"
+	tsscrypto "github.com/binance-chain/tss-lib/crypto"
+	cmts "github.com/binance-chain/tss-lib/crypto/commitments"
+	"github.com/binance-chain/tss-lib/crypto/mta"
+	"github.com/binance-chain/tss-lib/crypto/paillier"
+	"github.com/binance-chain/tss-lib/crypto/schnorr"
+	"github.com/binance-chain/tss-lib/tss"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+)
+
+// signing is a participant's side of the GG18 signing protocol, following rounds 1 to 9 of tss-lib's
+// ecdsa/signing. The signers convert the products k*gamma and k*w of their nonce, blinding and key shares into
+// additive shares with the MtA conversion, which is protected by range proofs, reveal R = k^-1 * G, and check
+// their shares of s against each other before revealing them, so a malicious signer can make the session fail
+// but can't learn anything about the key shares of the other signers.
+type signing struct {
+	share  *Share
+	key    *Key
+	digest *big.Int
+	// signers are the indexes of the signers, including the local party.
+	signers []uint32
+
+	w     *big.Int
+	bigWs map[uint32]*tsscrypto.ECPoint
+
+	// Round 1
+	k, gamma          *big.Int
+	pointGamma        *tsscrypto.ECPoint
+	gammaDecommitment cmts.HashDeCommitment
+	cis               map[uint32]*big.Int
+	commitments       map[uint32]cmts.HashCommitment
+
+	// Round 2
+	betas, vs map[uint32]*big.Int
+
+	// Round 3
+	theta, sigma *big.Int
+
+	// Round 4
+	thetaInverse *big.Int
+
+	// Round 5
+	r, si          *big.Int
+	bigR           *tsscrypto.ECPoint
+	li, roi        *big.Int
+	bigVi, bigAi   *tsscrypto.ECPoint
+	vaDecommitment cmts.HashDeCommitment
+
+	// Round 7
+	ui, ti         *tsscrypto.ECPoint
+	utDecommitment cmts.HashDeCommitment
+}
+
+func newSigning(share *Share, digest []byte, signers []uint32) *signing {
+	s := &signing{
+		share:       share,
+		key:         share.Key,
+		digest:      new(big.Int).SetBytes(digest),
+		signers:     signers,
+		bigWs:       make(map[uint32]*tsscrypto.ECPoint, len(signers)),
+		cis:         make(map[uint32]*big.Int, len(signers)),
+		commitments: make(map[uint32]cmts.HashCommitment, len(signers)),
+		betas:       make(map[uint32]*big.Int, len(signers)),
+		vs:          make(map[uint32]*big.Int, len(signers)),
+	}
+
+	// The signers' additive shares of the key for this set of signers, and their public keys.
+	w := new(big.Int).Mul(lagrangeCoefficient(share.Index, signers), s.key.Secret)
+	s.w = w.Mod(w, curveN)
+	for _, index := range signers {
+		pk := s.key.Parties[index].SharePublicKey
+		point := tsscrypto.NewECPointNoCurveCheck(tss.EC(), pk.X, pk.Y)
+		s.bigWs[index] = point.ScalarMult(lagrangeCoefficient(index, signers))
+	}
+
+	return s
+}
+
+func (s *signing) rounds() []roundSpec {
+	return []roundSpec{
+		{broadcast: true, p2p: true},
+		{p2p: true},
+		{broadcast: true},
+		{broadcast: true},
+		{broadcast: true},
+		{broadcast: true},
+		{broadcast: true},
+		{broadcast: true},
+		{broadcast: true},
+	}
+}
+
+func (s *signing) round(r int, in map[uint32]*roundMessages) (*roundOutput, error) {
+	switch r {
+	case 1:
+		return s.round1()
+	case 2:
+		return s.round2(in)
+	case 3:
+		return s.round3(in)
+	case 4:
+		return s.round4(in)
+	case 5:
+		return s.round5(in)
+	case 6:
+		return s.round6(in)
+	case 7:
+		return s.round7(in)
+	case 8:
+		return s.round8(in)
+	case 9:
+		return s.round9(in)
+	}
+	return nil, fmt.Errorf("invalid round %d", r)
+}
+
+// paillierKey returns the Paillier public key of a signer.
+func (s *signing) paillierKey(index uint32) *paillier.PublicKey {
+	return &paillier.PublicKey{N: s.key.Parties[index].PaillierN}
+}
+
+// round1 samples the nonce share k and the blinding share gamma, commits to Gamma = gamma * G, and starts the MtA
+// conversions by sending k encrypted under the party's Paillier key to every other signer, with a range proof.
+func (s *signing) round1() (*roundOutput, error) {
+	n := tss.EC().Params().N
+	s.k = common.GetRandomPositiveInt(n)
+	s.gamma = common.GetRandomPositiveInt(n)
+	s.pointGamma = tsscrypto.ScalarBaseMult(tss.EC(), s.gamma)
+
+	commitment := cmts.NewHashCommitment(s.pointGamma.X(), s.pointGamma.Y())
+	s.gammaDecommitment = commitment.D
+
+	out := &roundOutput{
+		broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignCommitment{
+			SignCommitment: &signerv1.ThresholdCommitment{Commitment: commitment.C.Bytes()},
+		}},
+		p2p: make(map[uint32]*signerv1.ThresholdMessage, len(s.signers)),
+	}
+
+	for _, index := range s.signers {
+		if index == s.share.Index {
+			continue
+		}
+		peer := s.key.Parties[index]
+		c, proof, err := mta.AliceInit(s.paillierKey(s.share.Index), s.k, peer.NTilde, peer.H1, peer.H2)
+		if err != nil {
+			return nil, err
+		}
+		s.cis[index] = c
+		rangeProof := proof.Bytes()
+		out.p2p[index] = &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignMtaInit{
+			SignMtaInit: &signerv1.ThresholdSignMtAInit{C: c.Bytes(), RangeProof: rangeProof[:]},
+		}}
+	}
+
+	return out, nil
+}
+
+// round2 responds to the MtA conversions of the other signers, for gamma and for w.
+func (s *signing) round2(in map[uint32]*roundMessages) (*roundOutput, error) {
+	self := s.key.Parties[s.share.Index]
+	out := &roundOutput{p2p: make(map[uint32]*signerv1.ThresholdMessage, len(in))}
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		commitment := msgs.broadcast.GetSignCommitment()
+		init := msgs.p2p.GetSignMtaInit()
+		if commitment == nil || init == nil {
+			return errors.New("expected a commitment and an MtA init message")
+		}
+		s.commitments[index] = new(big.Int).SetBytes(commitment.Commitment)
+
+		peer := s.key.Parties[index]
+		pk := s.paillierKey(index)
+		c := new(big.Int).SetBytes(init.C)
+		if !validCiphertext(pk, c) {
+			return errors.New("invalid ciphertext")
+		}
+		proof, err := mta.RangeProofAliceFromBytes(init.RangeProof)
+		if err != nil {
+			return err
+		}
+
+		beta, c1, _, proofBob, err := mta.BobMid(pk, proof, s.gamma, c, peer.NTilde, peer.H1, peer.H2, self.NTilde, self.H1, self.H2)
+		if err != nil {
+			return err
+		}
+		v, c2, _, proofBobWC, err := mta.BobMidWC(pk, proof, s.w, c, peer.NTilde, peer.H1, peer.H2, self.NTilde, self.H1, self.H2, s.bigWs[s.share.Index])
+		if err != nil {
+			return err
+		}
+		s.betas[index] = beta
+		s.vs[index] = v
+
+		proofBobBytes := proofBob.Bytes()
+		proofBobWCBytes := proofBobWC.Bytes()
+		out.p2p[index] = &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignMtaResponse{
+			SignMtaResponse: &signerv1.ThresholdSignMtAResponse{
+				C1:         c1.Bytes(),
+				C2:         c2.Bytes(),
+				ProofBob:   proofBobBytes[:],
+				ProofBobWc: proofBobWCBytes[:],
+			},
+		}}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// round3 completes the MtA conversions, and sends the party's share of theta = k * gamma.
+func (s *signing) round3(in map[uint32]*roundMessages) (*roundOutput, error) {
+	modN := common.ModInt(tss.EC().Params().N)
+	self := s.key.Parties[s.share.Index]
+	pk := s.paillierKey(s.share.Index)
+	sk := s.share.PreParams.PaillierKey
+
+	theta := modN.Mul(s.k, s.gamma)
+	sigma := modN.Mul(s.k, s.w)
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.p2p.GetSignMtaResponse()
+		if msg == nil {
+			return errors.New("expected an MtA response")
+		}
+
+		c1 := new(big.Int).SetBytes(msg.C1)
+		c2 := new(big.Int).SetBytes(msg.C2)
+		if !validCiphertext(pk, c1) || !validCiphertext(pk, c2) {
+			return errors.New("invalid ciphertext")
+		}
+		if len(msg.ProofBob) != mta.ProofBobBytesParts || len(msg.ProofBobWc) != mta.ProofBobWCBytesParts {
+			return errors.New("invalid MtA proofs")
+		}
+		proofBob, err := mta.ProofBobFromBytes(msg.ProofBob)
+		if err != nil {
+			return err
+		}
+		proofBobWC, err := mta.ProofBobWCFromBytes(msg.ProofBobWc)
+		if err != nil {
+			return err
+		}
+
+		alpha, err := mta.AliceEnd(pk, proofBob, self.H1, self.H2, s.cis[index], c1, self.NTilde, sk)
+		if err != nil {
+			return err
+		}
+		u, err := mta.AliceEndWC(pk, proofBobWC, s.bigWs[index], s.cis[index], c2, self.NTilde, self.H1, self.H2, sk)
+		if err != nil {
+			return err
+		}
+
+		theta = modN.Add(theta, modN.Add(alpha, s.betas[index]))
+		sigma = modN.Add(sigma, modN.Add(u, s.vs[index]))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.theta = theta
+	s.sigma = sigma
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignTheta{
+		SignTheta: &signerv1.ThresholdSignTheta{Theta: theta.Bytes()},
+	}}}, nil
+}
+
+// round4 computes the inverse of theta, and opens the commitment to Gamma with a proof of knowledge of gamma.
+func (s *signing) round4(in map[uint32]*roundMessages) (*roundOutput, error) {
+	n := tss.EC().Params().N
+	modN := common.ModInt(n)
+	theta := new(big.Int).Set(s.theta)
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignTheta()
+		if msg == nil {
+			return errors.New("expected a theta share")
+		}
+		t := new(big.Int).SetBytes(msg.Theta)
+		if t.Cmp(n) >= 0 {
+			return errors.New("invalid theta share")
+		}
+		theta = modN.Add(theta, t)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if theta.Sign() == 0 {
+		return nil, errors.New("theta is zero")
+	}
+	s.thetaInverse = modN.ModInverse(theta)
+
+	proof, err := schnorr.NewZKProof(s.gamma, s.pointGamma)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignGammaDecommitment{
+		SignGammaDecommitment: &signerv1.ThresholdSignGammaDecommitment{
+			Decommitment: common.BigIntsToBytes(s.gammaDecommitment),
+			Proof:        marshalZKProof(proof.Alpha, proof.T, nil),
+		},
+	}}}, nil
+}
+
+// round5 opens the commitments to the Gamma points of the other signers, computes R and the party's share of s,
+// and commits to the points V and A that are used to check the shares of s before they are revealed.
+func (s *signing) round5(in map[uint32]*roundMessages) (*roundOutput, error) {
+	n := tss.EC().Params().N
+	modN := common.ModInt(n)
+	bigR := s.pointGamma
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignGammaDecommitment()
+		if msg == nil {
+			return errors.New("expected a Gamma decommitment")
+		}
+
+		points, err := decommitPoints(s.commitments[index], msg.Decommitment, 1)
+		if err != nil {
+			return err
+		}
+		alpha, t, _, err := unmarshalZKProof(msg.Proof)
+		if err != nil {
+			return err
+		}
+		proof := &schnorr.ZKProof{Alpha: alpha, T: t}
+		if !proof.Verify(points[0]) {
+			return errors.New("invalid proof of knowledge of gamma")
+		}
+
+		bigR, err = bigR.Add(points[0])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.bigR = bigR.ScalarMult(s.thetaInverse)
+	s.r = new(big.Int).Mod(s.bigR.X(), n)
+	if s.r.Sign() == 0 {
+		return nil, errors.New("r is zero")
+	}
+	s.si = modN.Add(modN.Mul(s.digest, s.k), modN.Mul(s.r, s.sigma))
+
+	s.li = common.GetRandomPositiveInt(n)
+	s.roi = common.GetRandomPositiveInt(n)
+	s.bigAi = tsscrypto.ScalarBaseMult(tss.EC(), s.roi)
+	s.bigVi, err = s.bigR.ScalarMult(s.si).Add(tsscrypto.ScalarBaseMult(tss.EC(), s.li))
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := cmts.NewHashCommitment(s.bigVi.X(), s.bigVi.Y(), s.bigAi.X(), s.bigAi.Y())
+	s.vaDecommitment = commitment.D
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignCommitment{
+		SignCommitment: &signerv1.ThresholdCommitment{Commitment: commitment.C.Bytes()},
+	}}}, nil
+}
+
+// round6 opens the commitment to V and A, with proofs of knowledge of their discrete logarithms.
+func (s *signing) round6(in map[uint32]*roundMessages) (*roundOutput, error) {
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignCommitment()
+		if msg == nil {
+			return errors.New("expected a commitment")
+		}
+		s.commitments[index] = new(big.Int).SetBytes(msg.Commitment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	proofA, err := schnorr.NewZKProof(s.roi, s.bigAi)
+	if err != nil {
+		return nil, err
+	}
+	proofV, err := schnorr.NewZKVProof(s.bigVi, s.bigR, s.si, s.li)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignCheckDecommitment{
+		SignCheckDecommitment: &signerv1.ThresholdSignCheckDecommitment{
+			Decommitment: common.BigIntsToBytes(s.vaDecommitment),
+			ProofA:       marshalZKProof(proofA.Alpha, proofA.T, nil),
+			ProofV:       marshalZKProof(proofV.Alpha, proofV.T, proofV.U),
+		},
+	}}}, nil
+}
+
+// round7 opens the V and A points of the other signers, and commits to U = roi * V and T = li * A, where
+// V = -m * G - r * Y + sum(V_j) and A = sum(A_j).
+func (s *signing) round7(in map[uint32]*roundMessages) (*roundOutput, error) {
+	modN := common.ModInt(tss.EC().Params().N)
+	bigA := s.bigAi
+
+	minusM := modN.Sub(big.NewInt(0), s.digest)
+	minusR := modN.Sub(big.NewInt(0), s.r)
+	publicKey := tsscrypto.NewECPointNoCurveCheck(tss.EC(), s.key.PublicKey.X, s.key.PublicKey.Y)
+	bigV, err := tsscrypto.ScalarBaseMult(tss.EC(), minusM).Add(publicKey.ScalarMult(minusR))
+	if err != nil {
+		return nil, err
+	}
+	if bigV, err = bigV.Add(s.bigVi); err != nil {
+		return nil, err
+	}
+
+	err = forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignCheckDecommitment()
+		if msg == nil {
+			return errors.New("expected a V and A decommitment")
+		}
+
+		points, err := decommitPoints(s.commitments[index], msg.Decommitment, 2)
+		if err != nil {
+			return err
+		}
+		bigVj, bigAj := points[0], points[1]
+
+		alpha, t, _, err := unmarshalZKProof(msg.ProofA)
+		if err != nil {
+			return err
+		}
+		if !(&schnorr.ZKProof{Alpha: alpha, T: t}).Verify(bigAj) {
+			return errors.New("invalid proof of knowledge of A")
+		}
+		alpha, t, u, err := unmarshalZKProof(msg.ProofV)
+		if err != nil || u == nil {
+			return errors.New("invalid proof of knowledge of V")
+		}
+		if !(&schnorr.ZKVProof{Alpha: alpha, T: t, U: u}).Verify(bigVj, s.bigR) {
+			return errors.New("invalid proof of knowledge of V")
+		}
+
+		if bigV, err = bigV.Add(bigVj); err != nil {
+			return err
+		}
+		bigA, err = bigA.Add(bigAj)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.ui = bigV.ScalarMult(s.roi)
+	s.ti = bigA.ScalarMult(s.li)
+	commitment := cmts.NewHashCommitment(s.ui.X(), s.ui.Y(), s.ti.X(), s.ti.Y())
+	s.utDecommitment = commitment.D
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignCommitment{
+		SignCommitment: &signerv1.ThresholdCommitment{Commitment: commitment.C.Bytes()},
+	}}}, nil
+}
+
+// round8 opens the commitment to U and T.
+func (s *signing) round8(in map[uint32]*roundMessages) (*roundOutput, error) {
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignCommitment()
+		if msg == nil {
+			return errors.New("expected a commitment")
+		}
+		s.commitments[index] = new(big.Int).SetBytes(msg.Commitment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignDecommitment{
+		SignDecommitment: &signerv1.ThresholdDecommitment{Decommitment: common.BigIntsToBytes(s.utDecommitment)},
+	}}}, nil
+}
+
+// round9 checks that sum(U_j) = sum(T_j), which holds if and only if the shares of s form a valid signature, and
+// only then reveals the party's share of s.
+func (s *signing) round9(in map[uint32]*roundMessages) (*roundOutput, error) {
+	sumU, sumT := s.ui, s.ti
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignDecommitment()
+		if msg == nil {
+			return errors.New("expected a U and T decommitment")
+		}
+
+		points, err := decommitPoints(s.commitments[index], msg.Decommitment, 2)
+		if err != nil {
+			return err
+		}
+		if sumU, err = sumU.Add(points[0]); err != nil {
+			return err
+		}
+		sumT, err = sumT.Add(points[1])
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !sumU.Equals(sumT) {
+		// The check can't tell which signer is at fault.
+		return nil, errors.New("the shares of s do not form a valid signature")
+	}
+
+	return &roundOutput{broadcast: &signerv1.ThresholdMessage{Message: &signerv1.ThresholdMessage_SignShare{
+		SignShare: &signerv1.ThresholdSignShare{S: s.si.Bytes()},
+	}}}, nil
+}
+
+// finish sums the shares of s, and verifies the signature.
+func (s *signing) finish(in map[uint32]*roundMessages) (*signerv1.ThresholdSessionResult, error) {
+	n := tss.EC().Params().N
+	modN := common.ModInt(n)
+	sum := new(big.Int).Set(s.si)
+
+	err := forEachPeer(in, func(index uint32, msgs *roundMessages) error {
+		msg := msgs.broadcast.GetSignShare()
+		if msg == nil {
+			return errors.New("expected a share of s")
+		}
+		sj := new(big.Int).SetBytes(msg.S)
+		if sj.Cmp(n) >= 0 {
+			return errors.New("invalid share of s")
+		}
+		sum = modN.Add(sum, sj)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !ecdsa.Verify(s.key.PublicKey, scalarBytes(s.digest), s.r, sum) {
+		return nil, errors.New("signature verification failed")
+	}
+
+	return &signerv1.ThresholdSessionResult{R: scalarBytes(s.r), S: scalarBytes(sum)}, nil
+}
+
+// validCiphertext reports whether c is a valid Paillier ciphertext under pk, which is in [1, N^2) and coprime
+// to N.
+func validCiphertext(pk *paillier.PublicKey, c *big.Int) bool {
+	return c.Sign() > 0 && c.Cmp(pk.NSquare()) < 0 && new(big.Int).GCD(nil, nil, c, pk.N).Cmp(big.NewInt(1)) == 0
+}
+
+// decommitPoints opens a commitment to count points.
+func decommitPoints(commitment cmts.HashCommitment, decommitment [][]byte, count int) ([]*tsscrypto.ECPoint, error) {
+	c := cmts.HashCommitDecommit{C: commitment, D: cmts.NewHashDeCommitmentFromBytes(decommitment)}
+	ok, values := c.DeCommit()
+	if !ok || len(values) != 2*count {
+		return nil, errors.New("the decommitment does not match the commitment")
+	}
+	return tsscrypto.UnFlattenECPoints(tss.EC(), values)
+}
+
+func marshalZKProof(alpha *tsscrypto.ECPoint, t *big.Int, u *big.Int) *signerv1.ThresholdSchnorrProof {
+	proof := &signerv1.ThresholdSchnorrProof{
+		AlphaX: alpha.X().Bytes(),
+		AlphaY: alpha.Y().Bytes(),
+		T:      t.Bytes(),
+	}
+	if u != nil {
+		proof.U = u.Bytes()
+	}
+	return proof
+}
+
+// unmarshalZKProof parses a Schnorr proof. u is nil if it is not set.
+func unmarshalZKProof(proof *signerv1.ThresholdSchnorrProof) (alpha *tsscrypto.ECPoint, t *big.Int, u *big.Int, err error) {
+	if proof == nil {
+		return nil, nil, nil, errors.New("missing proof")
+	}
+	alpha, err = tsscrypto.NewECPoint(tss.EC(), new(big.Int).SetBytes(proof.AlphaX), new(big.Int).SetBytes(proof.AlphaY))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	t = new(big.Int).SetBytes(proof.T)
+	if len(proof.U) > 0 {
+		u = new(big.Int).SetBytes(proof.U)
+	}
+	return alpha, t, u, nil
+}
@@ -0,0 +1,266 @@
+package threshold
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// The pre-parameters of the participants take minutes to generate, so the tests use participants that were
+// initialized with NewShare ahead of time.
+const testParties = 3
+
+// testCluster is a set of participants served over UNIX sockets.
+type testCluster struct {
+	dir     string
+	config  *Config
+	clients map[uint32]signerv1.ThresholdSignerServiceClient
+}
+
+// newTestCluster starts testParties participants of a key with the given threshold, from copies of the
+// initialized share files in testdata.
+func newTestCluster(t *testing.T, threshold uint32) *testCluster {
+	// UNIX socket paths are limited in length, so a short directory is used instead of t.TempDir().
+	dir, err := os.MkdirTemp("", "threshold")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	config := &Config{Threshold: threshold}
+	for i := uint32(1); i <= testParties; i++ {
+		b, err := os.ReadFile(fmt.Sprintf("testdata/participant-%d.json", i))
+		require.NoError(t, err)
+		sharePath := filepath.Join(dir, fmt.Sprintf("share-%d.json", i))
+		require.NoError(t, os.WriteFile(sharePath, b, 0600))
+
+		share, err := LoadShare(sharePath)
+		require.NoError(t, err)
+		identity := share.Identity.Public()
+		config.Participants = append(config.Participants, ConfigParticipant{
+			Index:         i,
+			Address:       "unix://" + filepath.Join(dir, fmt.Sprintf("%d.sock", i)),
+			SigningKey:    hex.EncodeToString(identity.SigningKey),
+			EncryptionKey: hex.EncodeToString(identity.EncryptionKey[:]),
+		})
+	}
+	require.NoError(t, config.Validate())
+
+	c := &testCluster{dir: dir, config: config, clients: map[uint32]signerv1.ThresholdSignerServiceClient{}}
+	for _, p := range config.Participants {
+		c.start(t, p)
+	}
+	return c
+}
+
+// start serves a participant, and connects to it.
+func (c *testCluster) start(t *testing.T, p ConfigParticipant) {
+	participant, err := NewParticipant(c.sharePath(p.Index), c.config)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, Serve(ctx, participant, p.Address, nil))
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	conn, err := c.config.Dial(context.Background(), p)
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	c.clients[p.Index] = signerv1.NewThresholdSignerServiceClient(conn)
+}
+
+func (c *testCluster) sharePath(index uint32) string {
+	return filepath.Join(c.dir, fmt.Sprintf("share-%d.json", index))
+}
+
+func (c *testCluster) subset(indexes ...uint32) map[uint32]signerv1.ThresholdSignerServiceClient {
+	clients := map[uint32]signerv1.ThresholdSignerServiceClient{}
+	for _, index := range indexes {
+		clients[index] = c.clients[index]
+	}
+	return clients
+}
+
+// verifySignature checks r and s against the public key, after normalizing s to the lower half of the curve order.
+func verifySignature(t *testing.T, publicKey *ecdsa.PublicKey, digest []byte, r []byte, s []byte) {
+	sInt := new(big.Int).SetBytes(s)
+	if sInt.Cmp(new(big.Int).Rsh(curveN, 1)) > 0 {
+		sInt.Sub(curveN, sInt)
+	}
+	sig := append(append([]byte{}, r...), scalarBytes(sInt)...)
+	assert.True(t, ethcrypto.VerifySignature(ethcrypto.FromECDSAPub(publicKey), digest, sig))
+}
+
+func TestShareFiles(t *testing.T) {
+	share, err := LoadShare("testdata/participant-1.json")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(1), share.Index)
+	assert.Nil(t, share.Key)
+
+	path := filepath.Join(t.TempDir(), "share.json")
+	require.NoError(t, share.SaveShare(path))
+	assert.Error(t, share.SaveShare(path), "share files must not be overwritten")
+
+	loaded, err := LoadShare(path)
+	require.NoError(t, err)
+	assert.True(t, loaded.Identity.Public().Equal(share.Identity.Public()))
+	assert.Equal(t, share.PreParams.NTilde, loaded.PreParams.NTilde)
+
+	// Pre-parameters that don't match their proofs' secrets are rejected.
+	share.PreParams.H2 = new(big.Int).Add(share.PreParams.H2, big.NewInt(1))
+	assert.Error(t, share.Validate())
+}
+
+func TestConfigValidate(t *testing.T) {
+	cluster := newTestCluster(t, 2)
+	config := *cluster.config
+
+	// TCP addresses require mutual TLS.
+	config.Participants = append([]ConfigParticipant{}, cluster.config.Participants...)
+	config.Participants[0].Address = "10.0.0.1:4000"
+	assert.ErrorContains(t, config.Validate(), "tls")
+	config.TLS = &ConfigTLS{CACert: "ca.pem", ClientCert: "client.pem", ClientKey: "client.key"}
+	assert.NoError(t, config.Validate())
+
+	// Participants must have distinct identities.
+	config.Participants[1].SigningKey = config.Participants[0].SigningKey
+	assert.ErrorContains(t, config.Validate(), "same identity")
+
+	config.Participants = cluster.config.Participants
+	config.Threshold = testParties + 1
+	assert.Error(t, config.Validate())
+}
+
+func TestThresholdKeygenAndSign(t *testing.T) {
+	ctx := context.Background()
+	cluster := newTestCluster(t, 2)
+
+	// Signing requires a key.
+	_, _, err := Sign(ctx, cluster.subset(1, 2), ethcrypto.Keccak256([]byte("data")))
+	assert.ErrorContains(t, err, "key generation has not completed")
+
+	publicKey, err := Keygen(ctx, cluster.clients)
+	require.NoError(t, err)
+
+	for index, client := range cluster.clients {
+		info, err := client.Info(ctx, &signerv1.ThresholdInfoRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, index, info.Index)
+		assert.Equal(t, uint32(2), info.Threshold)
+		assert.Equal(t, uint32(testParties), info.Parties)
+		assert.Equal(t, ethcrypto.FromECDSAPub(publicKey), info.PublicKey)
+
+		// The key share is stored, and no participant holds the key.
+		share, err := LoadShare(cluster.sharePath(index))
+		require.NoError(t, err)
+		require.NotNil(t, share.Key)
+		assert.True(t, share.Key.PublicKey.Equal(publicKey))
+		assert.NotEqual(t, 0, share.Key.Secret.Cmp(new(big.Int)))
+	}
+
+	// The key can't be generated again.
+	_, err = Keygen(ctx, cluster.clients)
+	assert.ErrorContains(t, err, "already holds a key share")
+
+	for _, signers := range [][]uint32{{1, 2}, {2, 3}, {1, 3}, {1, 2, 3}} {
+		digest := ethcrypto.Keccak256([]byte("data"), []byte{byte(signers[0]), byte(len(signers))})
+		r, s, err := Sign(ctx, cluster.subset(signers...), digest)
+		require.NoError(t, err)
+		verifySignature(t, publicKey, digest, r, s)
+	}
+
+	// Fewer signers than the threshold are refused.
+	_, _, err = Sign(ctx, cluster.subset(1), ethcrypto.Keccak256([]byte("data")))
+	assert.ErrorContains(t, err, "the number of signers must be between")
+
+	// A participant that is restarted signs with its stored share.
+	participant, err := NewParticipant(cluster.sharePath(3), cluster.config)
+	require.NoError(t, err)
+	info, err := participant.Info(ctx, &signerv1.ThresholdInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, ethcrypto.FromECDSAPub(publicKey), info.PublicKey)
+
+	t.Run("TamperedEnvelope", func(t *testing.T) {
+		// A coordinator that modifies the messages of participant 2 is detected by the other participants.
+		clients := cluster.subset(1, 2)
+		clients[1] = &tamperingClient{ThresholdSignerServiceClient: clients[1], from: 2}
+		_, _, err := Sign(ctx, clients, ethcrypto.Keccak256([]byte("data")))
+		var participantErr *ParticipantError
+		require.ErrorAs(t, err, &participantErr)
+		assert.Equal(t, uint32(2), participantErr.Index)
+		assert.ErrorContains(t, err, "invalid envelope signature")
+	})
+
+	t.Run("SessionRules", func(t *testing.T) {
+		sessionId := []byte("0123456789abcdef")
+		start := func(client signerv1.ThresholdSignerServiceClient, signers []uint32) error {
+			stream, err := client.Session(ctx)
+			require.NoError(t, err)
+			require.NoError(t, stream.Send(&signerv1.ThresholdSessionRequest{Request: &signerv1.ThresholdSessionRequest_Start{Start: &signerv1.ThresholdSessionStart{
+				SessionId: sessionId,
+				Session:   &signerv1.ThresholdSessionStart_Sign{Sign: &signerv1.ThresholdSignStart{Digest: make([]byte, 32), Signers: signers}},
+			}}}))
+			_, err = stream.Recv()
+			return err
+		}
+
+		require.NoError(t, start(cluster.clients[1], []uint32{1, 2}))
+
+		// A session id can't be reused.
+		assert.ErrorContains(t, start(cluster.clients[1], []uint32{1, 2}), "already used")
+
+		// The participant must be one of the signers.
+		sessionId = []byte("fedcba9876543210")
+		assert.ErrorContains(t, start(cluster.clients[1], []uint32{2, 3}), "not one of the signers")
+	})
+}
+
+// tamperingClient flips a bit in the payload of the envelopes of a participant that are relayed to the client.
+type tamperingClient struct {
+	signerv1.ThresholdSignerServiceClient
+	from uint32
+}
+
+func (c *tamperingClient) Session(ctx context.Context, opts ...grpc.CallOption) (signerv1.ThresholdSignerService_SessionClient, error) {
+	stream, err := c.ThresholdSignerServiceClient.Session(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &tamperingStream{ThresholdSignerService_SessionClient: stream, from: c.from}, nil
+}
+
+type tamperingStream struct {
+	signerv1.ThresholdSignerService_SessionClient
+	from uint32
+}
+
+func (s *tamperingStream) Send(req *signerv1.ThresholdSessionRequest) error {
+	if env := req.GetEnvelope(); env != nil && env.From == s.from {
+		env.Payload = append([]byte{}, env.Payload...)
+		env.Payload[len(env.Payload)-1] ^= 1
+	}
+	return s.ThresholdSignerService_SessionClient.Send(req)
+}
+
+func TestKeygenTimeoutIsBounded(t *testing.T) {
+	// The coordinator's timeout must leave room for the participants' key generation.
+	assert.Greater(t, KeygenTimeout, SessionTimeout)
+	assert.GreaterOrEqual(t, sessionIdRetention, KeygenTimeout)
+	assert.Less(t, time.Duration(0), SessionTimeout)
+}
@@ -0,0 +1,231 @@
+package guardiansigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/guardiansigner/threshold"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+)
+
+var (
+	// The timeout for a threshold signing session, including all rounds.
+	THRESHOLD_SIGNER_TIMEOUT = time.Second * 15
+	// The time a participant that failed a signing session is only used as a last resort.
+	THRESHOLD_PARTICIPANT_BACKOFF = time.Minute
+)
+
+// ThresholdSigner is a signer that produces signatures with a t-of-n threshold key, by coordinating a signing
+// session among t of the participants (see `guardiand threshold-signer`). The URI is expected to be in the
+// format threshold://<path-to-config>, where the config is written by the key generation ceremony.
+//
+// The signatures are ordinary secp256k1 signatures, which can't be told apart from signatures of a single key.
+// See the threshold package for the security properties of the protocol.
+type ThresholdSigner struct {
+	threshold    int
+	publicKey    ecdsa.PublicKey
+	participants []*thresholdParticipant
+}
+
+// thresholdParticipant is a connection to a participant of a threshold signer.
+type thresholdParticipant struct {
+	index  uint32
+	conn   *grpc.ClientConn
+	client signerv1.ThresholdSignerServiceClient
+
+	mu       sync.Mutex
+	failedAt time.Time
+}
+
+func (p *thresholdParticipant) recentlyFailed() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return !p.failedAt.IsZero() && time.Since(p.failedAt) < THRESHOLD_PARTICIPANT_BACKOFF
+}
+
+func (p *thresholdParticipant) setFailed(failed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if failed {
+		p.failedAt = time.Now()
+	} else {
+		p.failedAt = time.Time{}
+	}
+}
+
+// NewThresholdSigner creates a new ThresholdSigner from the config at configPath. At least threshold participants
+// must be reachable, and every reachable participant must hold a share of the configured key.
+func NewThresholdSigner(ctx context.Context, unsafeDevMode bool, configPath string) (*ThresholdSigner, error) {
+	config, err := threshold.LoadConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := config.ECDSAPublicKey()
+	if err != nil {
+		return nil, err
+	}
+
+	identities, err := config.Identities()
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &ThresholdSigner{
+		threshold: int(config.Threshold),
+		publicKey: *publicKey,
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, THRESHOLD_SIGNER_TIMEOUT)
+	defer cancel()
+
+	reachable := 0
+	for _, p := range config.Participants {
+		conn, err := config.Dial(ctx, p)
+		if err != nil {
+			signer.close()
+			return nil, fmt.Errorf("failed to connect to threshold participant %d: %w", p.Index, err)
+		}
+
+		participant := &thresholdParticipant{
+			index:  p.Index,
+			conn:   conn,
+			client: signerv1.NewThresholdSignerServiceClient(conn),
+		}
+		signer.participants = append(signer.participants, participant)
+
+		info, err := participant.client.Info(timeoutCtx, &signerv1.ThresholdInfoRequest{})
+		if err != nil {
+			// The participant might come up later, in the meantime the other participants are used.
+			participant.setFailed(true)
+			continue
+		}
+
+		// The identity keys authenticate the messages of the participant to the others, so a participant with a
+		// different identity could not take part in a session anyway.
+		identity := identities[p.Index]
+		if info.Index != p.Index || !bytes.Equal(info.SigningKey, identity.SigningKey) || !bytes.Equal(info.EncryptionKey, identity.EncryptionKey[:]) {
+			signer.close()
+			return nil, fmt.Errorf("threshold participant %d has a different identity than the config", p.Index)
+		}
+
+		if info.Threshold != config.Threshold || info.Parties != uint32(len(config.Participants)) { // #nosec G115 -- the number of participants is checked by LoadConfig
+			signer.close()
+			return nil, fmt.Errorf("threshold participant %d holds a share of a %d-of-%d key, which does not match the config", p.Index, info.Threshold, info.Parties)
+		}
+
+		participantKey, err := ethcrypto.UnmarshalPubkey(info.PublicKey)
+		if err != nil || !participantKey.Equal(publicKey) {
+			signer.close()
+			return nil, fmt.Errorf("threshold participant %d holds a share of a different key", p.Index)
+		}
+
+		reachable++
+	}
+
+	if reachable < signer.threshold {
+		signer.close()
+		return nil, fmt.Errorf("only %d threshold participants are reachable, %d are required", reachable, signer.threshold)
+	}
+
+	return signer, nil
+}
+
+func (t *ThresholdSigner) close() {
+	for _, p := range t.participants {
+		p.conn.Close()
+	}
+}
+
+// selectSigners returns threshold participants for a signing session, preferring participants that did not
+// fail recently and participants with lower indexes.
+func (t *ThresholdSigner) selectSigners(exclude map[uint32]bool) map[uint32]signerv1.ThresholdSignerServiceClient {
+	candidates := make([]*thresholdParticipant, 0, len(t.participants))
+	for _, p := range t.participants {
+		if !exclude[p.index] {
+			candidates = append(candidates, p)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return !candidates[i].recentlyFailed() && candidates[j].recentlyFailed()
+	})
+
+	if len(candidates) < t.threshold {
+		return nil
+	}
+
+	signers := make(map[uint32]signerv1.ThresholdSignerServiceClient, t.threshold)
+	for _, p := range candidates[:t.threshold] {
+		signers[p.index] = p.client
+	}
+	return signers
+}
+
+func (t *ThresholdSigner) participant(index uint32) *thresholdParticipant {
+	for _, p := range t.participants {
+		if p.index == index {
+			return p
+		}
+	}
+	return nil
+}
+
+// Sign runs a signing session among threshold participants. If a participant fails, the session is retried
+// without it, as long as enough participants remain.
+func (t *ThresholdSigner) Sign(ctx context.Context, hash []byte) ([]byte, error) {
+	timeoutCtx, cancel := context.WithTimeout(ctx, THRESHOLD_SIGNER_TIMEOUT)
+	defer cancel()
+
+	exclude := map[uint32]bool{}
+	for {
+		signers := t.selectSigners(exclude)
+		if signers == nil {
+			return nil, errors.New("not enough threshold participants are available")
+		}
+
+		r, s, err := threshold.Sign(timeoutCtx, signers, hash)
+		if err == nil {
+			for index := range signers {
+				t.participant(index).setFailed(false)
+			}
+			return recoverableSignature(hash, r, s, t.publicKey)
+		}
+
+		var participantErr *threshold.ParticipantError
+		if !errors.As(err, &participantErr) || timeoutCtx.Err() != nil {
+			return nil, fmt.Errorf("threshold signing failed: %w", err)
+		}
+
+		t.participant(participantErr.Index).setFailed(true)
+		exclude[participantErr.Index] = true
+	}
+}
+
+func (t *ThresholdSigner) PublicKey(ctx context.Context) ecdsa.PublicKey {
+	return t.publicKey
+}
+
+func (t *ThresholdSigner) Verify(ctx context.Context, sig []byte, hash []byte) (bool, error) {
+	// Use ethcrypto to recover the public key
+	recoveredPubKey, err := ethcrypto.SigToPub(hash, sig)
+
+	if err != nil {
+		return false, err
+	}
+
+	return recoveredPubKey.Equal(&t.publicKey), nil
+}
+
+// Return the signer type as "threshold".
+func (t *ThresholdSigner) TypeAsString() string {
+	return "threshold"
+}
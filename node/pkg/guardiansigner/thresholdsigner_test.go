@@ -0,0 +1,226 @@
+package guardiansigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/guardiansigner/threshold"
+	signerv1 "github.com/certusone/wormhole/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// When set, the test binary runs as a threshold participant instead of running the tests.
+	thresholdParticipantShareEnv  = "GUARDIANSIGNER_TEST_THRESHOLD_SHARE"
+	thresholdParticipantConfigEnv = "GUARDIANSIGNER_TEST_THRESHOLD_CONFIG"
+	thresholdParticipantSocketEnv = "GUARDIANSIGNER_TEST_THRESHOLD_SOCKET"
+)
+
+func TestMain(m *testing.M) {
+	if sharePath := os.Getenv(thresholdParticipantShareEnv); sharePath != "" {
+		os.Exit(runThresholdParticipant(sharePath, os.Getenv(thresholdParticipantConfigEnv), os.Getenv(thresholdParticipantSocketEnv)))
+	}
+	os.Exit(m.Run())
+}
+
+func runThresholdParticipant(sharePath string, configPath string, socketPath string) int {
+	config, err := threshold.LoadConfig(configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	participant, err := threshold.NewParticipant(sharePath, config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM)
+	defer cancel()
+
+	if err := threshold.Serve(ctx, participant, "unix://"+socketPath, nil); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
+
+// startThresholdParticipants starts a participant process for each of the initialized shares in the testdata of
+// the threshold package, and runs the key generation among them. The pre-parameters of the shares take minutes to
+// generate, so they are not created here. It returns the threshold signer config and the participant processes,
+// keyed by index.
+func startThresholdParticipants(t *testing.T, thresh uint32, parties uint32) (*threshold.Config, map[uint32]*exec.Cmd) {
+	// UNIX socket paths are limited in length, so a short directory is used instead of t.TempDir().
+	dir, err := os.MkdirTemp("", "threshold")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	config := &threshold.Config{Threshold: thresh}
+	for index := uint32(1); index <= parties; index++ {
+		b, err := os.ReadFile(fmt.Sprintf("threshold/testdata/participant-%d.json", index))
+		require.NoError(t, err)
+		sharePath := filepath.Join(dir, fmt.Sprintf("share-%d.json", index))
+		require.NoError(t, os.WriteFile(sharePath, b, 0600))
+
+		share, err := threshold.LoadShare(sharePath)
+		require.NoError(t, err)
+		identity := share.Identity.Public()
+		config.Participants = append(config.Participants, threshold.ConfigParticipant{
+			Index:         index,
+			Address:       "unix://" + filepath.Join(dir, fmt.Sprintf("%d.sock", index)),
+			SigningKey:    hex.EncodeToString(identity.SigningKey),
+			EncryptionKey: hex.EncodeToString(identity.EncryptionKey[:]),
+		})
+	}
+
+	configPath := filepath.Join(dir, "participants.json")
+	require.NoError(t, config.SaveConfig(configPath))
+
+	processes := make(map[uint32]*exec.Cmd, parties)
+	clients := make(map[uint32]signerv1.ThresholdSignerServiceClient, parties)
+	for _, p := range config.Participants {
+		socketPath := strings.TrimPrefix(p.Address, "unix://")
+
+		// #nosec G204 -- the test binary re-executes itself
+		cmd := exec.Command(os.Args[0], "-test.run=^$")
+		cmd.Env = append(os.Environ(),
+			thresholdParticipantShareEnv+"="+filepath.Join(dir, fmt.Sprintf("share-%d.json", p.Index)),
+			thresholdParticipantConfigEnv+"="+configPath,
+			thresholdParticipantSocketEnv+"="+socketPath,
+		)
+		cmd.Stderr = os.Stderr
+		require.NoError(t, cmd.Start())
+		t.Cleanup(func() {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+		})
+		processes[p.Index] = cmd
+
+		require.Eventually(t, func() bool {
+			_, err := os.Stat(socketPath)
+			return err == nil
+		}, 10*time.Second, 10*time.Millisecond)
+
+		conn, err := config.Dial(context.Background(), p)
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		clients[p.Index] = signerv1.NewThresholdSignerServiceClient(conn)
+	}
+
+	publicKey, err := threshold.Keygen(context.Background(), clients)
+	require.NoError(t, err)
+	config.PublicKey = hex.EncodeToString(ethcrypto.FromECDSAPub(publicKey))
+
+	return config, processes
+}
+
+// saveThresholdConfig writes config to a new file, and returns its path.
+func saveThresholdConfig(t *testing.T, config *threshold.Config) string {
+	configPath := filepath.Join(t.TempDir(), "threshold.json")
+	require.NoError(t, config.SaveConfig(configPath))
+	return configPath
+}
+
+func TestThresholdSigner(t *testing.T) {
+	config, processes := startThresholdParticipants(t, 2, 3)
+	publicKey, err := config.ECDSAPublicKey()
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("ForeignShares", func(t *testing.T) {
+		// Point the config at a different key than the one the participants hold shares of.
+		otherKey, err := ecdsa.GenerateKey(ethcrypto.S256(), rand.Reader)
+		require.NoError(t, err)
+		foreign := *config
+		foreign.PublicKey = hex.EncodeToString(ethcrypto.FromECDSAPub(&otherKey.PublicKey))
+
+		_, err = NewThresholdSigner(ctx, false, saveThresholdConfig(t, &foreign))
+		require.ErrorContains(t, err, "different key")
+	})
+
+	t.Run("ForeignIdentities", func(t *testing.T) {
+		// Swap the identities of two participants in the config.
+		foreign := *config
+		foreign.Participants = append([]threshold.ConfigParticipant{}, config.Participants...)
+		p1, p2 := &foreign.Participants[0], &foreign.Participants[1]
+		p1.SigningKey, p2.SigningKey = p2.SigningKey, p1.SigningKey
+		p1.EncryptionKey, p2.EncryptionKey = p2.EncryptionKey, p1.EncryptionKey
+
+		_, err := NewThresholdSigner(ctx, false, saveThresholdConfig(t, &foreign))
+		require.ErrorContains(t, err, "different identity")
+	})
+
+	signer, err := NewGuardianSignerFromUri(ctx, "threshold://"+saveThresholdConfig(t, config), false)
+	require.NoError(t, err)
+	assert.True(t, publicKey.Equal(ptr(signer.PublicKey(ctx))))
+
+	sign := func() {
+		digest := ethcrypto.Keccak256([]byte(fmt.Sprintf("threshold %d", time.Now().UnixNano())))
+		sig, err := signer.Sign(ctx, digest)
+		require.NoError(t, err)
+
+		valid, err := signer.Verify(ctx, sig, digest)
+		require.NoError(t, err)
+		assert.True(t, valid)
+
+		recovered, err := ethcrypto.SigToPub(digest, sig)
+		require.NoError(t, err)
+		assert.Equal(t, ethcrypto.PubkeyToAddress(*publicKey), ethcrypto.PubkeyToAddress(*recovered))
+	}
+
+	sign()
+
+	// Signing fails over to the remaining participants when one of them goes away.
+	require.NoError(t, processes[1].Process.Kill())
+	_ = processes[1].Wait()
+	sign()
+	sign()
+
+	// With fewer than threshold participants, signing fails.
+	require.NoError(t, processes[2].Process.Kill())
+	_ = processes[2].Wait()
+	digest := ethcrypto.Keccak256([]byte("not enough participants"))
+	_, err = signer.Sign(ctx, digest)
+	require.Error(t, err)
+}
+
+func TestThresholdSignerRequiresPublicKey(t *testing.T) {
+	share, err := threshold.LoadShare("threshold/testdata/participant-1.json")
+	require.NoError(t, err)
+	other, err := threshold.LoadShare("threshold/testdata/participant-2.json")
+	require.NoError(t, err)
+
+	// A config that was not completed by the key generation can't be used for signing.
+	config := &threshold.Config{Threshold: 2}
+	for i, s := range []*threshold.Share{share, other} {
+		identity := s.Identity.Public()
+		config.Participants = append(config.Participants, threshold.ConfigParticipant{
+			Index:         uint32(i + 1), // #nosec G115 -- two participants
+			Address:       "unix:///nonexistent.sock",
+			SigningKey:    hex.EncodeToString(identity.SigningKey),
+			EncryptionKey: hex.EncodeToString(identity.EncryptionKey[:]),
+		})
+	}
+
+	_, err = NewThresholdSigner(context.Background(), false, saveThresholdConfig(t, config))
+	require.ErrorContains(t, err, "key generation ceremony has not completed")
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
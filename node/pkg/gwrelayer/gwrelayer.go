@@ -7,11 +7,14 @@ import (
 	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
@@ -32,6 +35,7 @@ type (
 		SenderAddress() string
 		SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error)
 		SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error)
+		SignAndBroadcastBatch(ctx context.Context, msgs []sdktypes.Msg) (*sdktx.BroadcastTxResponse, error)
 		BroadcastTxResponseToString(txResp *sdktx.BroadcastTxResponse) string
 	}
 )
@@ -43,11 +47,17 @@ type (
 		logger                      *zap.Logger
 		ibcTranslatorAddress        string
 		wormchainConn               GatewayRelayerWormchainConn
+		db                          db.GatewayRelayerDB
 		env                         common.Environment
-		subChan                     chan *VaaToPublish
 		tokenBridges                tokenBridgeMap
 		tokenBridgeAddress          string
 		ibcTranslatorPayloadAddress vaa.Address
+
+		// queue holds the VAAs waiting to be submitted, keyed by message ID. It is persisted in the database.
+		queueLock sync.Mutex
+		queue     map[string]*queuedVAA
+		// wakeC signals the worker that VAAs were queued.
+		wakeC chan struct{}
 	}
 
 	tokenBridgeMap map[tokenBridgeKey]struct{}
@@ -71,8 +81,8 @@ const (
 	TokenBridge
 )
 
-// subChanSize is the capacity of the submit channel used to publish VAAs.
-const subChanSize = 50
+// maxBatchSize is the maximum number of queued VAAs submitted in a single transaction.
+const maxBatchSize = 10
 
 var (
 	vaasSubmittedToIbcTranslator = promauto.NewCounter(
@@ -90,19 +100,25 @@ var (
 	channelFullErrors = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "gwrelayer_channel_full_errors",
-			Help: "Total number of VAAs dropped because the gateway relayer channel was full",
+			Help: "Total number of VAAs dropped because the gateway relayer queue was full",
 		})
 	submitErrors = promauto.NewCounter(
 		prometheus.CounterOpts{
 			Name: "gwrelayer_submit_errors",
 			Help: "Total number of errors encountered while submitting VAAs",
 		})
+	batchFallbacks = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gwrelayer_batch_fallbacks",
+			Help: "Total number of batches of VAAs that failed and were resubmitted one VAA at a time",
+		})
 )
 
 // NewGatewayRelayer creates a new instance of the GatewayRelayer object.
 func NewGatewayRelayer(
 	ctx context.Context,
 	logger *zap.Logger,
+	gwrDB db.GatewayRelayerDB,
 	ibcTranslatorAddress string,
 	wormchainConn GatewayRelayerWormchainConn,
 	env common.Environment,
@@ -116,8 +132,10 @@ func NewGatewayRelayer(
 		logger:               logger.With(zap.String("component", "gwrelayer")),
 		ibcTranslatorAddress: ibcTranslatorAddress,
 		wormchainConn:        wormchainConn,
+		db:                   gwrDB,
 		env:                  env,
-		subChan:              make(chan *VaaToPublish, subChanSize),
+		queue:                make(map[string]*queuedVAA),
+		wakeC:                make(chan struct{}, 1),
 		// tokenBridgeAddress and tokenBridges are initialized in Start().
 	}
 }
@@ -144,6 +162,10 @@ func (gwr *GatewayRelayer) Start(ctx context.Context) error {
 		zap.String("tokenBridgeAddress", gwr.tokenBridgeAddress),
 	)
 
+	if err := gwr.loadQueue(); err != nil {
+		return fmt.Errorf("failed to load queue: %w", err)
+	}
+
 	// Start the watcher to listen to transfer events from the smart contract.
 	if gwr.env == common.GoTest {
 		// We're not in a runnable context, so we can't use supervisor.
@@ -217,12 +239,26 @@ func convertBech32AddressToWormhole(contractAddress string) (vaa.Address, error)
 	return vaa.Address(addrBytes), nil
 }
 
-// SubmitVAA checks to see if the VAA should be submitted to the smart contract, and if so, writes it to the channel for publishing.
+// SubmitVAA checks to see if the VAA should be submitted to the smart contract, and if so, adds it to the queue for publishing.
 func (gwr *GatewayRelayer) SubmitVAA(v *vaa.VAA) {
-	var v2p VaaToPublish
-	if shouldPub, err := shouldPublishToIbcTranslator(gwr.tokenBridges, v, vaa.ChainIDWormchain, gwr.ibcTranslatorPayloadAddress); err != nil {
+	v2p, err := gwr.vaaToPublish(v)
+	if err != nil {
 		gwr.logger.Error("failed to check if vaa should be published", zap.String("msgId", v.MessageID()), zap.Error(err))
 		return
+	}
+	if v2p == nil {
+		gwr.logger.Debug("not relaying vaa", zap.String("msgId", v.MessageID()))
+		return
+	}
+
+	_ = gwr.enqueue(v2p)
+}
+
+// vaaToPublish determines the contract a VAA should be submitted to. It returns nil if the VAA is not relayed.
+func (gwr *GatewayRelayer) vaaToPublish(v *vaa.VAA) (*VaaToPublish, error) {
+	v2p := &VaaToPublish{V: v}
+	if shouldPub, err := shouldPublishToIbcTranslator(gwr.tokenBridges, v, vaa.ChainIDWormchain, gwr.ibcTranslatorPayloadAddress); err != nil {
+		return nil, err
 	} else if shouldPub {
 		v2p.VType = IbcTranslator
 		v2p.ContractAddress = gwr.ibcTranslatorAddress
@@ -230,19 +266,9 @@ func (gwr *GatewayRelayer) SubmitVAA(v *vaa.VAA) {
 		v2p.VType = TokenBridge
 		v2p.ContractAddress = gwr.tokenBridgeAddress
 	} else {
-		gwr.logger.Debug("not relaying vaa", zap.String("msgId", v.MessageID()))
-		return
-	}
-
-	v2p.V = v
-
-	select {
-	case gwr.subChan <- &v2p:
-		gwr.logger.Debug("submitted vaa to channel", zap.String("msgId", v.MessageID()), zap.String("contract", v2p.ContractAddress), zap.Uint8("vaaType", uint8(v2p.VType)))
-	default:
-		channelFullErrors.Inc()
-		gwr.logger.Error("unable to submit vaa because the channel is full, dropping it", zap.String("msgId", v.MessageID()), zap.String("contract", v2p.ContractAddress), zap.Uint8("vaaType", uint8(v2p.VType)))
+		return nil, nil
 	}
+	return v2p, nil
 }
 
 // shouldPublishToIbcTranslator returns true if a message should be forwarded to the contract on wormchain, false if not.
@@ -289,37 +315,64 @@ func shouldPublishToTokenBridge(tokenBridges tokenBridgeMap, v *vaa.VAA) bool {
 	return true
 }
 
-// worker listens for VAAs and submits them to the smart contract.
+// worker submits the queued VAAs to the smart contract when they are due.
 func (gwr *GatewayRelayer) worker(ctx context.Context) error {
+	ticker := time.NewTicker(queueCheckInterval)
+	defer ticker.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return nil
-		case v2p := <-gwr.subChan:
-			if err := gwr.submitVAAToContract(v2p); err != nil {
-				vaaBytes, marshalErr := v2p.V.Marshal()
-				if marshalErr != nil {
-					gwr.logger.Error("failed to submit vaa to contract",
-						zap.String("msgId", v2p.V.MessageID()),
-						zap.String("contract", v2p.ContractAddress),
-						zap.Uint8("vaaType", uint8(v2p.VType)),
-						zap.Error(err),
-						zap.Any("vaa", v2p.V),
-					)
-				} else {
-					gwr.logger.Error("failed to submit vaa to contract",
-						zap.String("msgId", v2p.V.MessageID()),
-						zap.String("contract", v2p.ContractAddress),
-						zap.Uint8("vaaType", uint8(v2p.VType)),
-						zap.Error(err),
-						zap.String("vaa", hex.EncodeToString(vaaBytes)),
-					)
-				}
-				// TODO: For now we don't want to restart because this will happen if the VAA has already been submitted by another guardian.
-				//return fmt.Errorf("failed to submit vaa to contract: %w", err)
-			}
+		case <-gwr.wakeC:
+			gwr.processQueue(ctx, time.Now())
+		case <-ticker.C:
+			gwr.processQueue(ctx, time.Now())
+		}
+	}
+}
+
+// submitBatchToContract submits a batch of VAAs to the smart contracts on wormchain in a single transaction.
+func (gwr *GatewayRelayer) submitBatchToContract(batch []*VaaToPublish) error {
+	msgs := make([]sdktypes.Msg, 0, len(batch))
+	for _, v2p := range batch {
+		msg, err := buildSubmitMsg(gwr.wormchainConn, v2p)
+		if err != nil {
+			return fmt.Errorf("failed to build message for %s: %w", v2p.V.MessageID(), err)
+		}
+		msgs = append(msgs, msg)
+	}
+
+	start := time.Now()
+	txResp, err := gwr.wormchainConn.SignAndBroadcastBatch(gwr.ctx, msgs)
+	if err != nil {
+		return fmt.Errorf("failed to send broadcast: %w", err)
+	}
+
+	if txResp == nil || txResp.TxResponse == nil {
+		return fmt.Errorf("sent broadcast but returned txResp is incomplete")
+	}
+
+	if txResp.TxResponse.Code != 0 {
+		return fmt.Errorf("submit failed: %s", txResp.TxResponse.RawLog)
+	}
+
+	for _, v2p := range batch {
+		if v2p.VType == IbcTranslator {
+			vaasSubmittedToIbcTranslator.Inc()
+		} else {
+			vaasSubmittedToTokenBridge.Inc()
 		}
 	}
+
+	gwr.logger.Info("done sending batch broadcast",
+		zap.Int("numVaas", len(batch)),
+		zap.Int64("gasUsed", txResp.TxResponse.GasUsed),
+		zap.Stringer("elapsedTime", time.Since(start)),
+		zap.String("txHash", txResp.TxResponse.TxHash),
+	)
+
+	return nil
 }
 
 // submitVAAToContract submits a VAA to the smart contract on wormchain.
@@ -358,6 +411,10 @@ type (
 	}
 )
 
+// ErrSubmitRejected is returned by SubmitVAAToContract if the contract rejected the VAA, as opposed to the submission
+// failing for a transient reason.
+var ErrSubmitRejected = errors.New("rejected by the contract")
+
 // SubmitVAAToContract submits a VAA to the smart contract on wormchain.
 func SubmitVAAToContract(
 	ctx context.Context,
@@ -367,43 +424,13 @@ func SubmitVAAToContract(
 ) (*sdktx.BroadcastTxResponse, error) {
 	logger.Info("submitting VAA to contract", zap.String("message_id", v2p.V.MessageID()), zap.String("contract", v2p.ContractAddress), zap.Uint8("vaaType", uint8(v2p.VType)))
 
-	vaaBytes, err := v2p.V.Marshal()
+	subMsg, err := buildSubmitMsg(wormchainConn, v2p)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal vaa: %w", err)
-	}
-
-	var msgBytes []byte
-
-	if v2p.VType == IbcTranslator {
-		msgData := completeTransferAndConvertMsg{
-			Params: completeTransferAndConvertParams{
-				VAA: vaaBytes,
-			},
-		}
-		msgBytes, err = json.Marshal(msgData)
-	} else if v2p.VType == TokenBridge {
-		msgData := submitVAA{
-			Params: submitVAAParams{
-				Data: vaaBytes,
-			},
-		}
-		msgBytes, err = json.Marshal(msgData)
-	} else {
-		return nil, fmt.Errorf("invalid vtype: %d", uint8(v2p.VType))
-	}
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	subMsg := wasmdtypes.MsgExecuteContract{
-		Sender:   wormchainConn.SenderAddress(),
-		Contract: v2p.ContractAddress,
-		Msg:      msgBytes,
-		Funds:    sdktypes.Coins{},
+		return nil, err
 	}
 
 	start := time.Now()
-	txResp, err := wormchainConn.SignAndBroadcastTx(ctx, &subMsg)
+	txResp, err := wormchainConn.SignAndBroadcastTx(ctx, subMsg)
 	if err != nil {
 		return txResp, fmt.Errorf("failed to send broadcast: %w", err)
 	}
@@ -425,7 +452,7 @@ func SubmitVAAToContract(
 	}
 
 	if strings.Contains(txResp.TxResponse.RawLog, "failed") && !canIgnoreFailure(txResp.TxResponse.RawLog) {
-		return txResp, fmt.Errorf("submit failed: %s", txResp.TxResponse.RawLog)
+		return txResp, fmt.Errorf("submit failed: %w: %s", ErrSubmitRejected, txResp.TxResponse.RawLog)
 	}
 
 	logger.Info("done sending broadcast",
@@ -442,6 +469,44 @@ func SubmitVAAToContract(
 	return txResp, nil
 }
 
+// buildSubmitMsg builds the message that submits a VAA to the smart contract on wormchain.
+func buildSubmitMsg(wormchainConn GatewayRelayerWormchainConn, v2p *VaaToPublish) (*wasmdtypes.MsgExecuteContract, error) {
+	vaaBytes, err := v2p.V.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal vaa: %w", err)
+	}
+
+	var msgBytes []byte
+
+	if v2p.VType == IbcTranslator {
+		msgData := completeTransferAndConvertMsg{
+			Params: completeTransferAndConvertParams{
+				VAA: vaaBytes,
+			},
+		}
+		msgBytes, err = json.Marshal(msgData)
+	} else if v2p.VType == TokenBridge {
+		msgData := submitVAA{
+			Params: submitVAAParams{
+				Data: vaaBytes,
+			},
+		}
+		msgBytes, err = json.Marshal(msgData)
+	} else {
+		return nil, fmt.Errorf("invalid vtype: %d", uint8(v2p.VType))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	return &wasmdtypes.MsgExecuteContract{
+		Sender:   wormchainConn.SenderAddress(),
+		Contract: v2p.ContractAddress,
+		Msg:      msgBytes,
+		Funds:    sdktypes.Coins{},
+	}, nil
+}
+
 // canIgnoreFailure checks for returns from the contract that aren't really errors.
 func canIgnoreFailure(rawLog string) bool {
 	return strings.Contains(rawLog, "VaaAlreadyExecuted") || strings.Contains(rawLog, "this asset has already been attested")
@@ -2,7 +2,11 @@ package gwrelayer
 
 import (
 	"bytes"
+	"context"
 	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -10,10 +14,13 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/wormhole-foundation/wormhole/sdk"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
+	wasmdtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"go.uber.org/zap"
 )
 
@@ -186,3 +193,274 @@ func Test_canIgnoreFailure(t *testing.T) {
 	assert.True(t, canIgnoreFailure("failed to execute message; message index: 0: Generic error: this asset has already been attested: execute wasm contract failed"))
 	assert.False(t, canIgnoreFailure("failed to execute message; message index: 0: Generic error: some other failure: execute wasm contract failed"))
 }
+
+// mockWormchainConn simulates the token bridge contract on wormchain. VAAs are identified by their sequence.
+type mockWormchainConn struct {
+	mutex      sync.Mutex
+	batchSizes []int
+	singles    []uint64
+	// batchCode is the result code of batch transactions.
+	batchCode uint32
+	// singleResult returns the result of submitting a single VAA.
+	singleResult func(seq uint64) (*sdktx.BroadcastTxResponse, error)
+	redeemed     map[uint64]bool
+}
+
+func (c *mockWormchainConn) Close() {}
+
+func (c *mockWormchainConn) SenderAddress() string {
+	return "wormhole1sender"
+}
+
+func sequenceOf(t require.TestingT, vaaBytes []byte) uint64 {
+	v, err := vaa.Unmarshal(vaaBytes)
+	require.NoError(t, err)
+	return v.Sequence
+}
+
+func (c *mockWormchainConn) SubmitQuery(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	var q struct {
+		IsVaaRedeemed struct {
+			VAA []byte `json:"vaa"`
+		} `json:"is_vaa_redeemed"`
+	}
+	if err := json.Unmarshal(query, &q); err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	v, err := vaa.Unmarshal(q.IsVaaRedeemed.VAA)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(map[string]bool{"is_redeemed": c.redeemed[v.Sequence]})
+}
+
+func (c *mockWormchainConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	var m submitVAA
+	if err := json.Unmarshal(msg.(*wasmdtypes.MsgExecuteContract).Msg, &m); err != nil {
+		return nil, err
+	}
+	v, err := vaa.Unmarshal(m.Params.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.singles = append(c.singles, v.Sequence)
+	if c.singleResult != nil {
+		return c.singleResult(v.Sequence)
+	}
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{RawLog: "[]"}}, nil
+}
+
+func (c *mockWormchainConn) SignAndBroadcastBatch(ctx context.Context, msgs []sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.batchSizes = append(c.batchSizes, len(msgs))
+	return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{Code: c.batchCode, RawLog: "failed to execute message: VaaAlreadyExecuted"}}, nil
+}
+
+func (c *mockWormchainConn) BroadcastTxResponseToString(txResp *sdktx.BroadcastTxResponse) string {
+	return ""
+}
+
+func (c *mockWormchainConn) counts() ([]int, []uint64) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return append([]int{}, c.batchSizes...), append([]uint64{}, c.singles...)
+}
+
+// newTestGatewayRelayer creates a gateway relayer for devnet with a database in a temporary directory.
+func newTestGatewayRelayer(t *testing.T, conn *mockWormchainConn, database *db.Database) *GatewayRelayer {
+	t.Helper()
+	gwr := NewGatewayRelayer(context.Background(), zap.NewNop(), database, "wormhole1gwrelayer", conn, common.GoTest)
+	require.NotNil(t, gwr)
+
+	var err error
+	gwr.tokenBridges, gwr.tokenBridgeAddress, err = buildTokenBridgeMap(gwr.logger, gwr.env)
+	require.NoError(t, err)
+	require.NoError(t, gwr.loadQueue())
+	return gwr
+}
+
+func openTestDB(t *testing.T) *db.Database {
+	t.Helper()
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	t.Cleanup(func() { _ = database.Close() })
+	return database
+}
+
+// attestationVAA creates an asset meta VAA from the devnet Ethereum token bridge, which is submitted to the token bridge.
+func attestationVAA(t *testing.T, seq uint64) *vaa.VAA {
+	t.Helper()
+	emitterAddr, err := vaa.BytesToAddress(sdk.KnownDevnetTokenbridgeEmitters[vaa.ChainIDEthereum])
+	require.NoError(t, err)
+	return &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Nonce:            1,
+		Sequence:         seq,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddr,
+		Payload:          []byte{2, 0, 0, 0},
+	}
+}
+
+func queuedSequences(t *testing.T, gwr *GatewayRelayer) []uint64 {
+	t.Helper()
+	seqs := []uint64{}
+	for _, entry := range gwr.QueueStatus() {
+		seqs = append(seqs, sequenceOf(t, entry.VAA))
+	}
+	return seqs
+}
+
+func Test_queueSubmitsDueVAAsInBatch(t *testing.T) {
+	conn := &mockWormchainConn{}
+	database := openTestDB(t)
+	gwr := newTestGatewayRelayer(t, conn, database)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		gwr.SubmitVAA(attestationVAA(t, seq))
+	}
+	assert.Equal(t, []uint64{1, 2, 3}, queuedSequences(t, gwr))
+
+	gwr.processQueue(context.Background(), time.Now())
+
+	batches, singles := conn.counts()
+	assert.Equal(t, []int{3}, batches)
+	assert.Empty(t, singles)
+	assert.Empty(t, gwr.QueueStatus())
+
+	entries, err := database.GatewayRelayerGetQueue(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func Test_queueRetriesTransientFailuresAndKeepsRejectedVAAs(t *testing.T) {
+	conn := &mockWormchainConn{
+		batchCode: 5,
+		singleResult: func(seq uint64) (*sdktx.BroadcastTxResponse, error) {
+			switch seq {
+			case 1:
+				return nil, errors.New("connection refused")
+			case 2:
+				return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{Code: 5, RawLog: "failed to execute message: invalid vaa"}}, nil
+			default:
+				return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{RawLog: "[]"}}, nil
+			}
+		},
+	}
+	database := openTestDB(t)
+	gwr := newTestGatewayRelayer(t, conn, database)
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		gwr.SubmitVAA(attestationVAA(t, seq))
+	}
+
+	now := time.Now()
+	gwr.processQueue(context.Background(), now)
+
+	batches, singles := conn.counts()
+	assert.Equal(t, []int{3}, batches)
+	assert.Equal(t, []uint64{1, 2, 3}, singles)
+
+	entries := gwr.QueueStatus()
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(1), sequenceOf(t, entries[0].VAA))
+	assert.Equal(t, 1, entries[0].Attempts)
+	assert.False(t, entries[0].Failed)
+	assert.True(t, now.Add(initialRetryDelay).Equal(entries[0].NextAttempt))
+	assert.Contains(t, entries[0].LastError, "connection refused")
+
+	assert.Equal(t, uint64(2), sequenceOf(t, entries[1].VAA))
+	assert.True(t, entries[1].Failed)
+	assert.Contains(t, entries[1].LastError, "invalid vaa")
+
+	// Nothing is due until the backoff expired.
+	gwr.processQueue(context.Background(), now.Add(time.Second))
+	_, singles = conn.counts()
+	assert.Len(t, singles, 3)
+
+	// The failed VAA is not retried, the other one is.
+	gwr.processQueue(context.Background(), now.Add(initialRetryDelay))
+	_, singles = conn.counts()
+	assert.Equal(t, []uint64{1, 2, 3, 1}, singles)
+
+	// The queue survives a restart.
+	restarted := newTestGatewayRelayer(t, conn, database)
+	restored := restarted.QueueStatus()
+	entries = gwr.QueueStatus()
+	require.Len(t, restored, len(entries))
+	for i := range entries {
+		assert.Equal(t, entries[i].MessageID, restored[i].MessageID)
+		assert.Equal(t, entries[i].VAA, restored[i].VAA)
+		assert.Equal(t, entries[i].Attempts, restored[i].Attempts)
+		assert.Equal(t, entries[i].Failed, restored[i].Failed)
+		assert.True(t, entries[i].NextAttempt.Equal(restored[i].NextAttempt))
+	}
+}
+
+func Test_queueDropsRedeemedVAAs(t *testing.T) {
+	conn := &mockWormchainConn{redeemed: map[uint64]bool{2: true}}
+	gwr := newTestGatewayRelayer(t, conn, openTestDB(t))
+
+	gwr.SubmitVAA(attestationVAA(t, 1))
+	gwr.SubmitVAA(attestationVAA(t, 2))
+	gwr.processQueue(context.Background(), time.Now())
+
+	batches, singles := conn.counts()
+	assert.Empty(t, batches)
+	assert.Equal(t, []uint64{1}, singles)
+	assert.Empty(t, gwr.QueueStatus())
+}
+
+func Test_queueRetryReplayAndDrop(t *testing.T) {
+	conn := &mockWormchainConn{
+		singleResult: func(seq uint64) (*sdktx.BroadcastTxResponse, error) {
+			return &sdktx.BroadcastTxResponse{TxResponse: &sdktypes.TxResponse{Code: 5, RawLog: "failed to execute message: invalid vaa"}}, nil
+		},
+	}
+	gwr := newTestGatewayRelayer(t, conn, openTestDB(t))
+
+	v := attestationVAA(t, 1)
+	gwr.SubmitVAA(v)
+	gwr.processQueue(context.Background(), time.Now())
+	entries := gwr.QueueStatus()
+	require.Len(t, entries, 1)
+	require.True(t, entries[0].Failed)
+
+	require.NoError(t, gwr.Retry(v.MessageID()))
+	entries = gwr.QueueStatus()
+	require.Len(t, entries, 1)
+	assert.False(t, entries[0].Failed)
+	assert.Equal(t, 0, entries[0].Attempts)
+	assert.Empty(t, entries[0].LastError)
+
+	require.NoError(t, gwr.Drop(v.MessageID()))
+	assert.Empty(t, gwr.QueueStatus())
+	assert.ErrorIs(t, gwr.Drop(v.MessageID()), ErrQueueEntryNotFound)
+	assert.ErrorIs(t, gwr.Retry(v.MessageID()), ErrQueueEntryNotFound)
+
+	require.NoError(t, gwr.Replay(v))
+	assert.Equal(t, []uint64{1}, queuedSequences(t, gwr))
+
+	// A transfer to another chain is not relayed.
+	notRelayed := attestationVAA(t, 2)
+	notRelayed.Payload = []byte{1, 0, 0, 0}
+	assert.ErrorIs(t, gwr.Replay(notRelayed), ErrNotRelayed)
+}
+
+func Test_retryDelay(t *testing.T) {
+	assert.Equal(t, time.Duration(0), retryDelay(0))
+	assert.Equal(t, initialRetryDelay, retryDelay(1))
+	assert.Equal(t, 2*initialRetryDelay, retryDelay(2))
+	assert.Equal(t, 4*initialRetryDelay, retryDelay(3))
+	assert.Equal(t, maxRetryDelay, retryDelay(20))
+	assert.Equal(t, maxRetryDelay, retryDelay(maxAttempts))
+}
@@ -0,0 +1,437 @@
+package gwrelayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxQueueSize bounds the number of VAAs waiting to be submitted, including the failed ones.
+	maxQueueSize = 10_000
+
+	// queueCheckInterval is how often the queue is checked for VAAs that are due for a retry.
+	queueCheckInterval = time.Second
+
+	// initialRetryDelay and maxRetryDelay bound the exponential backoff between submission attempts.
+	initialRetryDelay = 5 * time.Second
+	maxRetryDelay     = 10 * time.Minute
+
+	// maxAttempts is the number of failed submissions after which a VAA is no longer retried until it is replayed.
+	maxAttempts = 30
+)
+
+var (
+	// ErrQueueEntryNotFound is returned if a VAA is not in the gateway relayer queue.
+	ErrQueueEntryNotFound = errors.New("VAA is not in the gateway relayer queue")
+
+	// ErrQueueFull is returned if a VAA can't be queued because the queue is full.
+	ErrQueueFull = errors.New("the gateway relayer queue is full")
+
+	// ErrNotRelayed is returned when replaying a VAA that the gateway relayer does not submit to wormchain.
+	ErrNotRelayed = errors.New("VAA is not relayed by the gateway relayer")
+)
+
+var (
+	queueSize = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gwrelayer_queue_size",
+			Help: "Number of VAAs waiting to be submitted by the gateway relayer, including the failed ones",
+		})
+	queueFailed = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gwrelayer_queue_failed",
+			Help: "Number of VAAs in the gateway relayer queue whose submission failed and that are not retried",
+		})
+	submitRetries = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gwrelayer_submit_retries",
+			Help: "Total number of failed submissions that are retried by the gateway relayer",
+		})
+	vaasAlreadyRedeemed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gwrelayer_vaas_already_redeemed",
+			Help: "Total number of queued VAAs that were dropped because they were already redeemed on wormchain",
+		})
+)
+
+// queuedVAA is a VAA in the queue along with its persisted state.
+type queuedVAA struct {
+	v2p   *VaaToPublish
+	entry *db.GatewayRelayerQueueEntry
+}
+
+// VaaTypeString returns the name of a VaaType as used by the admin RPC.
+func VaaTypeString(vType VaaType) string {
+	switch vType {
+	case IbcTranslator:
+		return "ibc_translator"
+	case TokenBridge:
+		return "token_bridge"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(vType))
+	}
+}
+
+// retryDelay returns the delay before the next submission after the given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	if attempts <= 0 {
+		return 0
+	}
+	delay := initialRetryDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxRetryDelay {
+			return maxRetryDelay
+		}
+	}
+	return delay
+}
+
+// loadQueue reloads the VAAs that weren't submitted before the guardian restarted.
+func (gwr *GatewayRelayer) loadQueue() error {
+	entries, err := gwr.db.GatewayRelayerGetQueue(gwr.logger)
+	if err != nil {
+		return err
+	}
+
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	for _, entry := range entries {
+		v, err := vaa.Unmarshal(entry.VAA)
+		if err != nil {
+			gwr.logger.Error("failed to unmarshal queued vaa, dropping it", zap.String("msgId", entry.MessageID), zap.Error(err))
+			if err := gwr.db.GatewayRelayerDeleteEntry(entry.MessageID); err != nil {
+				gwr.logger.Error("failed to delete queued vaa", zap.String("msgId", entry.MessageID), zap.Error(err))
+			}
+			continue
+		}
+
+		gwr.queue[entry.MessageID] = &queuedVAA{
+			v2p:   &VaaToPublish{V: v, ContractAddress: entry.ContractAddress, VType: VaaType(entry.VaaType)},
+			entry: entry,
+		}
+	}
+
+	gwr.updateQueueMetricsLocked()
+	gwr.logger.Info("loaded gateway relayer queue", zap.Int("numVaas", len(gwr.queue)))
+	return nil
+}
+
+// enqueue persists a VAA and adds it to the queue. It is a no-op if the VAA is already queued.
+func (gwr *GatewayRelayer) enqueue(v2p *VaaToPublish) error {
+	msgId := v2p.V.MessageID()
+	vaaBytes, err := v2p.V.Marshal()
+	if err != nil {
+		gwr.logger.Error("failed to marshal vaa, dropping it", zap.String("msgId", msgId), zap.Error(err))
+		return fmt.Errorf("failed to marshal vaa: %w", err)
+	}
+
+	gwr.queueLock.Lock()
+	if _, exists := gwr.queue[msgId]; exists {
+		gwr.queueLock.Unlock()
+		return nil
+	}
+
+	if len(gwr.queue) >= maxQueueSize {
+		gwr.queueLock.Unlock()
+		channelFullErrors.Inc()
+		gwr.logger.Error("unable to submit vaa because the queue is full, dropping it", zap.String("msgId", msgId), zap.String("contract", v2p.ContractAddress), zap.Uint8("vaaType", uint8(v2p.VType)))
+		return ErrQueueFull
+	}
+
+	now := time.Now()
+	entry := &db.GatewayRelayerQueueEntry{
+		MessageID:       msgId,
+		VAA:             vaaBytes,
+		ContractAddress: v2p.ContractAddress,
+		VaaType:         uint8(v2p.VType),
+		EnqueuedAt:      now,
+		NextAttempt:     now,
+	}
+	if err := gwr.db.GatewayRelayerStoreEntry(entry); err != nil {
+		// Keep it in memory anyway, it just won't survive a restart.
+		gwr.logger.Error("failed to persist queued vaa", zap.String("msgId", msgId), zap.Error(err))
+	}
+	gwr.queue[msgId] = &queuedVAA{v2p: v2p, entry: entry}
+	gwr.updateQueueMetricsLocked()
+	gwr.queueLock.Unlock()
+
+	gwr.logger.Debug("queued vaa", zap.String("msgId", msgId), zap.String("contract", v2p.ContractAddress), zap.Uint8("vaaType", uint8(v2p.VType)))
+	gwr.wake()
+	return nil
+}
+
+// wake signals the worker that VAAs may be due, without blocking.
+func (gwr *GatewayRelayer) wake() {
+	select {
+	case gwr.wakeC <- struct{}{}:
+	default:
+	}
+}
+
+// dueVAAs returns up to maxBatchSize VAAs that are due for submission, oldest first.
+func (gwr *GatewayRelayer) dueVAAs(now time.Time) []*queuedVAA {
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	due := []*queuedVAA{}
+	for _, q := range gwr.queue {
+		if !q.entry.Failed && !q.entry.NextAttempt.After(now) {
+			due = append(due, q)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		if !due[i].entry.EnqueuedAt.Equal(due[j].entry.EnqueuedAt) {
+			return due[i].entry.EnqueuedAt.Before(due[j].entry.EnqueuedAt)
+		}
+		return due[i].entry.MessageID < due[j].entry.MessageID
+	})
+
+	if len(due) > maxBatchSize {
+		due = due[:maxBatchSize]
+	}
+	return due
+}
+
+// processQueue submits the VAAs that are due, skipping the ones that were already redeemed on wormchain.
+func (gwr *GatewayRelayer) processQueue(ctx context.Context, now time.Time) {
+	due := gwr.dueVAAs(now)
+	if len(due) == 0 {
+		return
+	}
+
+	// Another guardian may have submitted the VAA in the meantime, in which case the submission would fail anyway.
+	pending := make([]*queuedVAA, 0, len(due))
+	for _, q := range due {
+		redeemed, err := gwr.isVAARedeemed(ctx, q.entry.VAA)
+		if err != nil {
+			gwr.logger.Warn("failed to check if vaa was already redeemed, submitting it anyway", zap.String("msgId", q.entry.MessageID), zap.Error(err))
+		} else if redeemed {
+			vaasAlreadyRedeemed.Inc()
+			gwr.logger.Info("vaa was already redeemed, dropping it from the queue", zap.String("msgId", q.entry.MessageID))
+			gwr.complete(q)
+			continue
+		}
+		pending = append(pending, q)
+	}
+
+	if len(pending) == 0 {
+		return
+	}
+
+	if len(pending) > 1 {
+		batch := make([]*VaaToPublish, len(pending))
+		for i, q := range pending {
+			batch[i] = q.v2p
+		}
+		err := gwr.submitBatchToContract(batch)
+		if err == nil {
+			for _, q := range pending {
+				gwr.complete(q)
+			}
+			return
+		}
+		// The transaction is atomic, so a single VAA that was already executed by another guardian fails the whole
+		// batch. Fall back to submitting the VAAs one at a time.
+		batchFallbacks.Inc()
+		gwr.logger.Warn("failed to submit batch of vaas to contract, submitting them individually", zap.Int("numVaas", len(batch)), zap.Error(err))
+	}
+
+	for _, q := range pending {
+		if err := gwr.submitVAAToContract(q.v2p); err != nil {
+			gwr.handleFailure(q, err, now)
+			continue
+		}
+		gwr.complete(q)
+	}
+}
+
+// isVAARedeemed asks the token bridge contract whether a VAA was already redeemed. VAAs submitted to the IBC
+// translator are redeemed through the token bridge as well.
+func (gwr *GatewayRelayer) isVAARedeemed(ctx context.Context, vaaBytes []byte) (bool, error) {
+	type isVaaRedeemedQuery struct {
+		IsVaaRedeemed struct {
+			VAA []byte `json:"vaa"`
+		} `json:"is_vaa_redeemed"`
+	}
+	var query isVaaRedeemedQuery
+	query.IsVaaRedeemed.VAA = vaaBytes
+
+	queryBytes, err := json.Marshal(query)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal query: %w", err)
+	}
+
+	respBytes, err := gwr.wormchainConn.SubmitQuery(ctx, gwr.tokenBridgeAddress, queryBytes)
+	if err != nil {
+		return false, fmt.Errorf("failed to query token bridge: %w", err)
+	}
+
+	var resp struct {
+		IsRedeemed bool `json:"is_redeemed"`
+	}
+	if err := json.Unmarshal(respBytes, &resp); err != nil {
+		return false, fmt.Errorf("failed to unmarshal query response: %w", err)
+	}
+
+	return resp.IsRedeemed, nil
+}
+
+// complete removes a VAA from the queue once it was submitted or found to be redeemed.
+func (gwr *GatewayRelayer) complete(q *queuedVAA) {
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	if gwr.queue[q.entry.MessageID] == q {
+		delete(gwr.queue, q.entry.MessageID)
+	}
+	if err := gwr.db.GatewayRelayerDeleteEntry(q.entry.MessageID); err != nil {
+		gwr.logger.Error("failed to delete queued vaa", zap.String("msgId", q.entry.MessageID), zap.Error(err))
+	}
+	gwr.updateQueueMetricsLocked()
+}
+
+// handleFailure schedules a retry of a failed submission, or marks it as failed if the contract rejected the VAA or
+// it ran out of attempts.
+func (gwr *GatewayRelayer) handleFailure(q *queuedVAA, err error, now time.Time) {
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	// The VAA may have been dropped through the admin RPC in the meantime.
+	if gwr.queue[q.entry.MessageID] != q {
+		return
+	}
+
+	q.entry.Attempts++
+	q.entry.LastError = err.Error()
+	if errors.Is(err, ErrSubmitRejected) || q.entry.Attempts >= maxAttempts {
+		q.entry.Failed = true
+		gwr.logger.Error("failed to submit vaa to contract, giving up until it is replayed",
+			zap.String("msgId", q.entry.MessageID),
+			zap.String("contract", q.v2p.ContractAddress),
+			zap.Uint8("vaaType", uint8(q.v2p.VType)),
+			zap.Int("attempts", q.entry.Attempts),
+			zap.Error(err),
+		)
+	} else {
+		q.entry.NextAttempt = now.Add(retryDelay(q.entry.Attempts))
+		submitRetries.Inc()
+		gwr.logger.Warn("failed to submit vaa to contract, will retry",
+			zap.String("msgId", q.entry.MessageID),
+			zap.String("contract", q.v2p.ContractAddress),
+			zap.Uint8("vaaType", uint8(q.v2p.VType)),
+			zap.Int("attempts", q.entry.Attempts),
+			zap.Time("nextAttempt", q.entry.NextAttempt),
+			zap.Error(err),
+		)
+	}
+
+	if err := gwr.db.GatewayRelayerStoreEntry(q.entry); err != nil {
+		gwr.logger.Error("failed to persist queued vaa", zap.String("msgId", q.entry.MessageID), zap.Error(err))
+	}
+	gwr.updateQueueMetricsLocked()
+}
+
+// updateQueueMetricsLocked updates the queue metrics. The caller must hold the queue lock.
+func (gwr *GatewayRelayer) updateQueueMetricsLocked() {
+	numFailed := 0
+	for _, q := range gwr.queue {
+		if q.entry.Failed {
+			numFailed++
+		}
+	}
+	queueSize.Set(float64(len(gwr.queue)))
+	queueFailed.Set(float64(numFailed))
+}
+
+// QueueStatus returns a copy of the queued VAAs, oldest first.
+func (gwr *GatewayRelayer) QueueStatus() []*db.GatewayRelayerQueueEntry {
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	entries := make([]*db.GatewayRelayerQueueEntry, 0, len(gwr.queue))
+	for _, q := range gwr.queue {
+		entry := *q.entry
+		entries = append(entries, &entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if !entries[i].EnqueuedAt.Equal(entries[j].EnqueuedAt) {
+			return entries[i].EnqueuedAt.Before(entries[j].EnqueuedAt)
+		}
+		return entries[i].MessageID < entries[j].MessageID
+	})
+	return entries
+}
+
+// Retry makes a queued VAA due for submission immediately, resetting its attempts. It returns ErrQueueEntryNotFound if
+// the VAA is not queued.
+func (gwr *GatewayRelayer) Retry(msgId string) error {
+	gwr.queueLock.Lock()
+	q, exists := gwr.queue[msgId]
+	if !exists {
+		gwr.queueLock.Unlock()
+		return ErrQueueEntryNotFound
+	}
+
+	q.entry.Attempts = 0
+	q.entry.Failed = false
+	q.entry.LastError = ""
+	q.entry.NextAttempt = time.Now()
+	if err := gwr.db.GatewayRelayerStoreEntry(q.entry); err != nil {
+		gwr.logger.Error("failed to persist queued vaa", zap.String("msgId", msgId), zap.Error(err))
+	}
+	gwr.updateQueueMetricsLocked()
+	gwr.queueLock.Unlock()
+
+	gwr.logger.Info("retrying queued vaa", zap.String("msgId", msgId))
+	gwr.wake()
+	return nil
+}
+
+// Replay queues a VAA that is not in the queue, for instance because it was dropped. It returns ErrNotRelayed if the
+// gateway relayer does not submit this VAA.
+func (gwr *GatewayRelayer) Replay(v *vaa.VAA) error {
+	v2p, err := gwr.vaaToPublish(v)
+	if err != nil {
+		return err
+	}
+	if v2p == nil {
+		return ErrNotRelayed
+	}
+
+	gwr.logger.Info("replaying vaa", zap.String("msgId", v.MessageID()))
+	return gwr.enqueue(v2p)
+}
+
+// Drop removes a VAA from the queue.
+func (gwr *GatewayRelayer) Drop(msgId string) error {
+	gwr.queueLock.Lock()
+	defer gwr.queueLock.Unlock()
+
+	if _, exists := gwr.queue[msgId]; !exists {
+		return ErrQueueEntryNotFound
+	}
+
+	if err := gwr.db.GatewayRelayerDeleteEntry(msgId); err != nil {
+		return err
+	}
+	delete(gwr.queue, msgId)
+	gwr.updateQueueMetricsLocked()
+
+	gwr.logger.Info("dropped queued vaa", zap.String("msgId", msgId))
+	return nil
+}
@@ -0,0 +1,397 @@
+// Package heightmonitor implements a background service that compares the block heights of our watchers to the median
+// of the heights the other guardians report in their heartbeats. A node that is far behind or ahead of the network
+// most likely has a broken RPC provider, which this catches long before messages go missing.
+package heightmonitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultInterval is how often the heights are compared by default.
+	DefaultInterval = 30 * time.Second
+	// DefaultThreshold is the default number of blocks our height may deviate from the network median.
+	DefaultThreshold = 100
+	// DefaultMinGuardians is the default number of other guardians that must report a height for a chain to compare against.
+	DefaultMinGuardians = 3
+)
+
+// The status of a chain.
+const (
+	StatusOK               = "ok"
+	StatusBehind           = "behind"
+	StatusAhead            = "ahead"
+	StatusInsufficientData = "insufficient_data"
+)
+
+var (
+	heightDeviation = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_height_monitor_deviation_blocks",
+			Help: "Number of blocks our height deviates from the median height reported by the other guardians (negative if behind)",
+		}, []string{"chain_name"})
+	heightOutOfRange = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_height_monitor_out_of_range",
+			Help: "Set to 1 if our height deviates from the network median by more than the threshold, 0 otherwise",
+		}, []string{"chain_name"})
+	heightReportingGuardians = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_height_monitor_reporting_guardians",
+			Help: "Number of other guardians reporting a height for the chain",
+		}, []string{"chain_name"})
+)
+
+// Config configures the height monitor.
+type Config struct {
+	// Interval is how often the heights are compared.
+	Interval time.Duration
+	// DefaultThreshold is the number of blocks our height may deviate from the network median, unless overridden
+	// for the chain in Thresholds.
+	DefaultThreshold int64
+	Thresholds       map[vaa.ChainID]int64
+	// MinGuardians is the number of other guardians that must report a height for a chain to compare against.
+	MinGuardians int
+}
+
+// DefaultConfig returns the default configuration.
+func DefaultConfig() Config {
+	return Config{
+		Interval:         DefaultInterval,
+		DefaultThreshold: DefaultThreshold,
+		Thresholds:       map[vaa.ChainID]int64{},
+		MinGuardians:     DefaultMinGuardians,
+	}
+}
+
+// threshold returns the threshold of a chain.
+func (cfg *Config) threshold(chainID vaa.ChainID) int64 {
+	if t, exists := cfg.Thresholds[chainID]; exists {
+		return t
+	}
+	return cfg.DefaultThreshold
+}
+
+// ParseThresholds parses per chain thresholds of the form "chain=blocks,chain=blocks".
+func ParseThresholds(str string) (map[vaa.ChainID]int64, error) {
+	ret := make(map[vaa.ChainID]int64)
+
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return ret, nil
+	}
+
+	for _, entry := range strings.Split(str, ",") {
+		chainStr, thresholdStr, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			return nil, fmt.Errorf(`invalid threshold "%s", must be of the form chain=blocks`, entry)
+		}
+
+		chainID, err := vaa.ChainIDFromString(chainStr)
+		if err != nil || chainID == vaa.ChainIDUnset {
+			return nil, fmt.Errorf(`invalid chain "%s" in threshold`, chainStr)
+		}
+
+		threshold, err := strconv.ParseInt(thresholdStr, 10, 64)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf(`invalid threshold "%s" for chain %s, must be a positive number of blocks`, thresholdStr, chainID)
+		}
+
+		ret[chainID] = threshold
+	}
+
+	return ret, nil
+}
+
+// LocalHeightsFunc returns the current heights of our watchers, like they are published in our heartbeats.
+type LocalHeightsFunc func() map[vaa.ChainID]*gossipv1.Heartbeat_Network
+
+// Heights are the heights of a chain. Zero means the height is not known.
+type Heights struct {
+	Height          int64
+	SafeHeight      int64
+	FinalizedHeight int64
+}
+
+// ChainStatus is the result of comparing our heights of a chain to the network.
+type ChainStatus struct {
+	ChainID vaa.ChainID
+	Local   Heights
+	// Median are the median heights reported by the other guardians.
+	Median Heights
+	// NumGuardians is the number of other guardians reporting a height for the chain.
+	NumGuardians int
+	// Deviation is the largest difference, in blocks, between a local and a median height. It is negative if we are behind.
+	Deviation int64
+	Threshold int64
+	Status    string
+}
+
+// Monitor periodically compares our watcher heights to the heights reported by the other guardians.
+type Monitor struct {
+	logger       *zap.Logger
+	gst          *common.GuardianSetState
+	ourAddr      eth_common.Address
+	localHeights LocalHeightsFunc
+	cfg          Config
+
+	mu     sync.Mutex
+	status []*ChainStatus
+	// warned are the chains we raised a readiness warning for.
+	warned map[vaa.ChainID]struct{}
+}
+
+// NewMonitor creates a height monitor. Our own heartbeats, identified by ourAddr, are not part of the network median.
+func NewMonitor(logger *zap.Logger, gst *common.GuardianSetState, ourAddr eth_common.Address, localHeights LocalHeightsFunc, cfg Config) (*Monitor, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	if cfg.DefaultThreshold <= 0 {
+		return nil, errors.New("default threshold must be positive")
+	}
+	if cfg.MinGuardians <= 0 {
+		return nil, errors.New("min guardians must be positive")
+	}
+
+	return &Monitor{
+		logger:       logger.With(zap.String("component", "heightmonitor")),
+		gst:          gst,
+		ourAddr:      ourAddr,
+		localHeights: localHeights,
+		cfg:          cfg,
+		warned:       make(map[vaa.ChainID]struct{}),
+	}, nil
+}
+
+// Run compares the heights every interval until the context is canceled.
+func (m *Monitor) Run(ctx context.Context) error {
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	ticker := time.NewTicker(m.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			m.check()
+		}
+	}
+}
+
+// Status returns the result of the most recent comparison, ordered by chain ID.
+func (m *Monitor) Status() []*ChainStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.status
+}
+
+// readinessComponent returns the component the readiness warnings of a chain are raised for.
+func readinessComponent(chainID vaa.ChainID) readiness.Component {
+	return readiness.Component("heightMonitor:" + chainID.String())
+}
+
+// check compares the heights and updates the status, metrics and readiness warnings.
+func (m *Monitor) check() {
+	gs := m.gst.Get()
+	if gs == nil {
+		return
+	}
+
+	// Use the most recent heartbeat of each of the other guardians in the current guardian set.
+	network := make(map[vaa.ChainID][]Heights)
+	for addr, heartbeats := range m.gst.GetAll() {
+		if addr == m.ourAddr {
+			continue
+		}
+		if _, ok := gs.KeyIndex(addr); !ok {
+			continue
+		}
+
+		var latest *gossipv1.Heartbeat
+		for _, hb := range heartbeats {
+			if latest == nil || hb.Timestamp > latest.Timestamp {
+				latest = hb
+			}
+		}
+		if latest == nil {
+			continue
+		}
+
+		for _, n := range latest.Networks {
+			if n.Height > 0 {
+				chainID := vaa.ChainID(n.Id)
+				network[chainID] = append(network[chainID], Heights{Height: n.Height, SafeHeight: n.SafeHeight, FinalizedHeight: n.FinalizedHeight})
+			}
+		}
+	}
+
+	status := make([]*ChainStatus, 0)
+	for chainID, local := range m.localHeights() {
+		if local.Height <= 0 {
+			continue
+		}
+
+		cs := &ChainStatus{
+			ChainID:      chainID,
+			Local:        Heights{Height: local.Height, SafeHeight: local.SafeHeight, FinalizedHeight: local.FinalizedHeight},
+			NumGuardians: len(network[chainID]),
+			Threshold:    m.cfg.threshold(chainID),
+			Status:       StatusInsufficientData,
+		}
+
+		if cs.NumGuardians >= m.cfg.MinGuardians {
+			cs.Median = medianHeights(network[chainID])
+			cs.Deviation = deviation(cs.Local, cs.Median)
+			switch {
+			case cs.Deviation < -cs.Threshold:
+				cs.Status = StatusBehind
+			case cs.Deviation > cs.Threshold:
+				cs.Status = StatusAhead
+			default:
+				cs.Status = StatusOK
+			}
+		}
+
+		status = append(status, cs)
+	}
+	sort.Slice(status, func(i, j int) bool { return status[i].ChainID < status[j].ChainID })
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	previous := m.status
+	m.status = status
+
+	current := make(map[vaa.ChainID]struct{}, len(status))
+	for _, cs := range status {
+		current[cs.ChainID] = struct{}{}
+	}
+
+	// Chains we no longer have a height for, e.g. because the watcher stopped, are not compared anymore. Every chain we
+	// warned about is part of the previous status.
+	for _, cs := range previous {
+		if _, ok := current[cs.ChainID]; ok {
+			continue
+		}
+		deleteMetrics(cs.ChainID)
+		if _, warned := m.warned[cs.ChainID]; warned {
+			readiness.ClearWarning(readinessComponent(cs.ChainID))
+			m.logger.Info("no longer comparing our height to the network, since we have no height", zap.Stringer("chain", cs.ChainID))
+			delete(m.warned, cs.ChainID)
+		}
+	}
+
+	for _, cs := range status {
+		chainName := cs.ChainID.String()
+		heightReportingGuardians.WithLabelValues(chainName).Set(float64(cs.NumGuardians))
+		if cs.Status == StatusInsufficientData {
+			heightDeviation.DeleteLabelValues(chainName)
+			heightOutOfRange.DeleteLabelValues(chainName)
+		} else {
+			heightDeviation.WithLabelValues(chainName).Set(float64(cs.Deviation))
+			if cs.Status == StatusOK {
+				heightOutOfRange.WithLabelValues(chainName).Set(0)
+			} else {
+				heightOutOfRange.WithLabelValues(chainName).Set(1)
+			}
+		}
+
+		_, warned := m.warned[cs.ChainID]
+		if cs.Status == StatusBehind || cs.Status == StatusAhead {
+			readiness.SetWarning(readinessComponent(cs.ChainID), fmt.Sprintf("%s by %d blocks compared to the median of %d guardians (threshold %d)", cs.Status, abs(cs.Deviation), cs.NumGuardians, cs.Threshold))
+			if !warned {
+				m.logger.Warn("our height deviates from the network",
+					zap.Stringer("chain", cs.ChainID),
+					zap.String("status", cs.Status),
+					zap.Int64("localHeight", cs.Local.Height),
+					zap.Int64("medianHeight", cs.Median.Height),
+					zap.Int64("deviation", cs.Deviation),
+					zap.Int64("threshold", cs.Threshold),
+					zap.Int("numGuardians", cs.NumGuardians),
+				)
+				m.warned[cs.ChainID] = struct{}{}
+			}
+		} else if warned {
+			readiness.ClearWarning(readinessComponent(cs.ChainID))
+			m.logger.Info("our height is back in line with the network", zap.Stringer("chain", cs.ChainID), zap.String("status", cs.Status))
+			delete(m.warned, cs.ChainID)
+		}
+	}
+}
+
+// deleteMetrics deletes the metrics of a chain that is not compared anymore.
+func deleteMetrics(chainID vaa.ChainID) {
+	chainName := chainID.String()
+	heightDeviation.DeleteLabelValues(chainName)
+	heightOutOfRange.DeleteLabelValues(chainName)
+	heightReportingGuardians.DeleteLabelValues(chainName)
+}
+
+// medianHeights returns the median of each kind of height, ignoring the unknown ones.
+func medianHeights(heights []Heights) Heights {
+	median := func(get func(h Heights) int64) int64 {
+		values := make([]int64, 0, len(heights))
+		for _, h := range heights {
+			if v := get(h); v > 0 {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return 0
+		}
+		sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		mid := len(values) / 2
+		if len(values)%2 == 0 {
+			return (values[mid-1] + values[mid]) / 2
+		}
+		return values[mid]
+	}
+
+	return Heights{
+		Height:          median(func(h Heights) int64 { return h.Height }),
+		SafeHeight:      median(func(h Heights) int64 { return h.SafeHeight }),
+		FinalizedHeight: median(func(h Heights) int64 { return h.FinalizedHeight }),
+	}
+}
+
+// deviation returns the largest difference between a local and a median height that are both known.
+func deviation(local Heights, median Heights) int64 {
+	var ret int64
+	for _, pair := range [][2]int64{
+		{local.Height, median.Height},
+		{local.SafeHeight, median.SafeHeight},
+		{local.FinalizedHeight, median.FinalizedHeight},
+	} {
+		if pair[0] > 0 && pair[1] > 0 {
+			if d := pair[0] - pair[1]; abs(d) > abs(ret) {
+				ret = d
+			}
+		}
+	}
+	return ret
+}
+
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
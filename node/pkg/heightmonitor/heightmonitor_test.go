@@ -0,0 +1,199 @@
+package heightmonitor
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/readiness"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestParseThresholds(t *testing.T) {
+	thresholds, err := ParseThresholds("")
+	require.NoError(t, err)
+	assert.Empty(t, thresholds)
+
+	thresholds, err = ParseThresholds("solana=500, ethereum=10")
+	require.NoError(t, err)
+	assert.Equal(t, map[vaa.ChainID]int64{vaa.ChainIDSolana: 500, vaa.ChainIDEthereum: 10}, thresholds)
+
+	for _, str := range []string{"solana", "notachain=10", "solana=abc", "solana=0", "solana=-5"} {
+		_, err := ParseThresholds(str)
+		assert.Error(t, err, str)
+	}
+}
+
+func TestNewMonitorRejectsInvalidConfig(t *testing.T) {
+	gst := common.NewGuardianSetState(nil)
+	for _, modify := range []func(cfg *Config){
+		func(cfg *Config) { cfg.Interval = 0 },
+		func(cfg *Config) { cfg.DefaultThreshold = 0 },
+		func(cfg *Config) { cfg.MinGuardians = 0 },
+	} {
+		cfg := DefaultConfig()
+		modify(&cfg)
+		_, err := NewMonitor(zap.NewNop(), gst, eth_common.Address{}, nil, cfg)
+		assert.Error(t, err)
+	}
+}
+
+func TestMedianHeights(t *testing.T) {
+	median := medianHeights([]Heights{
+		{Height: 100, SafeHeight: 90, FinalizedHeight: 0},
+		{Height: 120, SafeHeight: 0, FinalizedHeight: 0},
+		{Height: 110, SafeHeight: 96, FinalizedHeight: 0},
+	})
+	assert.Equal(t, Heights{Height: 110, SafeHeight: 93, FinalizedHeight: 0}, median)
+}
+
+func TestDeviation(t *testing.T) {
+	// The largest difference wins, unknown heights are ignored.
+	assert.Equal(t, int64(-50), deviation(Heights{Height: 100, SafeHeight: 50}, Heights{Height: 110, SafeHeight: 100, FinalizedHeight: 80}))
+	assert.Equal(t, int64(20), deviation(Heights{Height: 120, FinalizedHeight: 90}, Heights{Height: 100, FinalizedHeight: 85}))
+	assert.Equal(t, int64(0), deviation(Heights{Height: 100}, Heights{SafeHeight: 100}))
+}
+
+// setupMonitor creates a monitor with our guardian and numOthers other guardians in the guardian set.
+func setupMonitor(t *testing.T, numOthers int, local map[vaa.ChainID]*gossipv1.Heartbeat_Network) (*Monitor, *common.GuardianSetState, []eth_common.Address) {
+	t.Helper()
+
+	ourAddr := eth_common.HexToAddress("0x01")
+	keys := []eth_common.Address{ourAddr}
+	for i := 0; i < numOthers; i++ {
+		keys = append(keys, eth_common.HexToAddress(fmt.Sprintf("0x%x", i+2)))
+	}
+
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(common.NewGuardianSet(keys, 0))
+
+	cfg := DefaultConfig()
+	cfg.Thresholds[vaa.ChainIDSolana] = 500
+	m, err := NewMonitor(zap.NewNop(), gst, ourAddr, func() map[vaa.ChainID]*gossipv1.Heartbeat_Network { return local }, cfg)
+	require.NoError(t, err)
+
+	return m, gst, keys[1:]
+}
+
+func setHeartbeat(t *testing.T, gst *common.GuardianSetState, addr eth_common.Address, timestamp int64, networks ...*gossipv1.Heartbeat_Network) {
+	t.Helper()
+	require.NoError(t, gst.SetHeartbeat(addr, peer.ID(addr.Hex()), &gossipv1.Heartbeat{Timestamp: timestamp, Networks: networks}))
+}
+
+func TestCheck(t *testing.T) {
+	local := map[vaa.ChainID]*gossipv1.Heartbeat_Network{
+		vaa.ChainIDEthereum: {Id: uint32(vaa.ChainIDEthereum), Height: 1000, SafeHeight: 990, FinalizedHeight: 950},
+		vaa.ChainIDSolana:   {Id: uint32(vaa.ChainIDSolana), Height: 5000},
+		vaa.ChainIDSui:      {Id: uint32(vaa.ChainIDSui), Height: 300},
+		vaa.ChainIDAptos:    {Id: uint32(vaa.ChainIDAptos), Height: 0},
+	}
+	m, gst, others := setupMonitor(t, 3, local)
+
+	now := time.Now().UnixNano()
+	for i, addr := range others {
+		networks := []*gossipv1.Heartbeat_Network{
+			{Id: uint32(vaa.ChainIDEthereum), Height: 1200 + int64(i), SafeHeight: 1190, FinalizedHeight: 1150},
+			{Id: uint32(vaa.ChainIDSolana), Height: 5400},
+		}
+		// Only one guardian reports Sui.
+		if i == 0 {
+			networks = append(networks, &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDSui), Height: 1})
+		}
+		setHeartbeat(t, gst, addr, now, networks...)
+	}
+
+	// Our own heartbeats and those of guardians outside the guardian set are ignored.
+	setHeartbeat(t, gst, m.ourAddr, now, &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDSolana), Height: 1})
+	setHeartbeat(t, gst, eth_common.HexToAddress("0xff"), now, &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDSolana), Height: 1})
+
+	m.check()
+
+	status := m.Status()
+	require.Len(t, status, 3)
+
+	sol := status[0]
+	assert.Equal(t, vaa.ChainIDSolana, sol.ChainID)
+	assert.Equal(t, 3, sol.NumGuardians)
+	assert.Equal(t, int64(-400), sol.Deviation)
+	assert.Equal(t, int64(500), sol.Threshold)
+	assert.Equal(t, StatusOK, sol.Status)
+
+	eth := status[1]
+	assert.Equal(t, vaa.ChainIDEthereum, eth.ChainID)
+	assert.Equal(t, Heights{Height: 1201, SafeHeight: 1190, FinalizedHeight: 1150}, eth.Median)
+	assert.Equal(t, 3, eth.NumGuardians)
+	assert.Equal(t, int64(-201), eth.Deviation)
+	assert.Equal(t, int64(DefaultThreshold), eth.Threshold)
+	assert.Equal(t, StatusBehind, eth.Status)
+
+	sui := status[2]
+	assert.Equal(t, vaa.ChainIDSui, sui.ChainID)
+	assert.Equal(t, 1, sui.NumGuardians)
+	assert.Equal(t, StatusInsufficientData, sui.Status)
+
+	warnings := readiness.Warnings()
+	assert.Contains(t, warnings, readinessComponent(vaa.ChainIDEthereum))
+	assert.NotContains(t, warnings, readinessComponent(vaa.ChainIDSolana))
+	assert.NotContains(t, warnings, readinessComponent(vaa.ChainIDSui))
+
+	// Once we catch up, the warning is cleared.
+	local[vaa.ChainIDEthereum] = &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDEthereum), Height: 1210, SafeHeight: 1195, FinalizedHeight: 1150}
+	m.check()
+	assert.Equal(t, StatusOK, m.Status()[1].Status)
+	assert.NotContains(t, readiness.Warnings(), readinessComponent(vaa.ChainIDEthereum))
+}
+
+func TestCheckForgetsChainsWithoutHeight(t *testing.T) {
+	local := map[vaa.ChainID]*gossipv1.Heartbeat_Network{
+		vaa.ChainIDEthereum: {Id: uint32(vaa.ChainIDEthereum), Height: 1000},
+	}
+	m, gst, others := setupMonitor(t, 3, local)
+	for _, addr := range others {
+		setHeartbeat(t, gst, addr, time.Now().UnixNano(), &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDEthereum), Height: 2000})
+	}
+
+	m.check()
+	require.Equal(t, StatusBehind, m.Status()[0].Status)
+	require.Contains(t, readiness.Warnings(), readinessComponent(vaa.ChainIDEthereum))
+
+	// The watcher stops reporting a height, so the warning and the metrics of the chain are cleared.
+	local[vaa.ChainIDEthereum] = &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDEthereum), Height: 0}
+	m.check()
+	assert.Empty(t, m.Status())
+	assert.NotContains(t, readiness.Warnings(), readinessComponent(vaa.ChainIDEthereum))
+	assert.Empty(t, m.warned)
+	chainName := vaa.ChainIDEthereum.String()
+	assert.False(t, heightDeviation.DeleteLabelValues(chainName))
+	assert.False(t, heightOutOfRange.DeleteLabelValues(chainName))
+	assert.False(t, heightReportingGuardians.DeleteLabelValues(chainName))
+}
+
+func TestCheckUsesLatestHeartbeat(t *testing.T) {
+	local := map[vaa.ChainID]*gossipv1.Heartbeat_Network{
+		vaa.ChainIDEthereum: {Id: uint32(vaa.ChainIDEthereum), Height: 1000},
+	}
+	m, gst, others := setupMonitor(t, 3, local)
+
+	now := time.Now().UnixNano()
+	for _, addr := range others {
+		setHeartbeat(t, gst, addr, now, &gossipv1.Heartbeat_Network{Id: uint32(vaa.ChainIDEthereum), Height: 1000})
+	}
+	// A second node of the first guardian with an older heartbeat far ahead.
+	require.NoError(t, gst.SetHeartbeat(others[0], peer.ID("other node"), &gossipv1.Heartbeat{
+		Timestamp: now - int64(time.Minute),
+		Networks:  []*gossipv1.Heartbeat_Network{{Id: uint32(vaa.ChainIDEthereum), Height: 100000}},
+	}))
+
+	m.check()
+	status := m.Status()
+	require.Len(t, status, 1)
+	assert.Equal(t, 3, status[0].NumGuardians)
+	assert.Equal(t, StatusOK, status[0].Status)
+}
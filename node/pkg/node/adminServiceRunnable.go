@@ -7,11 +7,17 @@ import (
 	"os"
 	"time"
 
+	"github.com/certusone/wormhole/node/pkg/accountant"
 	"github.com/certusone/wormhole/node/pkg/adminrpc"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	nodev1 "github.com/certusone/wormhole/node/pkg/proto/node/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
@@ -39,6 +45,14 @@ func adminServiceRunnable(
 	ethContract *string,
 	rpcMap map[string]string,
 	reobservers interfaces.Reobservers,
+	retentionPolicy *db.RetentionPolicy,
+	acct *accountant.Accountant,
+	inspectC chan<- *processor.InspectRequest,
+	performance *processor.GuardianPerformance,
+	denylist *denylist.Denylist,
+	gatewayRelayer *gwrelayer.GatewayRelayer,
+	p2pIntrospector *p2p.Introspector,
+	heightMonitor *heightmonitor.Monitor,
 ) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
@@ -95,6 +109,14 @@ func adminServiceRunnable(
 		ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx)),
 		rpcMap,
 		reobservers,
+		retentionPolicy,
+		acct,
+		inspectC,
+		performance,
+		denylist,
+		gatewayRelayer,
+		p2pIntrospector,
+		heightMonitor,
 	)
 
 	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, gov)
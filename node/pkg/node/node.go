@@ -7,15 +7,20 @@ import (
 	"github.com/certusone/wormhole/node/pkg/accountant"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/governor"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 )
@@ -48,6 +53,12 @@ const (
 	// and thereby somewhat limits the amount of observation requests that can be sent in bursts to the network.
 	observationRequestOutboundBufferSize = 100
 
+	// observationFetchBufferSize configures the size of obsvFetchC. Fetch requests are dropped when it is full.
+	observationFetchBufferSize = 100
+
+	// invalidObservationBufferSize configures the size of invalidObsvC. Reports are dropped when it is full.
+	invalidObservationBufferSize = 100
+
 	// observationRequestPerChainBufferSize is the buffer size of the per-network reobservation channel
 	observationRequestPerChainBufferSize = 100
 )
@@ -66,11 +77,17 @@ type G struct {
 	// components
 	db              *db.Database
 	gst             *common.GuardianSetState
+	performance     *processor.GuardianPerformance
+	p2pIntrospector *p2p.Introspector
+	heightMonitor   *heightmonitor.Monitor
+	policyHooks     []processor.PolicyHook
+	denylist        *denylist.Denylist
 	acct            *accountant.Accountant
 	gov             *governor.ChainGovernor
 	gatewayRelayer  *gwrelayer.GatewayRelayer
 	queryHandler    *query.QueryHandler
 	publicrpcServer *grpc.Server
+	retentionPolicy *db.RetentionPolicy
 
 	// runnables
 	runnablesWithScissors map[string]supervisor.Runnable
@@ -94,8 +111,14 @@ type G struct {
 	obsvReqC channelPair[*gossipv1.ObservationRequest]
 	// Outbound observation requests
 	obsvReqSendC channelPair[*gossipv1.ObservationRequest]
+	// Outbound requests to fetch observations directly from other guardians
+	obsvFetchC channelPair[*p2p.ObservationFetchRequest]
+	// Peers that originated observations with invalid signatures, reported by the processor to p2p
+	invalidObsvC channelPair[peer.ID]
 	// acctC is the channel where messages will be put after they reached quorum in the accountant.
 	acctC channelPair[*common.MessagePublication]
+	// inspectC is used by the admin service and the recovery service to query the aggregation state of the processor.
+	inspectC channelPair[*processor.InspectRequest]
 
 	// Cross Chain Query Handler channels
 	chainQueryReqC            map[vaa.ChainID]chan *query.PerChainQueryInternal
@@ -129,7 +152,10 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 	g.signedInC = makeChannelPair[*gossipv1.SignedVAAWithQuorum](inboundSignedVaaBufferSize)
 	g.obsvReqC = makeChannelPair[*gossipv1.ObservationRequest](observationRequestInboundBufferSize)
 	g.obsvReqSendC = makeChannelPair[*gossipv1.ObservationRequest](observationRequestOutboundBufferSize)
+	g.obsvFetchC = makeChannelPair[*p2p.ObservationFetchRequest](observationFetchBufferSize)
+	g.invalidObsvC = makeChannelPair[peer.ID](invalidObservationBufferSize)
 	g.acctC = makeChannelPair[*common.MessagePublication](accountant.MsgChannelCapacity)
+	g.inspectC = makeChannelPair[*processor.InspectRequest](0)
 	// Cross Chain Query Handler channels
 	g.chainQueryReqC = make(map[vaa.ChainID]chan *query.PerChainQueryInternal)
 	g.signedQueryReqC = makeChannelPair[*gossipv1.SignedQueryRequest](query.SignedQueryRequestChannelSize)
@@ -139,6 +165,12 @@ func (g *G) initializeBasic(rootCtxCancel context.CancelFunc) {
 	// Guardian set state managed by processor
 	g.gst = common.NewGuardianSetState(nil)
 
+	// Guardian performance report updated by processor
+	g.performance = processor.NewGuardianPerformance(processor.GuardianPerformanceWindow)
+
+	// Introspection of the gossip network, populated by p2p and used by the admin service
+	g.p2pIntrospector = p2p.NewIntrospector()
+
 	// allocate maps
 	g.runnablesWithScissors = make(map[string]supervisor.Runnable)
 	g.runnables = make(map[string]supervisor.Runnable)
@@ -2,6 +2,7 @@ package node
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
@@ -11,18 +12,23 @@ import (
 	"github.com/certusone/wormhole/node/pkg/accountant"
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/denylist"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/certusone/wormhole/node/pkg/gwrelayer"
+	"github.com/certusone/wormhole/node/pkg/heightmonitor"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	"github.com/certusone/wormhole/node/pkg/processor"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/readiness"
+	"github.com/certusone/wormhole/node/pkg/recovery"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
 	"github.com/certusone/wormhole/node/pkg/watchers"
 	"github.com/certusone/wormhole/node/pkg/watchers/ibc"
 	"github.com/certusone/wormhole/node/pkg/watchers/interfaces"
 	"github.com/certusone/wormhole/node/pkg/wormconn"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/gorilla/mux"
 	libp2p_crypto "github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -38,7 +44,7 @@ type GuardianOption struct {
 }
 
 // GuardianOptionP2P configures p2p networking.
-// Dependencies: Accountant, Governor
+// Dependencies: Database, Accountant, Governor
 func GuardianOptionP2P(
 	p2pKey libp2p_crypto.PrivKey,
 	networkId string,
@@ -54,10 +60,11 @@ func GuardianOptionP2P(
 	ibcFeaturesFunc func() string,
 	protectedPeers []string,
 	ccqProtectedPeers []string,
+	peerProtection p2p.PeerProtectionParams,
 ) *GuardianOption {
 	return &GuardianOption{
 		name:         "p2p",
-		dependencies: []string{"accountant", "governor", "gateway-relayer"},
+		dependencies: []string{"db", "accountant", "governor", "gateway-relayer"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			components := p2p.DefaultComponents()
 			components.Port = port
@@ -76,6 +83,9 @@ func GuardianOptionP2P(
 			// Add the gossip advertisement address
 			components.GossipAdvertiseAddress = gossipAdvertiseAddress
 
+			components.PeerProtection = peerProtection
+			components.Introspector = g.p2pIntrospector
+
 			params, err := p2p.NewRunParams(
 				bootstrapPeers,
 				networkId,
@@ -108,6 +118,37 @@ func GuardianOptionP2P(
 					ccqProtectedPeers,
 				),
 				p2p.WithProcessorFeaturesFunc(processor.GetFeatures),
+				p2p.WithObservationExchange(
+					func(ctx context.Context, digest []byte) (*gossipv1.Observation, error) {
+						infos, err := processor.Inspect(ctx, g.inspectC.writeC, "", hex.EncodeToString(digest))
+						if err != nil {
+							return nil, err
+						}
+						for _, info := range infos {
+							if info.OurObs != nil {
+								return info.OurObs, nil
+							}
+						}
+						return nil, nil
+					},
+					func(ctx context.Context, messageID string) ([]byte, error) {
+						if g.db == nil {
+							return nil, nil
+						}
+						vaaID, err := db.VaaIDFromString(messageID)
+						if err != nil {
+							return nil, err
+						}
+						b, err := g.db.GetSignedVAABytes(*vaaID)
+						if errors.Is(err, db.ErrVAANotFound) {
+							return nil, nil
+						}
+						return b, err
+					},
+					g.obsvFetchC.readC,
+					g.signedInC.writeC,
+				),
+				p2p.WithInvalidObservationReports(g.invalidObsvC.readC),
 			)
 			if err != nil {
 				return err
@@ -162,6 +203,7 @@ func GuardianOptionAccountant(
 	websocket string,
 	contract string,
 	enforcing bool,
+	shadowMode bool,
 	wormchainConn *wormconn.ClientConn,
 	nttContract string,
 	nttWormchainConn *wormconn.ClientConn,
@@ -198,6 +240,9 @@ func GuardianOptionAccountant(
 				}
 				logger.Info("NTT accountant is enabled", zap.String("component", "gacct"))
 			}
+			if shadowMode {
+				logger.Info("accountant shadow mode is enabled, contract decisions will be predicted locally", zap.String("component", "gacct"))
+			}
 
 			g.acct = accountant.NewAccountant(
 				ctx,
@@ -208,6 +253,7 @@ func GuardianOptionAccountant(
 				websocket,
 				wormchainConn,
 				enforcing,
+				shadowMode,
 				nttContract,
 				nttWormchainConn,
 				g.guardianSigner,
@@ -247,14 +293,17 @@ func GuardianOptionGovernor(governorEnabled bool, flowCancelEnabled bool, coinGe
 
 // GuardianOptionGatewayRelayer configures the Gateway Relayer module. If the gateway relayer smart contract is configured, we will instantiate
 // the GatewayRelayer and signed VAAs will be passed to it for processing when they are published. It will forward payload three transfers destined
-// for the specified contract on wormchain to that contract.
+// for the specified contract on wormchain to that contract. VAAs waiting to be submitted are persisted in the database.
+// Dependencies: db
 func GuardianOptionGatewayRelayer(gatewayRelayerContract string, wormchainConn *wormconn.ClientConn) *GuardianOption {
 	return &GuardianOption{
-		name: "gateway-relayer",
+		name:         "gateway-relayer",
+		dependencies: []string{"db"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			g.gatewayRelayer = gwrelayer.NewGatewayRelayer(
 				ctx,
 				logger,
+				g.db,
 				gatewayRelayerContract,
 				wormchainConn,
 				g.env,
@@ -499,11 +548,11 @@ func GuardianOptionWatchers(watcherConfigs []watchers.WatcherConfig, ibcWatcherC
 }
 
 // GuardianOptionAdminService enables the admin rpc service on a unix socket.
-// Dependencies: db, governor
+// Dependencies: db, governor, accountant, denylist, gateway-relayer, height-monitor, vaa-retention
 func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *string, rpcMap map[string]string) *GuardianOption {
 	return &GuardianOption{
 		name:         "admin-service",
-		dependencies: []string{"governor", "db"},
+		dependencies: []string{"governor", "db", "accountant", "denylist", "gateway-relayer", "height-monitor", "vaa-retention"},
 		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
 			adminService, err := adminServiceRunnable(
 				logger,
@@ -519,6 +568,14 @@ func GuardianOptionAdminService(socketPath string, ethRpc *string, ethContract *
 				ethContract,
 				rpcMap,
 				g.reobservers,
+				g.retentionPolicy,
+				g.acct,
+				g.inspectC.writeC,
+				g.performance,
+				g.denylist,
+				g.gatewayRelayer,
+				g.p2pIntrospector,
+				g.heightMonitor,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to create admin service: %w", err)
@@ -586,6 +643,175 @@ func GuardianOptionDatabase(db *db.Database) *GuardianOption {
 		}}
 }
 
+// GuardianOptionVAARetention deletes VAAs from the database according to the retention policy, once at startup and
+// then periodically. If the policy is nil, no VAAs are deleted.
+// Dependencies: db
+func GuardianOptionVAARetention(policy *db.RetentionPolicy) *GuardianOption {
+	return &GuardianOption{
+		name:         "vaa-retention",
+		dependencies: []string{"db"},
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if policy == nil {
+				logger.Info("VAA retention policy is disabled")
+				return nil
+			}
+
+			logger.Info("VAA retention policy is enabled", zap.Int("numRules", len(policy.Rules)), zap.Duration("interval", policy.Interval))
+			g.retentionPolicy = policy
+			g.runnables["vaa-retention"] = func(ctx context.Context) error {
+				logger := supervisor.Logger(ctx)
+				supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+				apply := func() {
+					start := time.Now()
+					results, err := g.db.ApplyRetentionPolicy(policy, start, false)
+					if err != nil {
+						logger.Error("failed to apply VAA retention policy", zap.Error(err))
+						return
+					}
+					for _, result := range results {
+						logger.Info("applied VAA retention rule",
+							zap.Stringer("rule", &result.Rule),
+							zap.Int("numDeleted", result.NumDeleted),
+							zap.Int("numKept", result.NumKept),
+						)
+					}
+					logger.Info("applied VAA retention policy", zap.Duration("duration", time.Since(start)))
+				}
+
+				apply()
+
+				ticker := time.NewTicker(policy.Interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return nil
+					case <-ticker.C:
+						apply()
+					}
+				}
+			}
+			return nil
+		}}
+}
+
+// GuardianOptionSignerHealthCheck periodically checks the health of the guardian signers, so that failed signers
+// are detected before they are needed, and marks the guardian signer as ready once a signer passes a health check.
+// Dependencies: none
+func GuardianOptionSignerHealthCheck(signer *guardiansigner.SupervisedSigner) *GuardianOption {
+	return &GuardianOption{
+		name: "guardian-signer-health",
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			readiness.RegisterComponent(common.ReadinessGuardianSigner)
+			g.runnables["guardian-signer-health"] = signer.Run
+			return nil
+		}}
+}
+
+// GuardianOptionPolicyHook registers a policy hook that inspects message publications before they are passed to the
+// governor and the accountant. Hooks are evaluated in the order they are registered, and must be registered before
+// the processor.
+// Dependencies: none
+func GuardianOptionPolicyHook(hook processor.PolicyHook) *GuardianOption {
+	return &GuardianOption{
+		name: "policy-hook-" + hook.Name(),
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if _, exists := g.runnables["processor"]; exists {
+				return fmt.Errorf("policy hook %s must be registered before the processor", hook.Name())
+			}
+			g.policyHooks = append(g.policyHooks, hook)
+			logger.Info("registered policy hook", zap.String("hook", hook.Name()))
+			return nil
+		}}
+}
+
+// GuardianOptionDenylist configures the denylist, which holds the messages of denylisted emitters and the token bridge
+// transfers of denylisted tokens or to denylisted recipients. If filePath is set, entries are loaded from that file
+// and reloaded when it changes. The denylist must be configured before the processor.
+// Dependencies: db
+func GuardianOptionDenylist(enabled bool, filePath string) *GuardianOption {
+	return &GuardianOption{
+		name:         "denylist",
+		dependencies: []string{"db"},
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if !enabled {
+				logger.Info("denylist is disabled")
+				return nil
+			}
+
+			if _, exists := g.runnables["processor"]; exists {
+				return errors.New("the denylist must be configured before the processor")
+			}
+
+			g.denylist = denylist.NewDenylist(logger, g.db, g.env, filePath, g.msgC.writeC)
+			if err := g.denylist.Load(); err != nil {
+				return fmt.Errorf("failed to load denylist: %w", err)
+			}
+			g.policyHooks = append(g.policyHooks, g.denylist)
+			g.runnables["denylist"] = g.denylist.Run
+			logger.Info("denylist is enabled", zap.String("file", filePath))
+			return nil
+		}}
+}
+
+// GuardianOptionMissingMessageRecovery periodically looks for VAAs of the known emitters missing from the database,
+// fetches them from peer guardians and requests reobservation of the ones nobody has. If cfg is nil, the recovery
+// service is disabled.
+// Dependencies: db
+func GuardianOptionMissingMessageRecovery(cfg *recovery.Config) *GuardianOption {
+	return &GuardianOption{
+		name:         "missing-message-recovery",
+		dependencies: []string{"db"},
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if cfg == nil {
+				logger.Info("missing message recovery is disabled")
+				return nil
+			}
+
+			service, err := recovery.NewService(logger, g.db, g.gst, g.env, *cfg, g.signedInC.writeC, g.obsvReqSendC.writeC, g.inspectC.writeC)
+			if err != nil {
+				return fmt.Errorf("failed to create missing message recovery service: %w", err)
+			}
+			g.runnables["missing-message-recovery"] = service.Run
+			logger.Info("missing message recovery is enabled",
+				zap.Duration("interval", cfg.Interval),
+				zap.Strings("peers", cfg.Peers),
+				zap.Int("maxPerInterval", cfg.MaxPerInterval),
+			)
+			return nil
+		}}
+}
+
+// GuardianOptionHeightMonitor periodically compares the block heights of our watchers to the median of the heights
+// the other guardians report in their heartbeats, and raises readiness warnings when they deviate by more than the
+// configured threshold. If cfg is nil, the height monitor is disabled. It must be configured before the admin service.
+// Dependencies: none
+func GuardianOptionHeightMonitor(cfg *heightmonitor.Config) *GuardianOption {
+	return &GuardianOption{
+		name: "height-monitor",
+		f: func(ctx context.Context, logger *zap.Logger, g *G) error {
+			if cfg == nil {
+				logger.Info("height monitor is disabled")
+				return nil
+			}
+
+			monitor, err := heightmonitor.NewMonitor(logger, g.gst, ethcrypto.PubkeyToAddress(g.guardianSigner.PublicKey(ctx)), p2p.DefaultRegistry.GetNetworkStats, *cfg)
+			if err != nil {
+				return fmt.Errorf("failed to create height monitor: %w", err)
+			}
+			g.heightMonitor = monitor
+			g.runnables["height-monitor"] = monitor.Run
+			logger.Info("height monitor is enabled",
+				zap.Duration("interval", cfg.Interval),
+				zap.Int64("defaultThreshold", cfg.DefaultThreshold),
+				zap.Int("minGuardians", cfg.MinGuardians),
+			)
+			return nil
+		}}
+}
+
 // GuardianOptionProcessor enables the default processor, which is required to make consensus on messages.
 // Dependencies: db, governor, accountant
 func GuardianOptionProcessor(networkId string) *GuardianOption {
@@ -604,9 +830,14 @@ func GuardianOptionProcessor(networkId string) *GuardianOption {
 				g.gossipVaaSendC,
 				g.batchObsvC.readC,
 				g.obsvReqSendC.writeC,
+				g.obsvFetchC.writeC,
+				g.invalidObsvC.writeC,
 				g.signedInC.readC,
+				g.inspectC.readC,
 				g.guardianSigner,
 				g.gst,
+				g.performance,
+				g.policyHooks,
 				g.gov,
 				g.acct,
 				g.acctC.readC,
@@ -152,19 +152,28 @@ func (ccq *ccqP2p) run(
 		return fmt.Errorf("failed to join topic_resp: %w", err)
 	}
 
-	// We only want to accept messages from peers in the allow list.
-	err = ps.RegisterTopicValidator(topic_req, func(ctx context.Context, from peer.ID, msg *pubsub.Message) bool {
-		if len(ccq.allowedPeers) == 0 {
+	// Peers in the allow list are trusted to send requests at whatever rate they need, everyone else is rate limited.
+	protection := newPeerProtection(ccq.p2pComponents.PeerProtection, func(p peer.ID) bool {
+		if _, found := ccq.allowedPeers[p.String()]; found {
 			return true
 		}
-		if _, found := ccq.allowedPeers[msg.GetFrom().String()]; found {
-			return true
+		return p == ccq.h.ID() || components.ConnMgr.IsProtected(p, "")
+	})
+	go protection.run(ctx)
+	rateLimitValidator := protection.validator(TopicKindCcqReq)
+
+	// We only want to accept messages from peers in the allow list.
+	err = ps.RegisterTopicValidator(topic_req, func(ctx context.Context, from peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		if len(ccq.allowedPeers) != 0 {
+			if _, found := ccq.allowedPeers[msg.GetFrom().String()]; !found {
+				ccq.logger.Debug("Dropping message from unknown peer",
+					zap.String("fromPeerID", from.String()),
+					zap.String("msgPeerID", msg.ReceivedFrom.String()),
+					zap.String("msgFrom", msg.GetFrom().String()))
+				return pubsub.ValidationReject
+			}
 		}
-		ccq.logger.Debug("Dropping message from unknown peer",
-			zap.String("fromPeerID", from.String()),
-			zap.String("msgPeerID", msg.ReceivedFrom.String()),
-			zap.String("msgFrom", msg.GetFrom().String()))
-		return false
+		return rateLimitValidator(ctx, from, msg)
 	})
 	if err != nil {
 		return fmt.Errorf("failed to register message filter: %w", err)
@@ -254,9 +263,11 @@ func (ccq *ccqP2p) publisher(ctx context.Context, guardianSigner guardiansigner.
 				continue
 			}
 			digest := query.GetQueryResponseDigestFromBytes(msgBytes)
-			sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+			sig, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeQueryResponse), digest.Bytes())
 			if err != nil {
-				panic(err)
+				p2pSigningFailures.WithLabelValues("query_response").Inc()
+				ccq.logger.Error("failed to sign query response", zap.Error(err))
+				continue
 			}
 			envelope := &gossipv1.GossipMessage{
 				Message: &gossipv1.GossipMessage_SignedQueryResponse{
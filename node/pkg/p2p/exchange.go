@@ -0,0 +1,424 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/core/protocol"
+	"github.com/libp2p/go-msgio/pbio"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// observationExchangeTimeout is the deadline for a single request / response exchange, including the lookup.
+	observationExchangeTimeout = 5 * time.Second
+
+	// maxObservationExchangeRequestSize and maxObservationExchangeResponseSize limit the size of the messages read from a stream.
+	maxObservationExchangeRequestSize  = 1024
+	maxObservationExchangeResponseSize = 1024 * 1024
+
+	// observationExchangeRateLimit and observationExchangeBurst limit how many requests each guardian may send us.
+	observationExchangeRateLimit = 10
+	observationExchangeBurst     = 50
+
+	// maxConcurrentObservationFetches limits the number of fetch requests that are worked on at the same time.
+	maxConcurrentObservationFetches = 10
+)
+
+// Request types and statuses used in the observation exchange metrics.
+const (
+	exchangeTypeObservation = "observation"
+	exchangeTypeVAA         = "vaa"
+	exchangeTypeInvalid     = "invalid"
+
+	exchangeStatusOK           = "ok"
+	exchangeStatusNotFound     = "not_found"
+	exchangeStatusError        = "error"
+	exchangeStatusUnauthorized = "unauthorized"
+	exchangeStatusRateLimited  = "rate_limited"
+	exchangeStatusNoPeer       = "no_peer"
+)
+
+var (
+	observationExchangeServed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_observation_exchange_requests_served_total",
+			Help: "Total number of observation exchange requests received from other guardians",
+		}, []string{"type", "status"})
+	observationExchangeSent = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_observation_exchange_requests_sent_total",
+			Help: "Total number of observation exchange requests sent to other guardians",
+		}, []string{"type", "status"})
+	observationFetchesDropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_observation_fetches_dropped_total",
+			Help: "Total number of observation fetch requests dropped because too many were in flight",
+		})
+)
+
+// errExchangeNotFound is returned by `observationExchange.request` if the peer does not know the requested data.
+var errExchangeNotFound = errors.New("not found")
+
+// exchangeNotFound is the error string sent to the requestor if the requested data is not known.
+const exchangeNotFound = "not found"
+
+// ObservationLookupFunc returns our own signed observation of the given digest, or nil if we don't have one.
+type ObservationLookupFunc func(ctx context.Context, digest []byte) (*gossipv1.Observation, error)
+
+// VAALookupFunc returns the serialized signed VAA with the given message ID, or nil if we don't have it.
+type VAALookupFunc func(ctx context.Context, messageID string) ([]byte, error)
+
+// ObservationFetchRequest asks the observation exchange to fetch data directly from the given guardians rather than
+// waiting for it to be rebroadcast on gossip. Exactly one of Digest and MessageID must be set. For a digest, the
+// signed observation of every listed guardian is requested. For a message ID, the guardians are asked in order until
+// one of them returns the signed VAA.
+type ObservationFetchRequest struct {
+	Digest    []byte
+	MessageID string
+	Guardians []eth_common.Address
+}
+
+// ObservationExchangeProtocolID returns the libp2p protocol ID of the observation exchange on the given network.
+func ObservationExchangeProtocolID(networkID string) protocol.ID {
+	return protocol.ID(networkID + "/observation_exchange/1")
+}
+
+// observationExchange implements a request / response protocol between guardians to fetch signed observations and
+// signed VAAs directly from specific peers. Peers are authenticated by mapping their peer ID to a guardian address
+// using the heartbeats, and only guardians of the current guardian set are served.
+type observationExchange struct {
+	ctx        context.Context
+	logger     *zap.Logger
+	h          host.Host
+	gst        *common.GuardianSetState
+	protocolID protocol.ID
+
+	lookupObservation ObservationLookupFunc
+	lookupVAA         VAALookupFunc
+
+	// batchObsvRecvC and signedVaaRecvC receive the fetched observations and VAAs.
+	batchObsvRecvC chan<- *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch]
+	signedVaaRecvC chan<- *gossipv1.SignedVAAWithQuorum
+
+	limitersMu sync.Mutex
+	limiters   map[peer.ID]*rate.Limiter
+}
+
+func newObservationExchange(
+	ctx context.Context,
+	logger *zap.Logger,
+	h host.Host,
+	networkID string,
+	gst *common.GuardianSetState,
+	lookupObservation ObservationLookupFunc,
+	lookupVAA VAALookupFunc,
+	batchObsvRecvC chan<- *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch],
+	signedVaaRecvC chan<- *gossipv1.SignedVAAWithQuorum,
+) *observationExchange {
+	return &observationExchange{
+		ctx:               ctx,
+		logger:            logger.With(zap.String("component", "observation_exchange")),
+		h:                 h,
+		gst:               gst,
+		protocolID:        ObservationExchangeProtocolID(networkID),
+		lookupObservation: lookupObservation,
+		lookupVAA:         lookupVAA,
+		batchObsvRecvC:    batchObsvRecvC,
+		signedVaaRecvC:    signedVaaRecvC,
+		limiters:          make(map[peer.ID]*rate.Limiter),
+	}
+}
+
+// start registers the stream handler if we are able to serve requests.
+func (e *observationExchange) start() {
+	if e.lookupObservation != nil || e.lookupVAA != nil {
+		e.logger.Info("serving observation exchange requests", zap.String("protocol", string(e.protocolID)))
+		e.h.SetStreamHandler(e.protocolID, e.handleStream)
+	}
+}
+
+func (e *observationExchange) stop() {
+	e.h.RemoveStreamHandler(e.protocolID)
+}
+
+// guardianForPeer returns the guardian address a peer belongs to if it is a member of the current guardian set.
+func (e *observationExchange) guardianForPeer(p peer.ID) (eth_common.Address, bool) {
+	gs := e.gst.Get()
+	if gs == nil {
+		return eth_common.Address{}, false
+	}
+	for addr, peers := range e.gst.GetAll() {
+		if _, ok := peers[p]; ok {
+			if _, ok := gs.KeyIndex(addr); ok {
+				return addr, true
+			}
+		}
+	}
+	return eth_common.Address{}, false
+}
+
+// allow applies the per peer rate limit to incoming requests.
+func (e *observationExchange) allow(p peer.ID) bool {
+	e.limitersMu.Lock()
+	defer e.limitersMu.Unlock()
+	limiter, exists := e.limiters[p]
+	if !exists {
+		limiter = rate.NewLimiter(observationExchangeRateLimit, observationExchangeBurst)
+		e.limiters[p] = limiter
+	}
+	return limiter.Allow()
+}
+
+// handleStream serves a single request from another guardian.
+func (e *observationExchange) handleStream(s network.Stream) {
+	defer s.Close()
+	remote := s.Conn().RemotePeer()
+
+	// Only guardians are allowed to use the protocol. This also bounds the number of rate limiters we keep.
+	addr, ok := e.guardianForPeer(remote)
+	if !ok {
+		observationExchangeServed.WithLabelValues(exchangeTypeInvalid, exchangeStatusUnauthorized).Inc()
+		e.logger.Debug("rejecting observation exchange request from unknown peer", zap.Stringer("peer", remote))
+		_ = s.Reset()
+		return
+	}
+	if !e.allow(remote) {
+		observationExchangeServed.WithLabelValues(exchangeTypeInvalid, exchangeStatusRateLimited).Inc()
+		_ = s.Reset()
+		return
+	}
+
+	if err := s.SetDeadline(time.Now().Add(observationExchangeTimeout)); err != nil {
+		e.logger.Debug("failed to set stream deadline", zap.Error(err))
+	}
+
+	var req gossipv1.ObservationExchangeRequest
+	if err := pbio.NewDelimitedReader(s, maxObservationExchangeRequestSize).ReadMsg(&req); err != nil {
+		observationExchangeServed.WithLabelValues(exchangeTypeInvalid, exchangeStatusError).Inc()
+		e.logger.Debug("failed to read observation exchange request", zap.Stringer("peer", remote), zap.Error(err))
+		_ = s.Reset()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(e.ctx, observationExchangeTimeout)
+	defer cancel()
+	reqType, resp := e.serve(ctx, &req)
+	status := exchangeStatusOK
+	switch {
+	case resp.GetError() == exchangeNotFound:
+		status = exchangeStatusNotFound
+	case resp.GetError() != "":
+		status = exchangeStatusError
+	}
+	observationExchangeServed.WithLabelValues(reqType, status).Inc()
+
+	if err := pbio.NewDelimitedWriter(s).WriteMsg(resp); err != nil {
+		e.logger.Debug("failed to write observation exchange response", zap.Stringer("peer", remote), zap.Error(err))
+		_ = s.Reset()
+		return
+	}
+
+	e.logger.Debug("served observation exchange request",
+		zap.Stringer("peer", remote),
+		zap.String("guardian", addr.Hex()),
+		zap.String("type", reqType),
+		zap.String("status", status),
+	)
+}
+
+// serve looks up the requested data and returns the request type and the response.
+func (e *observationExchange) serve(ctx context.Context, req *gossipv1.ObservationExchangeRequest) (string, *gossipv1.ObservationExchangeResponse) {
+	errorResponse := func(msg string) *gossipv1.ObservationExchangeResponse {
+		return &gossipv1.ObservationExchangeResponse{Response: &gossipv1.ObservationExchangeResponse_Error{Error: msg}}
+	}
+
+	switch r := req.Request.(type) {
+	case *gossipv1.ObservationExchangeRequest_Digest:
+		if e.lookupObservation == nil {
+			return exchangeTypeObservation, errorResponse("observation lookups are not supported")
+		}
+		obs, err := e.lookupObservation(ctx, r.Digest)
+		if err != nil {
+			e.logger.Warn("failed to look up observation", zap.Error(err))
+			return exchangeTypeObservation, errorResponse("lookup failed")
+		}
+		if obs == nil {
+			return exchangeTypeObservation, errorResponse(exchangeNotFound)
+		}
+		return exchangeTypeObservation, &gossipv1.ObservationExchangeResponse{Response: &gossipv1.ObservationExchangeResponse_Observation{Observation: obs}}
+	case *gossipv1.ObservationExchangeRequest_MessageId:
+		if e.lookupVAA == nil {
+			return exchangeTypeVAA, errorResponse("VAA lookups are not supported")
+		}
+		b, err := e.lookupVAA(ctx, r.MessageId)
+		if err != nil {
+			e.logger.Warn("failed to look up VAA", zap.String("message_id", r.MessageId), zap.Error(err))
+			return exchangeTypeVAA, errorResponse("lookup failed")
+		}
+		if b == nil {
+			return exchangeTypeVAA, errorResponse(exchangeNotFound)
+		}
+		return exchangeTypeVAA, &gossipv1.ObservationExchangeResponse{Response: &gossipv1.ObservationExchangeResponse_Vaa{Vaa: b}}
+	default:
+		return exchangeTypeInvalid, errorResponse("invalid request")
+	}
+}
+
+// request sends a request to a peer and waits for the response.
+func (e *observationExchange) request(ctx context.Context, p peer.ID, req *gossipv1.ObservationExchangeRequest) (*gossipv1.ObservationExchangeResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, observationExchangeTimeout)
+	defer cancel()
+
+	s, err := e.h.NewStream(ctx, p, e.protocolID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open stream: %w", err)
+	}
+	defer s.Close()
+
+	deadline, _ := ctx.Deadline()
+	if err := s.SetDeadline(deadline); err != nil {
+		e.logger.Debug("failed to set stream deadline", zap.Error(err))
+	}
+
+	if err := pbio.NewDelimitedWriter(s).WriteMsg(req); err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("failed to write request: %w", err)
+	}
+	if err := s.CloseWrite(); err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("failed to close stream for writing: %w", err)
+	}
+
+	var resp gossipv1.ObservationExchangeResponse
+	if err := pbio.NewDelimitedReader(s, maxObservationExchangeResponseSize).ReadMsg(&resp); err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if msg := resp.GetError(); msg != "" {
+		if msg == exchangeNotFound {
+			return nil, errExchangeNotFound
+		}
+		return nil, fmt.Errorf("peer returned an error: %s", msg)
+	}
+
+	return &resp, nil
+}
+
+// requestFromGuardian sends a request to the peers of a guardian, as known from its heartbeats, until one of them answers.
+func (e *observationExchange) requestFromGuardian(ctx context.Context, reqType string, addr eth_common.Address, req *gossipv1.ObservationExchangeRequest) *gossipv1.ObservationExchangeResponse {
+	peers := e.gst.LastHeartbeat(addr)
+	if len(peers) == 0 {
+		observationExchangeSent.WithLabelValues(reqType, exchangeStatusNoPeer).Inc()
+		return nil
+	}
+
+	for p := range peers {
+		if p == e.h.ID() {
+			continue
+		}
+		resp, err := e.request(ctx, p, req)
+		if err == nil {
+			observationExchangeSent.WithLabelValues(reqType, exchangeStatusOK).Inc()
+			return resp
+		}
+		if errors.Is(err, errExchangeNotFound) {
+			observationExchangeSent.WithLabelValues(reqType, exchangeStatusNotFound).Inc()
+			return nil
+		}
+		observationExchangeSent.WithLabelValues(reqType, exchangeStatusError).Inc()
+		e.logger.Debug("observation exchange request failed",
+			zap.Stringer("peer", p),
+			zap.String("guardian", addr.Hex()),
+			zap.String("type", reqType),
+			zap.Error(err),
+		)
+	}
+
+	return nil
+}
+
+// fetch works on a single fetch request and feeds the results into the receive channels, as if they had been received on gossip.
+func (e *observationExchange) fetch(ctx context.Context, fetch *ObservationFetchRequest) {
+	if fetch.MessageID != "" {
+		req := &gossipv1.ObservationExchangeRequest{Request: &gossipv1.ObservationExchangeRequest_MessageId{MessageId: fetch.MessageID}}
+		for _, addr := range fetch.Guardians {
+			resp := e.requestFromGuardian(ctx, exchangeTypeVAA, addr, req)
+			if resp == nil {
+				continue
+			}
+
+			// The signatures are verified by the processor, but make sure we got what we asked for.
+			v, err := vaa.Unmarshal(resp.GetVaa())
+			if err != nil || v.MessageID() != fetch.MessageID {
+				e.logger.Warn("guardian returned an unexpected VAA", zap.String("guardian", addr.Hex()), zap.String("message_id", fetch.MessageID), zap.Error(err))
+				continue
+			}
+
+			select {
+			case e.signedVaaRecvC <- &gossipv1.SignedVAAWithQuorum{Vaa: resp.GetVaa()}:
+			default:
+				p2pReceiveChannelOverflow.WithLabelValues("signed_vaa_with_quorum").Inc()
+			}
+			return
+		}
+		return
+	}
+
+	req := &gossipv1.ObservationExchangeRequest{Request: &gossipv1.ObservationExchangeRequest_Digest{Digest: fetch.Digest}}
+	for _, addr := range fetch.Guardians {
+		resp := e.requestFromGuardian(ctx, exchangeTypeObservation, addr, req)
+		if resp == nil {
+			continue
+		}
+
+		// The signature is verified by the processor, but make sure we got what we asked for.
+		obs := resp.GetObservation()
+		if obs == nil || !bytes.Equal(obs.Hash, fetch.Digest) {
+			e.logger.Warn("guardian returned an unexpected observation", zap.String("guardian", addr.Hex()))
+			continue
+		}
+
+		batch := &gossipv1.SignedObservationBatch{Addr: addr.Bytes(), Observations: []*gossipv1.Observation{obs}}
+		if err := common.PostMsgWithTimestamp(batch, e.batchObsvRecvC); err != nil {
+			p2pReceiveChannelOverflow.WithLabelValues("batch_observation").Inc()
+		}
+	}
+}
+
+// runFetcher works on the fetch requests posted to fetchC with bounded concurrency.
+func (e *observationExchange) runFetcher(ctx context.Context, fetchC <-chan *ObservationFetchRequest) {
+	sem := make(chan struct{}, maxConcurrentObservationFetches)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case fetch := <-fetchC:
+			select {
+			case sem <- struct{}{}:
+			default:
+				observationFetchesDropped.Inc()
+				continue
+			}
+			go func() {
+				defer func() { <-sem }()
+				e.fetch(ctx, fetch)
+			}()
+		}
+	}
+}
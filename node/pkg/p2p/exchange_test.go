@@ -0,0 +1,120 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	exchangeServerAddr = eth_common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	exchangeClientAddr = eth_common.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157")
+)
+
+func newExchangeTestHost(t *testing.T) host.Host {
+	t.Helper()
+	h, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { h.Close() })
+	return h
+}
+
+// newExchangeTestGuardianSetState returns a guardian set state with both test guardians and a heartbeat of the given peer.
+func newExchangeTestGuardianSetState(t *testing.T, addr eth_common.Address, p peer.ID) *common.GuardianSetState {
+	t.Helper()
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(common.NewGuardianSet([]eth_common.Address{exchangeServerAddr, exchangeClientAddr}, 0))
+	require.NoError(t, gst.SetHeartbeat(addr, p, &gossipv1.Heartbeat{NodeName: addr.Hex()}))
+	return gst
+}
+
+func TestObservationExchange(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := newExchangeTestHost(t)
+	client := newExchangeTestHost(t)
+	client.Peerstore().AddAddrs(server.ID(), server.Addrs(), time.Hour)
+
+	digest := []byte{1, 2, 3}
+	obs := &gossipv1.Observation{Hash: digest, Signature: []byte{4, 5, 6}, MessageId: "2/0000000000000000000000003ee18b2214aff97000d974cf647e7c347e8fa585/7"}
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   vaa.Address{1},
+		Sequence:         7,
+		ConsistencyLevel: 32,
+		Payload:          []byte{1},
+	}
+	vaaBytes, err := v.Marshal()
+	require.NoError(t, err)
+
+	serverExchange := newObservationExchange(ctx, zap.NewNop(), server, networkId, newExchangeTestGuardianSetState(t, exchangeClientAddr, client.ID()),
+		func(ctx context.Context, d []byte) (*gossipv1.Observation, error) {
+			if string(d) == string(digest) {
+				return obs, nil
+			}
+			return nil, nil
+		},
+		func(ctx context.Context, messageID string) ([]byte, error) {
+			if messageID == v.MessageID() {
+				return vaaBytes, nil
+			}
+			return nil, nil
+		},
+		nil, nil,
+	)
+	serverExchange.start()
+	defer serverExchange.stop()
+
+	batchObsvC := make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch], 10)
+	signedVaaC := make(chan *gossipv1.SignedVAAWithQuorum, 10)
+	clientExchange := newObservationExchange(ctx, zap.NewNop(), client, networkId, newExchangeTestGuardianSetState(t, exchangeServerAddr, server.ID()), nil, nil, batchObsvC, signedVaaC)
+
+	// Observations are published as if they were received in a batch from the guardian.
+	clientExchange.fetch(ctx, &ObservationFetchRequest{Digest: digest, Guardians: []eth_common.Address{exchangeServerAddr}})
+	require.Len(t, batchObsvC, 1)
+	batch := <-batchObsvC
+	assert.Equal(t, exchangeServerAddr.Bytes(), batch.Msg.Addr)
+	require.Len(t, batch.Msg.Observations, 1)
+	assert.Equal(t, obs.Signature, batch.Msg.Observations[0].Signature)
+
+	clientExchange.fetch(ctx, &ObservationFetchRequest{MessageID: v.MessageID(), Guardians: []eth_common.Address{exchangeClientAddr, exchangeServerAddr}})
+	require.Len(t, signedVaaC, 1)
+	assert.Equal(t, vaaBytes, (<-signedVaaC).Vaa)
+
+	// Unknown data is reported as not found.
+	_, err = clientExchange.request(ctx, server.ID(), &gossipv1.ObservationExchangeRequest{Request: &gossipv1.ObservationExchangeRequest_Digest{Digest: []byte{9}}})
+	assert.ErrorIs(t, err, errExchangeNotFound)
+	clientExchange.fetch(ctx, &ObservationFetchRequest{MessageID: "2/0000000000000000000000000000000000000000000000000000000000000004/1", Guardians: []eth_common.Address{exchangeServerAddr}})
+	assert.Empty(t, signedVaaC)
+
+	// Peers that are not known guardians are not served.
+	stranger := newExchangeTestHost(t)
+	stranger.Peerstore().AddAddrs(server.ID(), server.Addrs(), time.Hour)
+	strangerExchange := newObservationExchange(ctx, zap.NewNop(), stranger, networkId, newExchangeTestGuardianSetState(t, exchangeServerAddr, server.ID()), nil, nil, batchObsvC, signedVaaC)
+	_, err = strangerExchange.request(ctx, server.ID(), &gossipv1.ObservationExchangeRequest{Request: &gossipv1.ObservationExchangeRequest_Digest{Digest: digest}})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, errExchangeNotFound)
+}
+
+func TestObservationExchangeRateLimit(t *testing.T) {
+	e := newObservationExchange(context.Background(), zap.NewNop(), nil, networkId, common.NewGuardianSetState(nil), nil, nil, nil, nil)
+	for i := 0; i < observationExchangeBurst; i++ {
+		assert.True(t, e.allow(testPeer))
+	}
+	assert.False(t, e.allow(testPeer))
+	assert.True(t, e.allow(testOtherPeer))
+}
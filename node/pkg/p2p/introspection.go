@@ -0,0 +1,350 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	libp2ppb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/metrics"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
+)
+
+const (
+	// maxRecentDisconnects is the number of disconnects kept by the `Introspector`.
+	maxRecentDisconnects = 100
+
+	// peerScoreInspectInterval is how often the GossipSub peer scores are sampled when peer scoring is enabled.
+	peerScoreInspectInterval = 10 * time.Second
+
+	// bandwidthIdleTimeout is how long the bandwidth counters of a peer are kept after it was last active.
+	bandwidthIdleTimeout = time.Hour
+
+	// trimDetectionWindow is how long after a connection manager trim a disconnect is attributed to the trim.
+	trimDetectionWindow = 5 * time.Second
+)
+
+// Disconnect reasons. libp2p does not report why a connection was closed, so these are best effort.
+const (
+	DisconnectReasonTrimmed  = "trimmed"
+	DisconnectReasonShutdown = "shutdown"
+	DisconnectReasonClosed   = "closed"
+)
+
+// The tags peers are protected with in the connection manager.
+var protectionTags = []string{"configured", "heartbeat"}
+
+// ErrP2PNotRunning is returned by the `Introspector` when the p2p service is not (or no longer) running.
+var ErrP2PNotRunning = errors.New("p2p is not running")
+
+// PeerStatus describes a connected peer.
+type PeerStatus struct {
+	PeerID peer.ID
+	// GuardianAddr and NodeName are taken from the heartbeats of the peer. They are empty if the peer is not a known guardian.
+	GuardianAddr   string
+	NodeName       string
+	ProtectionTags []string
+	Addrs          []string
+	// Direction and ConnectedSince refer to the oldest open connection to the peer.
+	Direction        network.Direction
+	ConnectedSince   time.Time
+	Latency          time.Duration
+	SubscribedTopics []string
+	MeshTopics       []string
+	BytesIn          uint64
+	BytesOut         uint64
+	MessagesIn       uint64
+	MessagesOut      uint64
+	// Score is only set if peer scoring is enabled.
+	Score float64
+}
+
+// DisconnectStatus describes a closed connection.
+type DisconnectStatus struct {
+	PeerID         peer.ID
+	GuardianAddr   string
+	NodeName       string
+	Addr           string
+	DisconnectedAt time.Time
+	ConnectedFor   time.Duration
+	Reason         string
+}
+
+// NetworkStatus is a snapshot of the state of the gossip network.
+type NetworkStatus struct {
+	PeerID            peer.ID
+	ListenAddrs       []string
+	MeshSizes         map[string]int
+	PeerScoring       bool
+	Peers             []PeerStatus
+	RecentDisconnects []DisconnectStatus
+}
+
+// Introspector exposes the libp2p state of a running `p2p.Run` for debugging. It is passed in through the `Components`.
+// Topics are reported by their kind (see the TopicKind constants).
+type Introspector struct {
+	bwc *metrics.BandwidthCounter
+
+	mu          sync.Mutex
+	ctx         context.Context
+	h           host.Host
+	ps          *pubsub.PubSub
+	connMgr     *connmgr.BasicConnMgr
+	gst         *common.GuardianSetState
+	topics      map[string]string // topic name -> topic kind
+	peerScoring bool
+	scores      map[peer.ID]float64
+	mesh        map[string]map[peer.ID]struct{} // topic name -> peers in our mesh
+	messagesIn  map[peer.ID]uint64
+	messagesOut map[peer.ID]uint64
+	disconnects []DisconnectStatus
+}
+
+func NewIntrospector() *Introspector {
+	return &Introspector{
+		bwc: metrics.NewBandwidthCounter(),
+	}
+}
+
+// start is called by `p2p.Run` once the host and pubsub are set up. It resets any state from a previous run.
+func (i *Introspector) start(ctx context.Context, h host.Host, ps *pubsub.PubSub, connMgr *connmgr.BasicConnMgr, gst *common.GuardianSetState, topics map[string]string, peerScoring bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.ctx = ctx
+	i.h = h
+	i.ps = ps
+	i.connMgr = connMgr
+	i.gst = gst
+	i.topics = topics
+	i.peerScoring = peerScoring
+	i.scores = make(map[peer.ID]float64)
+	i.mesh = make(map[string]map[peer.ID]struct{})
+	i.messagesIn = make(map[peer.ID]uint64)
+	i.messagesOut = make(map[peer.ID]uint64)
+
+	h.Network().Notify(&network.NotifyBundle{DisconnectedF: i.disconnected})
+}
+
+// stop is called when `p2p.Run` exits.
+func (i *Introspector) stop() {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.h = nil
+	i.ps = nil
+}
+
+// run periodically trims the bandwidth counters of peers that have been idle for a while until the context is canceled.
+func (i *Introspector) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			i.bwc.TrimIdle(time.Now().Add(-bandwidthIdleTimeout))
+		}
+	}
+}
+
+// trace updates the mesh membership and message counters from the GossipSub trace events.
+func (i *Introspector) trace(evt *libp2ppb.TraceEvent) {
+	if evt.Type == nil {
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.mesh == nil {
+		return
+	}
+
+	switch *evt.Type {
+	case libp2ppb.TraceEvent_GRAFT:
+		if p, err := peer.IDFromBytes(evt.GetGraft().GetPeerID()); err == nil {
+			topic := evt.GetGraft().GetTopic()
+			if _, exists := i.mesh[topic]; !exists {
+				i.mesh[topic] = make(map[peer.ID]struct{})
+			}
+			i.mesh[topic][p] = struct{}{}
+		}
+	case libp2ppb.TraceEvent_PRUNE:
+		if p, err := peer.IDFromBytes(evt.GetPrune().GetPeerID()); err == nil {
+			delete(i.mesh[evt.GetPrune().GetTopic()], p)
+		}
+	case libp2ppb.TraceEvent_REMOVE_PEER:
+		if p, err := peer.IDFromBytes(evt.GetRemovePeer().GetPeerID()); err == nil {
+			for _, peers := range i.mesh {
+				delete(peers, p)
+			}
+			delete(i.messagesIn, p)
+			delete(i.messagesOut, p)
+		}
+	case libp2ppb.TraceEvent_RECV_RPC:
+		if p, err := peer.IDFromBytes(evt.GetRecvRPC().GetReceivedFrom()); err == nil {
+			i.messagesIn[p] += uint64(len(evt.GetRecvRPC().GetMeta().GetMessages()))
+		}
+	case libp2ppb.TraceEvent_SEND_RPC:
+		if p, err := peer.IDFromBytes(evt.GetSendRPC().GetSendTo()); err == nil {
+			i.messagesOut[p] += uint64(len(evt.GetSendRPC().GetMeta().GetMessages()))
+		}
+	}
+}
+
+// inspectScores is the GossipSub peer score inspection callback.
+func (i *Introspector) inspectScores(scores map[peer.ID]float64) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.scores = scores
+}
+
+// disconnected is the libp2p network notification callback for closed connections.
+func (i *Introspector) disconnected(_ network.Network, conn network.Conn) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.h == nil {
+		return
+	}
+
+	now := time.Now()
+	reason := DisconnectReasonClosed
+	if i.ctx.Err() != nil {
+		reason = DisconnectReasonShutdown
+	} else if i.connMgr != nil && now.Sub(i.connMgr.GetInfo().LastTrim) < trimDetectionWindow {
+		reason = DisconnectReasonTrimmed
+	}
+
+	p := conn.RemotePeer()
+	guardianAddr, nodeName := i.guardianIdentities()[p].get()
+	i.disconnects = append(i.disconnects, DisconnectStatus{
+		PeerID:         p,
+		GuardianAddr:   guardianAddr,
+		NodeName:       nodeName,
+		Addr:           conn.RemoteMultiaddr().String(),
+		DisconnectedAt: now,
+		ConnectedFor:   now.Sub(conn.Stat().Opened),
+		Reason:         reason,
+	})
+	if len(i.disconnects) > maxRecentDisconnects {
+		i.disconnects = i.disconnects[len(i.disconnects)-maxRecentDisconnects:]
+	}
+}
+
+// guardianIdentity is the identity of a guardian peer as advertised in its heartbeats.
+type guardianIdentity struct {
+	addr     eth_common.Address
+	nodeName string
+}
+
+func (g *guardianIdentity) get() (string, string) {
+	if g == nil {
+		return "", ""
+	}
+	return g.addr.Hex(), g.nodeName
+}
+
+// guardianIdentities maps peer IDs to guardian identities using the heartbeats in the guardian set state.
+func (i *Introspector) guardianIdentities() map[peer.ID]*guardianIdentity {
+	ret := make(map[peer.ID]*guardianIdentity)
+	if i.gst == nil {
+		return ret
+	}
+	for addr, heartbeats := range i.gst.GetAll() {
+		for p, hb := range heartbeats {
+			ret[p] = &guardianIdentity{addr: addr, nodeName: hb.NodeName}
+		}
+	}
+	return ret
+}
+
+// Status returns a snapshot of the state of the gossip network.
+func (i *Introspector) Status() (*NetworkStatus, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.h == nil {
+		return nil, ErrP2PNotRunning
+	}
+
+	status := &NetworkStatus{
+		PeerID:            i.h.ID(),
+		MeshSizes:         make(map[string]int),
+		PeerScoring:       i.peerScoring,
+		Peers:             []PeerStatus{},
+		RecentDisconnects: make([]DisconnectStatus, len(i.disconnects)),
+	}
+	copy(status.RecentDisconnects, i.disconnects)
+
+	for _, addr := range i.h.Addrs() {
+		status.ListenAddrs = append(status.ListenAddrs, addr.String())
+	}
+
+	subscribed := make(map[peer.ID][]string)
+	for topic, kind := range i.topics {
+		status.MeshSizes[kind] = len(i.mesh[topic])
+		for _, p := range i.ps.ListPeers(topic) {
+			subscribed[p] = append(subscribed[p], kind)
+		}
+	}
+
+	identities := i.guardianIdentities()
+	for _, p := range i.h.Network().Peers() {
+		conns := i.h.Network().ConnsToPeer(p)
+		if len(conns) == 0 {
+			continue
+		}
+
+		ps := PeerStatus{
+			PeerID:           p,
+			ProtectionTags:   []string{},
+			Latency:          i.h.Peerstore().LatencyEWMA(p),
+			SubscribedTopics: subscribed[p],
+			MeshTopics:       []string{},
+			MessagesIn:       i.messagesIn[p],
+			MessagesOut:      i.messagesOut[p],
+			Score:            i.scores[p],
+		}
+		ps.GuardianAddr, ps.NodeName = identities[p].get()
+
+		for _, tag := range protectionTags {
+			if i.connMgr != nil && i.connMgr.IsProtected(p, tag) {
+				ps.ProtectionTags = append(ps.ProtectionTags, tag)
+			}
+		}
+
+		for _, conn := range conns {
+			ps.Addrs = append(ps.Addrs, conn.RemoteMultiaddr().String())
+			if ps.ConnectedSince.IsZero() || conn.Stat().Opened.Before(ps.ConnectedSince) {
+				ps.ConnectedSince = conn.Stat().Opened
+				ps.Direction = conn.Stat().Direction
+			}
+		}
+
+		for topic, kind := range i.topics {
+			if _, inMesh := i.mesh[topic][p]; inMesh {
+				ps.MeshTopics = append(ps.MeshTopics, kind)
+			}
+		}
+		sort.Strings(ps.SubscribedTopics)
+		sort.Strings(ps.MeshTopics)
+
+		bw := i.bwc.GetBandwidthForPeer(p)
+		ps.BytesIn = uint64(bw.TotalIn)   // #nosec G115 -- Bandwidth totals are never negative
+		ps.BytesOut = uint64(bw.TotalOut) // #nosec G115 -- Bandwidth totals are never negative
+
+		status.Peers = append(status.Peers, ps)
+	}
+
+	sort.Slice(status.Peers, func(a, b int) bool {
+		return status.Peers[a].PeerID < status.Peers[b].PeerID
+	})
+
+	return status, nil
+}
@@ -0,0 +1,122 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	eth_common "github.com/ethereum/go-ethereum/common"
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIntrospectorNotRunning(t *testing.T) {
+	i := NewIntrospector()
+	_, err := i.Status()
+	assert.ErrorIs(t, err, ErrP2PNotRunning)
+}
+
+func TestIntrospectorStatus(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const topic = "/wormhole/test/control"
+	i := NewIntrospector()
+
+	mgr, err := connmgr.NewConnManager(LowWaterMarkDefault, HighWaterMarkDefault)
+	require.NoError(t, err)
+	h1, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"), libp2p.ConnectionManager(mgr), libp2p.BandwidthReporter(i.bwc))
+	require.NoError(t, err)
+	defer h1.Close()
+	h2, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	defer h2.Close()
+
+	ps1, err := pubsub.NewGossipSub(ctx, h1, pubsub.WithEventTracer(&traceHandler{introspector: i}))
+	require.NoError(t, err)
+	ps2, err := pubsub.NewGossipSub(ctx, h2)
+	require.NoError(t, err)
+
+	t1, err := ps1.Join(topic)
+	require.NoError(t, err)
+	sub1, err := t1.Subscribe()
+	require.NoError(t, err)
+	defer sub1.Cancel()
+	t2, err := ps2.Join(topic)
+	require.NoError(t, err)
+	sub2, err := t2.Subscribe()
+	require.NoError(t, err)
+	defer sub2.Cancel()
+
+	// h2 is a known guardian.
+	guardianAddr := eth_common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5")
+	gst := common.NewGuardianSetState(nil)
+	require.NoError(t, gst.SetHeartbeat(guardianAddr, h2.ID(), &gossipv1.Heartbeat{NodeName: "guardian-2"}))
+	mgr.Protect(h2.ID(), "heartbeat")
+
+	i.start(ctx, h1, ps1, mgr, gst, map[string]string{topic: TopicKindControl}, false)
+
+	require.NoError(t, h1.Connect(ctx, peer.AddrInfo{ID: h2.ID(), Addrs: h2.Addrs()}))
+
+	// Wait for h2 to be grafted into our mesh, then have it send us a message.
+	require.Eventually(t, func() bool {
+		st, err := i.Status()
+		require.NoError(t, err)
+		return st.MeshSizes[TopicKindControl] == 1
+	}, 10*time.Second, 50*time.Millisecond)
+	require.NoError(t, t2.Publish(ctx, []byte("hello")))
+	_, err = sub1.Next(ctx)
+	require.NoError(t, err)
+
+	st, err := i.Status()
+	require.NoError(t, err)
+	assert.Equal(t, h1.ID(), st.PeerID)
+	assert.NotEmpty(t, st.ListenAddrs)
+	assert.False(t, st.PeerScoring)
+	require.Len(t, st.Peers, 1)
+	p := st.Peers[0]
+	assert.Equal(t, h2.ID(), p.PeerID)
+	assert.Equal(t, guardianAddr.Hex(), p.GuardianAddr)
+	assert.Equal(t, "guardian-2", p.NodeName)
+	assert.Equal(t, []string{"heartbeat"}, p.ProtectionTags)
+	assert.Equal(t, network.DirOutbound, p.Direction)
+	assert.False(t, p.ConnectedSince.IsZero())
+	assert.Equal(t, []string{TopicKindControl}, p.SubscribedTopics)
+	assert.Equal(t, []string{TopicKindControl}, p.MeshTopics)
+	assert.Equal(t, uint64(1), p.MessagesIn)
+	assert.Empty(t, st.RecentDisconnects)
+
+	// The bandwidth meters are updated periodically.
+	require.Eventually(t, func() bool {
+		st, err := i.Status()
+		require.NoError(t, err)
+		return len(st.Peers) == 1 && st.Peers[0].BytesIn != 0 && st.Peers[0].BytesOut != 0
+	}, 10*time.Second, 100*time.Millisecond)
+
+	// Disconnects are recorded along with the guardian identity of the peer.
+	require.NoError(t, h1.Network().ClosePeer(h2.ID()))
+	require.Eventually(t, func() bool {
+		st, err := i.Status()
+		require.NoError(t, err)
+		return len(st.RecentDisconnects) == 1
+	}, 10*time.Second, 50*time.Millisecond)
+
+	st, err = i.Status()
+	require.NoError(t, err)
+	d := st.RecentDisconnects[0]
+	assert.Equal(t, h2.ID(), d.PeerID)
+	assert.Equal(t, guardianAddr.Hex(), d.GuardianAddr)
+	assert.Equal(t, "guardian-2", d.NodeName)
+	assert.Equal(t, DisconnectReasonClosed, d.Reason)
+
+	i.stop()
+	_, err = i.Status()
+	assert.ErrorIs(t, err, ErrP2PNotRunning)
+}
@@ -80,10 +80,20 @@ var (
 			Name: "wormhole_p2p_drops",
 			Help: "Total number of messages that were dropped by libp2p",
 		})
+	p2pSigningFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_signing_failures_total",
+			Help: "Total number of p2p messages that were not sent because the guardian signer failed to sign them",
+		}, []string{"type"})
 )
 
 var heartbeatMessagePrefix = []byte("heartbeat|")
 
+// errInvalidSignature is returned when a signed gossip message is not signed by the guardian it claims to come from.
+// Messages from guardians that are not in our guardian set are not treated as invalid signatures, since it may be our
+// guardian set that is outdated.
+var errInvalidSignature = errors.New("invalid signature")
+
 var signedObservationRequestPrefix = []byte("signed_observation_request|")
 
 // heartbeatMaxTimeDifference specifies the maximum time difference between the local clock and the timestamp in incoming heartbeat messages. Heartbeats that are this old or this much into the future will be dropped. This value should encompass clock skew and network delay.
@@ -119,6 +129,10 @@ type Components struct {
 	GossipParams pubsub.GossipSubParams
 	// GossipAdvertiseAddress is an override for the external IP advertised via p2p to other peers.
 	GossipAdvertiseAddress string
+	// PeerProtection configures the per peer rate limits and peer scoring applied to incoming gossip.
+	PeerProtection PeerProtectionParams
+	// Introspector is optional. If set, it exposes the state of the gossip network, for instance to the admin service.
+	Introspector *Introspector
 }
 
 func (f *Components) ListeningAddresses() []string {
@@ -149,6 +163,7 @@ func DefaultComponents() *Components {
 		ProtectedHostByGuardianKey: make(map[eth_common.Address]peer.ID),
 		SignedHeartbeatLogLevel:    zapcore.DebugLevel,
 		GossipParams:               pubsub.DefaultGossipSubParams(),
+		PeerProtection:             DefaultPeerProtectionParams(),
 	}
 }
 
@@ -167,15 +182,19 @@ func DefaultConnectionManager() (*connmgr.BasicConnMgr, error) {
 
 // traceHandler is used to intercept libp2p trace events so we can peg metrics.
 type traceHandler struct {
+	introspector *Introspector
 }
 
 // Trace is the interface to the libp2p trace handler. It pegs metrics as appropriate.
-func (*traceHandler) Trace(evt *libp2ppb.TraceEvent) {
+func (th *traceHandler) Trace(evt *libp2ppb.TraceEvent) {
 	if evt.Type != nil {
 		if *evt.Type == libp2ppb.TraceEvent_DROP_RPC {
 			p2pDrop.Inc()
 		}
 	}
+	if th.introspector != nil {
+		th.introspector.trace(evt)
+	}
 }
 
 // BootstrapAddrs takes a comma-separated string of multi-address strings and returns an array of []peer.AddrInfo that does not include `self`.
@@ -293,6 +312,11 @@ func NewHost(logger *zap.Logger, ctx context.Context, networkID string, bootstra
 		opts = append(opts, libp2p.DisableIdentifyAddressDiscovery())
 	}
 
+	// Track the bandwidth used per peer for introspection.
+	if components.Introspector != nil {
+		opts = append(opts, libp2p.BandwidthReporter(components.Introspector.bwc))
+	}
+
 	return libp2p.New(opts...)
 }
 
@@ -357,15 +381,49 @@ func Run(params *RunParams) func(ctx context.Context) error {
 			}
 		}
 
+		controlTopic := fmt.Sprintf("%s/%s", params.networkID, "control")
+		attestationTopic := fmt.Sprintf("%s/%s", params.networkID, "attestation")
+		vaaTopic := fmt.Sprintf("%s/%s", params.networkID, "broadcast")
+
+		// Ourselves, known guardians and configured peers are exempt from rate limiting and penalties.
+		protection := newPeerProtection(params.components.PeerProtection, func(p peer.ID) bool {
+			return p == h.ID() || params.components.ConnMgr.IsProtected(p, "")
+		})
+		go protection.run(ctx)
+
+		if params.invalidObsvC != nil {
+			go func() {
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case p := <-params.invalidObsvC:
+						protection.recordInvalid(TopicKindAttestation, p, rejectReasonInvalidSignature, time.Now())
+					}
+				}
+			}()
+		}
+
 		logger.Info("connecting to pubsub")
-		ourTracer := &traceHandler{}
-		ps, err := pubsub.NewGossipSub(ctx, h,
+		ourTracer := &traceHandler{introspector: params.components.Introspector}
+		psOpts := []pubsub.Option{
 			pubsub.WithValidateQueueSize(P2P_VALIDATE_QUEUE_SIZE),
 			pubsub.WithGossipSubParams(params.components.GossipParams),
 			pubsub.WithEventTracer(ourTracer),
 			// TODO: Investigate making this change. May need to use LaxSign until everyone has upgraded to that.
 			// pubsub.WithMessageSignaturePolicy(pubsub.StrictNoSign),
-		)
+		}
+		if params.components.PeerProtection.PeerScoring {
+			logger.Info("enabling gossip peer scoring")
+			psOpts = append(psOpts, pubsub.WithPeerScore(
+				peerScoreParams([]string{controlTopic, attestationTopic, vaaTopic}, func(p peer.ID) float64 { return protection.score(p, time.Now()) }),
+				peerScoreThresholds(),
+			))
+			if params.components.Introspector != nil {
+				psOpts = append(psOpts, pubsub.WithPeerScoreInspect(pubsub.PeerScoreInspectFn(params.components.Introspector.inspectScores), peerScoreInspectInterval))
+			}
+		}
+		ps, err := pubsub.NewGossipSub(ctx, h, psOpts...)
 		if err != nil {
 			panic(err)
 		}
@@ -376,13 +434,16 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 		// Set up the control channel. ////////////////////////////////////////////////////////////////////
 		if params.nodeName != "" || params.gossipControlSendC != nil || params.obsvReqSendC != nil || params.obsvReqRecvC != nil || params.signedGovCfgRecvC != nil || params.signedGovStatusRecvC != nil || params.gst.IsSubscribedToHeartbeats() {
-			controlTopic := fmt.Sprintf("%s/%s", params.networkID, "control")
 			logger.Info("joining the control topic", zap.String("topic", controlTopic))
 			controlPubsubTopic, err = ps.Join(controlTopic)
 			if err != nil {
 				return fmt.Errorf("failed to join the control topic: %w", err)
 			}
 
+			if err := ps.RegisterTopicValidator(controlTopic, protection.validator(TopicKindControl)); err != nil {
+				return fmt.Errorf("failed to register the control topic validator: %w", err)
+			}
+
 			defer func() {
 				if err := controlPubsubTopic.Close(); err != nil && !errors.Is(err, context.Canceled) {
 					logger.Error("Error closing the control topic", zap.Error(err))
@@ -401,13 +462,16 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 		// Set up the attestation channel. ////////////////////////////////////////////////////////////////////
 		if params.gossipAttestationSendC != nil || params.batchObsvRecvC != nil {
-			attestationTopic := fmt.Sprintf("%s/%s", params.networkID, "attestation")
 			logger.Info("joining the attestation topic", zap.String("topic", attestationTopic))
 			attestationPubsubTopic, err = ps.Join(attestationTopic)
 			if err != nil {
 				return fmt.Errorf("failed to join the attestation topic: %w", err)
 			}
 
+			if err := ps.RegisterTopicValidator(attestationTopic, protection.validator(TopicKindAttestation)); err != nil {
+				return fmt.Errorf("failed to register the attestation topic validator: %w", err)
+			}
+
 			defer func() {
 				if err := attestationPubsubTopic.Close(); err != nil && !errors.Is(err, context.Canceled) {
 					logger.Error("Error closing the attestation topic", zap.Error(err))
@@ -426,13 +490,16 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 		// Set up the VAA channel. ////////////////////////////////////////////////////////////////////
 		if params.gossipVaaSendC != nil || params.signedIncomingVaaRecvC != nil {
-			vaaTopic := fmt.Sprintf("%s/%s", params.networkID, "broadcast")
 			logger.Info("joining the vaa topic", zap.String("topic", vaaTopic))
 			vaaPubsubTopic, err = ps.Join(vaaTopic)
 			if err != nil {
 				return fmt.Errorf("failed to join the vaa topic: %w", err)
 			}
 
+			if err := ps.RegisterTopicValidator(vaaTopic, protection.validator(TopicKindVaa)); err != nil {
+				return fmt.Errorf("failed to register the vaa topic validator: %w", err)
+			}
+
 			defer func() {
 				if err := vaaPubsubTopic.Close(); err != nil && !errors.Is(err, context.Canceled) {
 					logger.Error("Error closing the vaa topic", zap.Error(err))
@@ -449,6 +516,31 @@ func Run(params *RunParams) func(ctx context.Context) error {
 			}
 		}
 
+		if params.components.Introspector != nil {
+			topics := make(map[string]string)
+			if controlPubsubTopic != nil {
+				topics[controlTopic] = TopicKindControl
+			}
+			if attestationPubsubTopic != nil {
+				topics[attestationTopic] = TopicKindAttestation
+			}
+			if vaaPubsubTopic != nil {
+				topics[vaaTopic] = TopicKindVaa
+			}
+			params.components.Introspector.start(ctx, h, ps, params.components.ConnMgr, params.gst, topics, params.components.PeerProtection.PeerScoring)
+			defer params.components.Introspector.stop()
+			go params.components.Introspector.run(ctx)
+		}
+
+		if params.lookupObservation != nil || params.lookupVAA != nil || params.obsvFetchC != nil {
+			exchange := newObservationExchange(ctx, logger, h, params.networkID, params.gst, params.lookupObservation, params.lookupVAA, params.batchObsvRecvC, params.fetchedVaaRecvC)
+			exchange.start()
+			defer exchange.stop()
+			if params.obsvFetchC != nil {
+				go exchange.runFetcher(ctx, params.obsvFetchC)
+			}
+		}
+
 		// Make sure we connect to at least 1 bootstrap node (this is particularly important in a local devnet and CI
 		// as peer discovery can take a long time).
 
@@ -520,7 +612,7 @@ func Run(params *RunParams) func(ctx context.Context) error {
 						timer.Reset(15 * time.Second)
 
 						// create a heartbeat
-						b := func() []byte {
+						heartbeat := func() *gossipv1.Heartbeat {
 							DefaultRegistry.mu.Lock()
 							defer DefaultRegistry.mu.Unlock()
 							networks := make([]*gossipv1.Heartbeat_Network, 0, len(DefaultRegistry.networkStats))
@@ -584,19 +676,24 @@ func Run(params *RunParams) func(ctx context.Context) error {
 								params.gov.CollectMetrics(ctx, heartbeat, params.gossipControlSendC, params.guardianSigner, ourAddr)
 							}
 
-							msg := gossipv1.GossipMessage{
-								Message: &gossipv1.GossipMessage_SignedHeartbeat{
-									SignedHeartbeat: createSignedHeartbeat(ctx, params.guardianSigner, heartbeat),
-								},
-							}
-
-							b, err := proto.Marshal(&msg)
-							if err != nil {
-								panic(err)
-							}
-							return b
+							return heartbeat
 						}()
 
+						// The heartbeat is signed without holding the registry lock, since signing may wait for the signer.
+						s, err := createSignedHeartbeat(ctx, params.guardianSigner, heartbeat)
+						if err != nil {
+							p2pSigningFailures.WithLabelValues("heartbeat").Inc()
+							logger.Error("failed to sign heartbeat, skipping it", zap.Error(err))
+							continue
+						}
+
+						b, err := proto.Marshal(&gossipv1.GossipMessage{
+							Message: &gossipv1.GossipMessage_SignedHeartbeat{SignedHeartbeat: s},
+						})
+						if err != nil {
+							panic(err)
+						}
+
 						if controlPubsubTopic == nil {
 							panic("controlPubsubTopic should not be nil when nodeName is set")
 						}
@@ -655,9 +752,11 @@ func Run(params *RunParams) func(ctx context.Context) error {
 
 					// Sign the observation request using our node's guardian key.
 					digest := signedObservationRequestDigest(b)
-					sig, err := params.guardianSigner.Sign(ctx, digest.Bytes())
+					sig, err := params.guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeObservationRequest), digest.Bytes())
 					if err != nil {
-						panic(err)
+						p2pSigningFailures.WithLabelValues("observation_request").Inc()
+						logger.Error("failed to sign observation request, dropping it", zap.Any("observation_request", msg), zap.Error(err))
+						continue
 					}
 
 					sReq := &gossipv1.SignedObservationRequest{
@@ -746,6 +845,9 @@ func Run(params *RunParams) func(ctx context.Context) error {
 						}
 						if heartbeat, err := processSignedHeartbeat(envelope.GetFrom(), s, gs, params.gst, params.disableHeartbeatVerify); err != nil {
 							p2pMessagesReceived.WithLabelValues("invalid_heartbeat").Inc()
+							if errors.Is(err, errInvalidSignature) {
+								protection.recordInvalid(TopicKindControl, envelope.GetFrom(), rejectReasonInvalidSignature, time.Now())
+							}
 							if logger.Level().Enabled(params.components.SignedHeartbeatLogLevel) {
 								logger.Log(params.components.SignedHeartbeatLogLevel, "invalid signed heartbeat received",
 									zap.Error(err),
@@ -814,6 +916,9 @@ func Run(params *RunParams) func(ctx context.Context) error {
 							r, err := processSignedObservationRequest(s, gs)
 							if err != nil {
 								p2pMessagesReceived.WithLabelValues("invalid_signed_observation_request").Inc()
+								if errors.Is(err, errInvalidSignature) {
+									protection.recordInvalid(TopicKindControl, envelope.GetFrom(), rejectReasonInvalidSignature, time.Now())
+								}
 								if logger.Level().Enabled(zapcore.DebugLevel) {
 									logger.Debug("invalid signed observation request received",
 										zap.Error(err),
@@ -892,7 +997,7 @@ func Run(params *RunParams) func(ctx context.Context) error {
 					switch m := msg.Message.(type) {
 					case *gossipv1.GossipMessage_SignedObservationBatch:
 						if params.batchObsvRecvC != nil {
-							if err := common.PostMsgWithTimestamp(m.SignedObservationBatch, params.batchObsvRecvC); err == nil {
+							if err := common.PostMsgWithTimestampFrom(m.SignedObservationBatch, envelope.GetFrom(), params.batchObsvRecvC); err == nil {
 								p2pMessagesReceived.WithLabelValues("batch_observation").Inc()
 							} else {
 								if params.components.WarnChannelOverflow {
@@ -985,7 +1090,7 @@ func Run(params *RunParams) func(ctx context.Context) error {
 	}
 }
 
-func createSignedHeartbeat(ctx context.Context, guardianSigner guardiansigner.GuardianSigner, heartbeat *gossipv1.Heartbeat) *gossipv1.SignedHeartbeat {
+func createSignedHeartbeat(ctx context.Context, guardianSigner guardiansigner.GuardianSigner, heartbeat *gossipv1.Heartbeat) (*gossipv1.SignedHeartbeat, error) {
 	ourAddr := ethcrypto.PubkeyToAddress(guardianSigner.PublicKey(ctx))
 
 	b, err := proto.Marshal(heartbeat)
@@ -995,16 +1100,16 @@ func createSignedHeartbeat(ctx context.Context, guardianSigner guardiansigner.Gu
 
 	// Sign the heartbeat using our node's guardian signer.
 	digest := heartbeatDigest(b)
-	sig, err := guardianSigner.Sign(ctx, digest.Bytes())
+	sig, err := guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeHeartbeat), digest.Bytes())
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to sign heartbeat: %w", err)
 	}
 
 	return &gossipv1.SignedHeartbeat{
 		Heartbeat:    b,
 		Signature:    sig,
 		GuardianAddr: ourAddr.Bytes(),
-	}
+	}, nil
 }
 
 func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *common.GuardianSet, gst *common.GuardianSetState, disableVerify bool) (*gossipv1.Heartbeat, error) {
@@ -1013,7 +1118,7 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 	var pk eth_common.Address
 	if !ok {
 		if !disableVerify {
-			return nil, fmt.Errorf("invalid message: %s not in guardian set", envelopeAddr)
+			return nil, fmt.Errorf("%s not in guardian set", envelopeAddr)
 		}
 	} else {
 		pk = gs.Keys[idx]
@@ -1028,12 +1133,12 @@ func processSignedHeartbeat(from peer.ID, s *gossipv1.SignedHeartbeat, gs *commo
 
 	pubKey, err := ethcrypto.Ecrecover(digest.Bytes(), s.Signature)
 	if err != nil {
-		return nil, errors.New("failed to recover public key")
+		return nil, fmt.Errorf("%w: failed to recover public key", errInvalidSignature)
 	}
 
 	signerAddr := eth_common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
 	if pk != signerAddr && !disableVerify {
-		return nil, fmt.Errorf("invalid signer: %v", signerAddr)
+		return nil, fmt.Errorf("%w: invalid signer: %v", errInvalidSignature, signerAddr)
 	}
 
 	var h gossipv1.Heartbeat
@@ -1074,7 +1179,7 @@ func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *c
 	idx, ok := gs.KeyIndex(envelopeAddr)
 	var pk eth_common.Address
 	if !ok {
-		return nil, fmt.Errorf("invalid message: %s not in guardian set", envelopeAddr)
+		return nil, fmt.Errorf("%s not in guardian set", envelopeAddr)
 	} else {
 		pk = gs.Keys[idx]
 	}
@@ -1088,12 +1193,12 @@ func processSignedObservationRequest(s *gossipv1.SignedObservationRequest, gs *c
 
 	pubKey, err := ethcrypto.Ecrecover(digest.Bytes(), s.Signature)
 	if err != nil {
-		return nil, errors.New("failed to recover public key")
+		return nil, fmt.Errorf("%w: failed to recover public key", errInvalidSignature)
 	}
 
 	signerAddr := eth_common.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:])
 	if pk != signerAddr {
-		return nil, fmt.Errorf("invalid signer: %v", signerAddr)
+		return nil, fmt.Errorf("%w: invalid signer: %v", errInvalidSignature, signerAddr)
 	}
 
 	var h gossipv1.ObservationRequest
@@ -7,6 +7,7 @@ import (
 
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	node_common "github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/guardiansigner"
@@ -109,7 +110,8 @@ func TestSignedHeartbeat(t *testing.T) {
 			P2PNodeId:     tc.p2pNodeId,
 		}
 
-		s := createSignedHeartbeat(context.Background(), guardianSigner, heartbeat)
+		s, err := createSignedHeartbeat(context.Background(), guardianSigner, heartbeat)
+		require.NoError(t, err)
 		gs := &node_common.GuardianSet{
 			Keys:  []common.Address{addr},
 			Index: 1,
@@ -131,3 +133,50 @@ func TestSignedHeartbeat(t *testing.T) {
 		testFunc(t, tc)
 	}
 }
+
+func TestSignedHeartbeatInvalidSignature(t *testing.T) {
+	guardianSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	otherSigner, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	fromP2pId, err := peer.Decode("12D3KooWSgMXkhzTbKTeupHYmyG7sFJ5LpVreQcwVnX8RD7LBpy9")
+	require.NoError(t, err)
+	p2pNodeId, err := fromP2pId.Marshal()
+	require.NoError(t, err)
+
+	addr := crypto.PubkeyToAddress(guardianSigner.PublicKey(context.Background()))
+	heartbeat := &gossipv1.Heartbeat{
+		NodeName:     "someNode",
+		Timestamp:    time.Now().UnixNano(),
+		GuardianAddr: addr.String(),
+		P2PNodeId:    p2pNodeId,
+	}
+	gs := &node_common.GuardianSet{
+		Keys:  []common.Address{addr},
+		Index: 1,
+	}
+	gst := node_common.NewGuardianSetState(nil)
+
+	// Signed by a key that is not the one the envelope claims.
+	s, err := createSignedHeartbeat(context.Background(), otherSigner, heartbeat)
+	require.NoError(t, err)
+	s.GuardianAddr = addr.Bytes()
+	_, err = processSignedHeartbeat(fromP2pId, s, gs, gst, false)
+	assert.ErrorIs(t, err, errInvalidSignature)
+
+	// Signed by a key that is not in the guardian set. This is not treated as an invalid signature, since it may be our
+	// guardian set that is outdated.
+	s, err = createSignedHeartbeat(context.Background(), otherSigner, heartbeat)
+	require.NoError(t, err)
+	_, err = processSignedHeartbeat(fromP2pId, s, gs, gst, false)
+	require.ErrorContains(t, err, "not in guardian set")
+	assert.NotErrorIs(t, err, errInvalidSignature)
+
+	// Failures unrelated to the signature should not be treated as invalid signatures.
+	heartbeat.Timestamp = time.Now().Add(-time.Hour).UnixNano()
+	s, err = createSignedHeartbeat(context.Background(), guardianSigner, heartbeat)
+	require.NoError(t, err)
+	_, err = processSignedHeartbeat(fromP2pId, s, gs, gst, false)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, errInvalidSignature)
+}
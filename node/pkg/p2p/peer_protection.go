@@ -0,0 +1,368 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/proto"
+)
+
+// Topic kinds used to key the per-topic rate limits. These correspond to the suffix of the topic names.
+const (
+	TopicKindControl     = "control"
+	TopicKindAttestation = "attestation"
+	TopicKindVaa         = "vaa"
+	TopicKindCcqReq      = "ccq_req"
+)
+
+const (
+	// peerStateIdleTimeout is how long we keep rate limiter state (and the associated metrics) for a peer we have not heard from.
+	peerStateIdleTimeout = 30 * time.Minute
+
+	// peerStatePruneInterval is how often idle peer state is pruned.
+	peerStatePruneInterval = time.Minute
+
+	// invalidMessageHalfLife is the half life of the invalid message count used for the application specific peer score.
+	invalidMessageHalfLife = 10 * time.Minute
+
+	// protectedPeerScoreBonus is the application specific score given to protected peers (known guardians and configured peers)
+	// so that they are never graylisted, even if they trip one of the generic GossipSub penalties.
+	protectedPeerScoreBonus = 1000
+
+	// maxLabeledPeers bounds the number of peers that get their own label value in the per peer metrics. The messages of
+	// further peers are counted under otherPeersLabel, so that a flood of peer IDs does not blow up the metric cardinality.
+	maxLabeledPeers = 100
+
+	// otherPeersLabel is the peer label value of the peers beyond maxLabeledPeers.
+	otherPeersLabel = "other"
+)
+
+// Reasons used to label rejected messages.
+const (
+	rejectReasonMalformed        = "malformed"
+	rejectReasonInvalidSignature = "invalid_signature"
+)
+
+var (
+	p2pPeerMessagesThrottled = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_peer_messages_throttled_total",
+			Help: "Total number of p2p messages dropped because the originating peer exceeded the topic rate limit",
+		}, []string{"topic", "peer"})
+	p2pPeerMessagesRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_p2p_peer_messages_rejected_total",
+			Help: "Total number of p2p messages from the originating peer that were rejected as invalid",
+		}, []string{"topic", "peer", "reason"})
+)
+
+// TopicRateLimit configures the rate at which a single peer may originate messages on a topic.
+type TopicRateLimit struct {
+	// MessagesPerSecond is the sustained rate. Zero disables rate limiting on the topic.
+	MessagesPerSecond float64
+	// Burst is the number of messages a peer may send at once before being throttled.
+	Burst int
+}
+
+// PeerProtectionParams configures the protections applied to messages received from non-guardian peers.
+type PeerProtectionParams struct {
+	// RateLimits is keyed by topic kind (see the TopicKind constants). Topics without an entry are not rate limited.
+	RateLimits map[string]TopicRateLimit
+	// PeerScoring enables GossipSub peer scoring using the parameters from `peerScoreParams`.
+	PeerScoring bool
+	// InvalidMessagePenalty is subtracted from the score of a peer for each message with an invalid signature it originates.
+	InvalidMessagePenalty float64
+}
+
+// DefaultPeerProtectionParams returns rate limits that are well above what a healthy guardian produces. Note that guardians
+// are exempt from rate limiting once they have been identified through their heartbeats.
+func DefaultPeerProtectionParams() PeerProtectionParams {
+	return PeerProtectionParams{
+		RateLimits: map[string]TopicRateLimit{
+			TopicKindControl:     {MessagesPerSecond: 5, Burst: 50},
+			TopicKindAttestation: {MessagesPerSecond: 100, Burst: 1000},
+			TopicKindVaa:         {MessagesPerSecond: 100, Burst: 1000},
+			TopicKindCcqReq:      {MessagesPerSecond: 20, Burst: 200},
+		},
+		PeerScoring:           false,
+		InvalidMessagePenalty: 50,
+	}
+}
+
+// ParseTopicRateLimits parses a comma separated list of rate limits of the form `topic=messagesPerSecond:burst`
+// (for example `control=5:50,vaa=100:1000`) and applies them on top of the passed in limits.
+func ParseTopicRateLimits(str string, limits map[string]TopicRateLimit) (map[string]TopicRateLimit, error) {
+	ret := make(map[string]TopicRateLimit, len(limits))
+	for topic, limit := range limits {
+		ret[topic] = limit
+	}
+
+	str = strings.TrimSpace(str)
+	if str == "" {
+		return ret, nil
+	}
+
+	for _, entry := range strings.Split(str, ",") {
+		topic, limitStr, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			return nil, fmt.Errorf(`invalid rate limit "%s", must be of the form topic=messagesPerSecond:burst`, entry)
+		}
+
+		switch topic {
+		case TopicKindControl, TopicKindAttestation, TopicKindVaa, TopicKindCcqReq:
+		default:
+			return nil, fmt.Errorf(`invalid topic "%s" in rate limit, must be one of %s, %s, %s or %s`, topic, TopicKindControl, TopicKindAttestation, TopicKindVaa, TopicKindCcqReq)
+		}
+
+		rateStr, burstStr, found := strings.Cut(limitStr, ":")
+		if !found {
+			return nil, fmt.Errorf(`invalid rate limit "%s", must be of the form topic=messagesPerSecond:burst`, entry)
+		}
+
+		mps, err := strconv.ParseFloat(rateStr, 64)
+		if err != nil || mps < 0 || math.IsInf(mps, 0) || math.IsNaN(mps) {
+			return nil, fmt.Errorf(`invalid messages per second "%s" for topic %s`, rateStr, topic)
+		}
+
+		burst, err := strconv.Atoi(burstStr)
+		if err != nil || burst < 0 || (mps != 0 && burst == 0) {
+			return nil, fmt.Errorf(`invalid burst "%s" for topic %s`, burstStr, topic)
+		}
+
+		ret[topic] = TopicRateLimit{MessagesPerSecond: mps, Burst: burst}
+	}
+
+	return ret, nil
+}
+
+// peerState is the per peer state tracked by `peerProtection`.
+type peerState struct {
+	limiters       map[string]*rate.Limiter
+	invalid        float64
+	invalidUpdated time.Time
+	lastSeen       time.Time
+	// labeled is true if the peer has its own label value in the per peer metrics.
+	labeled bool
+}
+
+// peerProtection rate limits messages per originating peer and topic and tracks invalid messages for peer scoring.
+type peerProtection struct {
+	params PeerProtectionParams
+
+	// isExempt returns true for peers that should never be rate limited or penalized (ourselves and protected peers).
+	isExempt func(peer.ID) bool
+
+	mu    sync.Mutex
+	peers map[peer.ID]*peerState
+	// labeledPeers is the number of peers in peers that have their own label value in the per peer metrics.
+	labeledPeers int
+}
+
+func newPeerProtection(params PeerProtectionParams, isExempt func(peer.ID) bool) *peerProtection {
+	return &peerProtection{
+		params:   params,
+		isExempt: isExempt,
+		peers:    make(map[peer.ID]*peerState),
+	}
+}
+
+// getPeerState returns the state for a peer, creating it if necessary. It assumes the caller holds the lock.
+func (pp *peerProtection) getPeerState(p peer.ID, now time.Time) *peerState {
+	ps, exists := pp.peers[p]
+	if !exists {
+		ps = &peerState{limiters: make(map[string]*rate.Limiter)}
+		pp.peers[p] = ps
+	}
+	ps.lastSeen = now
+	return ps
+}
+
+// peerLabel returns the label value of a peer in the per peer metrics. A peer gets its own label value while there are
+// fewer than maxLabeledPeers labeled peers, and keeps it until its state is pruned. It assumes the caller holds the lock.
+func (pp *peerProtection) peerLabel(p peer.ID, ps *peerState) string {
+	if !ps.labeled {
+		if pp.labeledPeers >= maxLabeledPeers {
+			return otherPeersLabel
+		}
+		ps.labeled = true
+		pp.labeledPeers++
+	}
+	return p.String()
+}
+
+// allow returns true if the peer is allowed to originate another message on the topic.
+func (pp *peerProtection) allow(topicKind string, p peer.ID, now time.Time) bool {
+	limit, exists := pp.params.RateLimits[topicKind]
+	if !exists || limit.MessagesPerSecond == 0 {
+		return true
+	}
+	if pp.isExempt(p) {
+		return true
+	}
+
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	ps := pp.getPeerState(p, now)
+	limiter, exists := ps.limiters[topicKind]
+	if !exists {
+		limiter = rate.NewLimiter(rate.Limit(limit.MessagesPerSecond), limit.Burst)
+		ps.limiters[topicKind] = limiter
+	}
+
+	if limiter.AllowN(now, 1) {
+		return true
+	}
+
+	p2pPeerMessagesThrottled.WithLabelValues(topicKind, pp.peerLabel(p, ps)).Inc()
+	return false
+}
+
+// recordInvalid records that the peer originated an invalid message on the topic. Messages with invalid signatures
+// count against the application specific peer score.
+func (pp *peerProtection) recordInvalid(topicKind string, p peer.ID, reason string, now time.Time) {
+	if pp.isExempt(p) {
+		return
+	}
+
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	ps := pp.getPeerState(p, now)
+	p2pPeerMessagesRejected.WithLabelValues(topicKind, pp.peerLabel(p, ps), reason).Inc()
+	if reason != rejectReasonInvalidSignature {
+		return
+	}
+
+	ps.invalid = decayInvalidCount(ps.invalid, now.Sub(ps.invalidUpdated)) + 1
+	ps.invalidUpdated = now
+}
+
+// score returns the application specific score of a peer.
+func (pp *peerProtection) score(p peer.ID, now time.Time) float64 {
+	if pp.isExempt(p) {
+		return protectedPeerScoreBonus
+	}
+
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	ps, exists := pp.peers[p]
+	if !exists {
+		return 0
+	}
+	return -pp.params.InvalidMessagePenalty * decayInvalidCount(ps.invalid, now.Sub(ps.invalidUpdated))
+}
+
+// decayInvalidCount applies exponential decay to an invalid message count based on the time since it was last updated.
+func decayInvalidCount(count float64, elapsed time.Duration) float64 {
+	if count == 0 || elapsed <= 0 {
+		return count
+	}
+	return count * math.Pow(0.5, float64(elapsed)/float64(invalidMessageHalfLife))
+}
+
+// prune drops the state and the per peer metrics of peers that have been idle for too long.
+func (pp *peerProtection) prune(now time.Time) {
+	pp.mu.Lock()
+	defer pp.mu.Unlock()
+	for p, ps := range pp.peers {
+		if now.Sub(ps.lastSeen) > peerStateIdleTimeout {
+			delete(pp.peers, p)
+			if ps.labeled {
+				pp.labeledPeers--
+				p2pPeerMessagesThrottled.DeletePartialMatch(prometheus.Labels{"peer": p.String()})
+				p2pPeerMessagesRejected.DeletePartialMatch(prometheus.Labels{"peer": p.String()})
+			}
+		}
+	}
+}
+
+// run periodically prunes idle peer state until the context is canceled.
+func (pp *peerProtection) run(ctx context.Context) {
+	ticker := time.NewTicker(peerStatePruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			pp.prune(time.Now())
+		}
+	}
+}
+
+// validator returns a GossipSub topic validator that rejects malformed messages and ignores messages from peers
+// that exceed the rate limit of the topic. Ignored messages are not forwarded but do not count against the peer score,
+// so a burst from a guardian we have not identified yet does not get it graylisted.
+func (pp *peerProtection) validator(topicKind string) pubsub.ValidatorEx {
+	return func(_ context.Context, _ peer.ID, msg *pubsub.Message) pubsub.ValidationResult {
+		from := msg.GetFrom()
+		now := time.Now()
+		if !pp.allow(topicKind, from, now) {
+			return pubsub.ValidationIgnore
+		}
+
+		var m gossipv1.GossipMessage
+		if err := proto.Unmarshal(msg.Data, &m); err != nil {
+			pp.recordInvalid(topicKind, from, rejectReasonMalformed, now)
+			return pubsub.ValidationReject
+		}
+
+		return pubsub.ValidationAccept
+	}
+}
+
+// peerScoreParams returns the GossipSub peer score parameters used on the guardian network. The guardian network has
+// bursty traffic, so mesh delivery penalties are not used. Instead, peers are penalized for invalid messages (both at
+// the GossipSub level and through the application specific score) and for IP colocation and protocol misbehaviour.
+func peerScoreParams(topics []string, appSpecificScore func(peer.ID) float64) *pubsub.PeerScoreParams {
+	topicParams := make(map[string]*pubsub.TopicScoreParams, len(topics))
+	for _, topic := range topics {
+		topicParams[topic] = &pubsub.TopicScoreParams{
+			TopicWeight:                    1,
+			TimeInMeshWeight:               0.01,
+			TimeInMeshQuantum:              time.Second,
+			TimeInMeshCap:                  3600,
+			FirstMessageDeliveriesWeight:   0.5,
+			FirstMessageDeliveriesDecay:    pubsub.ScoreParameterDecay(10 * time.Minute),
+			FirstMessageDeliveriesCap:      100,
+			InvalidMessageDeliveriesWeight: -100,
+			InvalidMessageDeliveriesDecay:  pubsub.ScoreParameterDecay(time.Hour),
+		}
+	}
+
+	return &pubsub.PeerScoreParams{
+		Topics:                      topicParams,
+		TopicScoreCap:               100,
+		AppSpecificScore:            appSpecificScore,
+		AppSpecificWeight:           1,
+		IPColocationFactorWeight:    -10,
+		IPColocationFactorThreshold: 10,
+		BehaviourPenaltyWeight:      -10,
+		BehaviourPenaltyThreshold:   6,
+		BehaviourPenaltyDecay:       pubsub.ScoreParameterDecay(10 * time.Minute),
+		DecayInterval:               pubsub.DefaultDecayInterval,
+		DecayToZero:                 pubsub.DefaultDecayToZero,
+		RetainScore:                 time.Hour,
+	}
+}
+
+// peerScoreThresholds returns the GossipSub peer score thresholds used on the guardian network.
+func peerScoreThresholds() *pubsub.PeerScoreThresholds {
+	return &pubsub.PeerScoreThresholds{
+		GossipThreshold:             -500,
+		PublishThreshold:            -1000,
+		GraylistThreshold:           -2500,
+		AcceptPXThreshold:           0,
+		OpportunisticGraftThreshold: 5,
+	}
+}
@@ -0,0 +1,179 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/libp2p/go-libp2p"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	libp2ppb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testPeer      = peer.ID("untrusted-peer")
+	testGuardian  = peer.ID("guardian-peer")
+	testOtherPeer = peer.ID("other-peer")
+)
+
+func newTestPeerProtection(limits map[string]TopicRateLimit) *peerProtection {
+	params := DefaultPeerProtectionParams()
+	params.RateLimits = limits
+	return newPeerProtection(params, func(p peer.ID) bool { return p == testGuardian })
+}
+
+func TestParseTopicRateLimits(t *testing.T) {
+	defaults := DefaultPeerProtectionParams().RateLimits
+
+	limits, err := ParseTopicRateLimits("", defaults)
+	require.NoError(t, err)
+	assert.Equal(t, defaults, limits)
+
+	limits, err = ParseTopicRateLimits("control=1.5:10, vaa=0:0", defaults)
+	require.NoError(t, err)
+	assert.Equal(t, TopicRateLimit{MessagesPerSecond: 1.5, Burst: 10}, limits[TopicKindControl])
+	assert.Equal(t, TopicRateLimit{MessagesPerSecond: 0, Burst: 0}, limits[TopicKindVaa])
+	assert.Equal(t, defaults[TopicKindAttestation], limits[TopicKindAttestation])
+
+	// The passed in limits should not be modified.
+	assert.Equal(t, DefaultPeerProtectionParams().RateLimits, defaults)
+
+	for _, str := range []string{"control", "control=5", "unknown=5:10", "control=-1:10", "control=abc:10", "control=5:abc", "control=5:0"} {
+		_, err := ParseTopicRateLimits(str, defaults)
+		assert.Error(t, err, str)
+	}
+}
+
+func TestPeerProtectionRateLimit(t *testing.T) {
+	pp := newTestPeerProtection(map[string]TopicRateLimit{
+		TopicKindControl: {MessagesPerSecond: 1, Burst: 2},
+		TopicKindVaa:     {MessagesPerSecond: 0, Burst: 0},
+	})
+	now := time.Now()
+
+	assert.True(t, pp.allow(TopicKindControl, testPeer, now))
+	assert.True(t, pp.allow(TopicKindControl, testPeer, now))
+	assert.False(t, pp.allow(TopicKindControl, testPeer, now))
+	assert.Equal(t, 1.0, testutil.ToFloat64(p2pPeerMessagesThrottled.WithLabelValues(TopicKindControl, testPeer.String())))
+
+	// Limits are per peer.
+	assert.True(t, pp.allow(TopicKindControl, testOtherPeer, now))
+
+	// The bucket refills over time.
+	assert.True(t, pp.allow(TopicKindControl, testPeer, now.Add(time.Second)))
+
+	// Exempt peers, disabled limits and topics without limits are never throttled.
+	for i := 0; i < 10; i++ {
+		assert.True(t, pp.allow(TopicKindControl, testGuardian, now))
+		assert.True(t, pp.allow(TopicKindVaa, testPeer, now))
+		assert.True(t, pp.allow(TopicKindAttestation, testPeer, now))
+	}
+}
+
+func TestPeerProtectionScore(t *testing.T) {
+	pp := newTestPeerProtection(nil)
+	now := time.Now()
+
+	assert.Equal(t, 0.0, pp.score(testPeer, now))
+	assert.Equal(t, float64(protectedPeerScoreBonus), pp.score(testGuardian, now))
+
+	// Malformed messages are counted but only invalid signatures affect the score.
+	pp.recordInvalid(TopicKindControl, testPeer, rejectReasonMalformed, now)
+	assert.Equal(t, 0.0, pp.score(testPeer, now))
+
+	pp.recordInvalid(TopicKindControl, testPeer, rejectReasonInvalidSignature, now)
+	pp.recordInvalid(TopicKindControl, testPeer, rejectReasonInvalidSignature, now)
+	assert.Equal(t, -2*pp.params.InvalidMessagePenalty, pp.score(testPeer, now))
+	assert.Equal(t, 2.0, testutil.ToFloat64(p2pPeerMessagesRejected.WithLabelValues(TopicKindControl, testPeer.String(), rejectReasonInvalidSignature)))
+
+	// The penalty decays over time.
+	assert.InDelta(t, -pp.params.InvalidMessagePenalty, pp.score(testPeer, now.Add(invalidMessageHalfLife)), 0.0001)
+
+	// Exempt peers are never penalized.
+	pp.recordInvalid(TopicKindControl, testGuardian, rejectReasonInvalidSignature, now)
+	assert.Equal(t, float64(protectedPeerScoreBonus), pp.score(testGuardian, now))
+}
+
+func TestPeerProtectionPrune(t *testing.T) {
+	pp := newTestPeerProtection(map[string]TopicRateLimit{TopicKindControl: {MessagesPerSecond: 1, Burst: 1}})
+	now := time.Now()
+
+	pp.allow(TopicKindControl, testOtherPeer, now)
+	pp.allow(TopicKindControl, testOtherPeer, now)
+	pp.recordInvalid(TopicKindControl, testOtherPeer, rejectReasonInvalidSignature, now)
+	numThrottled := testutil.CollectAndCount(p2pPeerMessagesThrottled)
+	numRejected := testutil.CollectAndCount(p2pPeerMessagesRejected)
+
+	pp.prune(now.Add(peerStateIdleTimeout / 2))
+	assert.Len(t, pp.peers, 1)
+
+	pp.prune(now.Add(2 * peerStateIdleTimeout))
+	assert.Empty(t, pp.peers)
+	assert.Equal(t, 0.0, pp.score(testOtherPeer, now))
+	assert.Equal(t, numThrottled-1, testutil.CollectAndCount(p2pPeerMessagesThrottled))
+	assert.Equal(t, numRejected-1, testutil.CollectAndCount(p2pPeerMessagesRejected))
+}
+
+func TestPeerProtectionLabelCardinality(t *testing.T) {
+	pp := newTestPeerProtection(nil)
+	now := time.Now()
+	const topic = "label_cardinality_test"
+
+	// Beyond maxLabeledPeers, peers are counted under a shared label value.
+	for i := 0; i <= maxLabeledPeers; i++ {
+		pp.recordInvalid(topic, peer.ID(fmt.Sprintf("peer-%d", i)), rejectReasonMalformed, now)
+	}
+	lastPeer := peer.ID(fmt.Sprintf("peer-%d", maxLabeledPeers))
+	assert.Equal(t, maxLabeledPeers, pp.labeledPeers)
+	assert.Equal(t, 1.0, testutil.ToFloat64(p2pPeerMessagesRejected.WithLabelValues(topic, otherPeersLabel, rejectReasonMalformed)))
+
+	// Pruning the labeled peers frees their label values.
+	pp.recordInvalid(topic, lastPeer, rejectReasonMalformed, now.Add(peerStateIdleTimeout))
+	pp.prune(now.Add(peerStateIdleTimeout + time.Minute))
+	assert.Len(t, pp.peers, 1)
+	assert.Equal(t, 0, pp.labeledPeers)
+
+	pp.recordInvalid(topic, lastPeer, rejectReasonMalformed, now.Add(peerStateIdleTimeout))
+	assert.Equal(t, 1, pp.labeledPeers)
+	assert.Equal(t, 1.0, testutil.ToFloat64(p2pPeerMessagesRejected.WithLabelValues(topic, lastPeer.String(), rejectReasonMalformed)))
+}
+
+func TestPeerProtectionValidator(t *testing.T) {
+	pp := newTestPeerProtection(map[string]TopicRateLimit{TopicKindAttestation: {MessagesPerSecond: 1, Burst: 1}})
+	validator := pp.validator(TopicKindAttestation)
+
+	data, err := proto.Marshal(&gossipv1.GossipMessage{Message: &gossipv1.GossipMessage_SignedObservationBatch{SignedObservationBatch: &gossipv1.SignedObservationBatch{}}})
+	require.NoError(t, err)
+
+	newMsg := func(from peer.ID, data []byte) *pubsub.Message {
+		return &pubsub.Message{Message: &libp2ppb.Message{From: []byte(from), Data: data}}
+	}
+
+	assert.Equal(t, pubsub.ValidationAccept, validator(context.Background(), testOtherPeer, newMsg(testPeer, data)))
+	assert.Equal(t, pubsub.ValidationIgnore, validator(context.Background(), testOtherPeer, newMsg(testPeer, data)))
+	assert.Equal(t, pubsub.ValidationAccept, validator(context.Background(), testOtherPeer, newMsg(testGuardian, data)))
+	assert.Equal(t, pubsub.ValidationReject, validator(context.Background(), testOtherPeer, newMsg(testGuardian, []byte{0xff, 0xff, 0xff})))
+}
+
+func TestPeerScoreParamsAreValid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h, err := libp2p.New(libp2p.NoListenAddrs)
+	require.NoError(t, err)
+	defer h.Close()
+
+	pp := newTestPeerProtection(nil)
+	_, err = pubsub.NewGossipSub(ctx, h, pubsub.WithPeerScore(
+		peerScoreParams([]string{"/wormhole/dev/control", "/wormhole/dev/attestation", "/wormhole/dev/broadcast"}, func(p peer.ID) float64 { return pp.score(p, time.Now()) }),
+		peerScoreThresholds(),
+	))
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ import (
 
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"google.golang.org/protobuf/proto"
 )
 
 // The p2p package implements a simple global metrics registry singleton for node status values transmitted on-chain.
@@ -40,6 +41,17 @@ func (r *registry) SetNetworkStats(chain vaa.ChainID, data *gossipv1.Heartbeat_N
 	r.mu.Unlock()
 }
 
+// GetNetworkStats returns a copy of the current network status of all chains.
+func (r *registry) GetNetworkStats() map[vaa.ChainID]*gossipv1.Heartbeat_Network {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	ret := make(map[vaa.ChainID]*gossipv1.Heartbeat_Network, len(r.networkStats))
+	for chain, stats := range r.networkStats {
+		ret[chain] = proto.Clone(stats).(*gossipv1.Heartbeat_Network)
+	}
+	return ret
+}
+
 func (r *registry) AddErrorCount(chain vaa.ChainID, delta uint64) {
 	r.errorCounterMu.Lock()
 	defer r.errorCounterMu.Unlock()
@@ -34,6 +34,20 @@ func TestSetNetworkStats(t *testing.T) {
 	assert.Equal(t, expect, registry.networkStats)
 }
 
+func TestGetNetworkStats(t *testing.T) {
+	registry := NewRegistry()
+	registry.SetNetworkStats(vaa.ChainIDEthereum, &gossipv1.Heartbeat_Network{Height: 100})
+
+	stats := registry.GetNetworkStats()
+	assert.Len(t, stats, 1)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), stats[vaa.ChainIDEthereum].Id)
+	assert.Equal(t, int64(100), stats[vaa.ChainIDEthereum].Height)
+
+	// The returned stats are a copy.
+	stats[vaa.ChainIDEthereum].Height = 200
+	assert.Equal(t, int64(100), registry.networkStats[vaa.ChainIDEthereum].Height)
+}
+
 func TestAddErrorCount(t *testing.T) {
 	registry := NewRegistry()
 
@@ -11,6 +11,7 @@ import (
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 )
 
 type (
@@ -38,6 +39,15 @@ type (
 		// signedGovStatusRecvC is optional and can be set with `WithChainGovernorStatusListener`.
 		signedGovStatusRecvC chan *gossipv1.SignedChainGovernorStatus
 
+		// The observation exchange is optional and can be enabled with `WithObservationExchange`.
+		lookupObservation ObservationLookupFunc
+		lookupVAA         VAALookupFunc
+		obsvFetchC        <-chan *ObservationFetchRequest
+		fetchedVaaRecvC   chan<- *gossipv1.SignedVAAWithQuorum
+
+		// invalidObsvC is optional and can be set with `WithInvalidObservationReports`.
+		invalidObsvC <-chan peer.ID
+
 		// disableHeartbeatVerify is optional and can be set with `WithDisableHeartbeatVerify` or `WithGuardianOptions`.
 		disableHeartbeatVerify bool
 
@@ -156,6 +166,33 @@ func WithChainGovernorStatusListener(signedGovStatusRecvC chan *gossipv1.SignedC
 	}
 }
 
+// WithObservationExchange is used to enable the observation exchange stream protocol. The lookup functions are used to
+// serve requests from other guardians and may be nil. Fetch requests posted to obsvFetchC are sent to other guardians,
+// the fetched observations are published to the batch observation listener and the fetched VAAs to fetchedVaaRecvC.
+func WithObservationExchange(
+	lookupObservation ObservationLookupFunc,
+	lookupVAA VAALookupFunc,
+	obsvFetchC <-chan *ObservationFetchRequest,
+	fetchedVaaRecvC chan<- *gossipv1.SignedVAAWithQuorum,
+) RunOpt {
+	return func(p *RunParams) error {
+		p.lookupObservation = lookupObservation
+		p.lookupVAA = lookupVAA
+		p.obsvFetchC = obsvFetchC
+		p.fetchedVaaRecvC = fetchedVaaRecvC
+		return nil
+	}
+}
+
+// WithInvalidObservationReports is used to penalize the peers that originated observations with invalid signatures. The
+// processor, which verifies the signatures, posts the peers to invalidObsvC.
+func WithInvalidObservationReports(invalidObsvC <-chan peer.ID) RunOpt {
+	return func(p *RunParams) error {
+		p.invalidObsvC = invalidObsvC
+		return nil
+	}
+}
+
 // WithDisableHeartbeatVerify is used to set disableHeartbeatVerify.
 func WithDisableHeartbeatVerify(disableHeartbeatVerify bool) RunOpt {
 	return func(p *RunParams) error {
@@ -261,5 +298,10 @@ func (p *RunParams) verify() error {
 			return errors.New("if obsvReqSendC is not nil, vs may not be nil")
 		}
 	}
+	if p.obsvFetchC != nil {
+		if p.batchObsvRecvC == nil || p.fetchedVaaRecvC == nil {
+			return errors.New("if obsvFetchC is not nil, batchObsvRecvC and fetchedVaaRecvC may not be nil")
+		}
+	}
 	return nil
 }
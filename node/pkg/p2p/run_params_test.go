@@ -127,6 +127,44 @@ func TestRunParamsWithDisableHeartbeatVerify(t *testing.T) {
 	assert.True(t, params.disableHeartbeatVerify)
 }
 
+func TestRunParamsWithObservationExchange(t *testing.T) {
+	priv, _, err := p2pcrypto.GenerateKeyPair(p2pcrypto.Ed25519, -1)
+	require.NoError(t, err)
+	gst := common.NewGuardianSetState(nil)
+	_, rootCtxCancel := context.WithCancel(context.Background())
+	defer rootCtxCancel()
+
+	obsvFetchC := make(chan *ObservationFetchRequest)
+	fetchedVaaRecvC := make(chan *gossipv1.SignedVAAWithQuorum)
+
+	// The fetched observations need somewhere to go.
+	params, err := NewRunParams(
+		bootstrapPeers,
+		networkId,
+		priv,
+		gst,
+		rootCtxCancel,
+		WithObservationExchange(nil, nil, obsvFetchC, fetchedVaaRecvC),
+	)
+	require.ErrorContains(t, err, "if obsvFetchC is not nil, batchObsvRecvC and fetchedVaaRecvC may not be nil")
+	require.Nil(t, params)
+
+	batchObsvRecvC := make(chan *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch])
+	params, err = NewRunParams(
+		bootstrapPeers,
+		networkId,
+		priv,
+		gst,
+		rootCtxCancel,
+		WithSignedObservationBatchListener(batchObsvRecvC),
+		WithObservationExchange(nil, nil, obsvFetchC, fetchedVaaRecvC),
+	)
+	require.NoError(t, err)
+	require.NotNil(t, params)
+	assert.Equal(t, (<-chan *ObservationFetchRequest)(obsvFetchC), params.obsvFetchC)
+	assert.Equal(t, (chan<- *gossipv1.SignedVAAWithQuorum)(fetchedVaaRecvC), params.fetchedVaaRecvC)
+}
+
 func TestRunParamsWithProtectedPeers(t *testing.T) {
 	priv, _, err := p2pcrypto.GenerateKeyPair(p2pcrypto.Ed25519, -1)
 	require.NoError(t, err)
@@ -158,7 +158,7 @@ func createProcessorForTest(b *testing.B, numVAAs int, ctx context.Context, db *
 	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
 	require.NoError(b, err)
 
-	gwRelayer := gwrelayer.NewGatewayRelayer(ctx, logger, "wormhole14ejqjyq8um4p3xfqj74yld5waqljf88fz25yxnma0cngspxe3les00fpj", nil, common.MainNet)
+	gwRelayer := gwrelayer.NewGatewayRelayer(ctx, logger, db, "wormhole14ejqjyq8um4p3xfqj74yld5waqljf88fz25yxnma0cngspxe3les00fpj", nil, common.MainNet)
 	require.NoError(b, gwRelayer.Start(ctx))
 
 	pd := &ProcessorData{
@@ -0,0 +1,194 @@
+package processor
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+var (
+	aggregationStateCheckpointed = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_aggregation_state_checkpointed_entries",
+			Help: "Number of unsubmitted aggregation states of our own observations in the last checkpoint",
+		})
+	aggregationStateRestored = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_aggregation_state_restored_total",
+			Help: "Total number of checkpointed aggregation states loaded on startup, grouped by outcome",
+		}, []string{"outcome"})
+)
+
+// checkpointAggregationState stores the aggregation state of our own unsubmitted observations in the database, so
+// that it can be restored after a restart. Observations we haven't made ourselves are not stored, since they will
+// be retransmitted by the other guardians anyway.
+func (p *Processor) checkpointAggregationState() {
+	if p.db == nil {
+		return
+	}
+
+	states := []*db.AggregationState{}
+	for hash, s := range p.state.signatures {
+		if s.submitted || s.ourObs == nil {
+			continue
+		}
+
+		v, ok := s.ourObservation.(*VAA)
+		if !ok {
+			continue
+		}
+
+		vaaBytes, err := v.VAA.Marshal()
+		if err != nil {
+			p.logger.Error("failed to marshal observation for checkpoint", zap.String("message_id", s.LoggingID()), zap.String("digest", hash), zap.Error(err))
+			continue
+		}
+
+		signatures := make(map[ethCommon.Address][]byte, len(s.signatures))
+		for addr, sig := range s.signatures {
+			signatures[addr] = sig
+		}
+
+		states = append(states, &db.AggregationState{
+			Digest:        s.ourObs.Hash,
+			FirstObserved: s.firstObserved,
+			NextRetry:     s.nextRetry,
+			RetryCtr:      s.retryCtr,
+			VAA:           vaaBytes,
+			Unreliable:    v.Unreliable,
+			Reobservation: v.Reobservation,
+			TxHash:        s.txHash,
+			Signatures:    signatures,
+		})
+	}
+
+	if err := p.db.CheckpointAggregationStates(states); err != nil {
+		p.logger.Error("failed to checkpoint aggregation state", zap.Int("numStates", len(states)), zap.Error(err))
+		return
+	}
+	aggregationStateCheckpointed.Set(float64(len(states)))
+}
+
+// restoreAggregationState loads the aggregation state checkpointed by a previous run, and rebroadcasts our
+// signatures. It must be called once the guardian set is known, and only states that were observed with the
+// current guardian set are restored.
+func (p *Processor) restoreAggregationState() {
+	if p.db == nil {
+		return
+	}
+
+	states, err := p.db.GetAggregationStates(p.logger)
+	if err != nil {
+		p.logger.Error("failed to load aggregation state checkpoint", zap.Error(err))
+		return
+	}
+
+	numRestored := 0
+	for _, st := range states {
+		if outcome := p.restoreState(st); outcome != "restored" {
+			aggregationStateRestored.WithLabelValues(outcome).Inc()
+			p.logger.Info("not restoring checkpointed aggregation state",
+				zap.String("digest", hex.EncodeToString(st.Digest)),
+				zap.String("reason", outcome),
+			)
+			continue
+		}
+		aggregationStateRestored.WithLabelValues("restored").Inc()
+		numRestored++
+	}
+
+	p.logger.Info("restored aggregation state checkpoint", zap.Int("numCheckpointed", len(states)), zap.Int("numRestored", numRestored))
+}
+
+// restoreState restores a single checkpointed state and returns "restored", or the reason it was skipped.
+func (p *Processor) restoreState(st *db.AggregationState) string {
+	// The observation is discarded after retryLimitOurs, whether we restarted in the meantime or not.
+	if time.Since(st.FirstObserved) > retryLimitOurs {
+		return "expired"
+	}
+
+	v, err := vaa.Unmarshal(st.VAA)
+	if err != nil {
+		return "invalid_vaa"
+	}
+
+	digest := v.SigningDigest()
+	hash := hex.EncodeToString(digest.Bytes())
+	if hash != hex.EncodeToString(st.Digest) {
+		return "digest_mismatch"
+	}
+
+	if _, exists := p.state.signatures[hash]; exists {
+		return "already_present"
+	}
+
+	if v.GuardianSetIndex != p.gs.Index {
+		return "guardian_set_changed"
+	}
+
+	if p.haveSignedVAA(*db.VaaIDFromVAA(v)) {
+		return "already_signed"
+	}
+
+	// SECURITY defense-in-depth: only keep signatures that are valid for the guardian set.
+	signatures := make(map[ethCommon.Address][]byte, len(st.Signatures))
+	for addr, sig := range st.Signatures {
+		pk, err := crypto.Ecrecover(digest.Bytes(), sig)
+		if err != nil {
+			continue
+		}
+		if ethCommon.BytesToAddress(crypto.Keccak256(pk[1:])[12:]) != addr {
+			continue
+		}
+		if _, ok := p.gs.KeyIndex(addr); !ok {
+			continue
+		}
+		signatures[addr] = sig
+	}
+
+	ourSignature, ok := signatures[p.ourAddr]
+	if !ok {
+		return "missing_our_signature"
+	}
+
+	ourObservation := &VAA{
+		VAA:           *v,
+		Unreliable:    st.Unreliable,
+		Reobservation: st.Reobservation,
+	}
+
+	ourObs := &gossipv1.Observation{
+		Hash:      digest.Bytes(),
+		Signature: ourSignature,
+		TxHash:    st.TxHash,
+		MessageId: v.MessageID(),
+	}
+
+	s := &state{
+		firstObserved:  st.FirstObserved,
+		nextRetry:      st.NextRetry,
+		retryCtr:       st.RetryCtr,
+		ourObservation: ourObservation,
+		signatures:     signatures,
+		source:         v.EmitterChain.String(),
+		ourObs:         ourObs,
+		txHash:         st.TxHash,
+		gs:             p.gs,
+	}
+	p.state.signatures[hash] = s
+
+	// The other guardians may have dropped our signature while we were down, so broadcast it again.
+	p.postObservationToBatch(ourObs)
+	p.checkForQuorum(ourObs, s, s.gs, hash)
+
+	return "restored"
+}
@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func newCheckpointTestProcessor(t *testing.T, database *db.Database, signer guardiansigner.GuardianSigner, gs *common.GuardianSet) *Processor {
+	t.Helper()
+	return &Processor{
+		gossipAttestationSendC: make(chan []byte, 10),
+		gossipVaaSendC:         make(chan []byte, 10),
+		batchObsvPubC:          make(chan *gossipv1.Observation, 10),
+		guardianSigner:         signer,
+		gs:                     gs,
+		gst:                    common.NewGuardianSetState(nil),
+		db:                     database,
+		logger:                 zap.NewNop(),
+		state:                  &aggregationState{observationMap{}},
+		ourAddr:                crypto.PubkeyToAddress(signer.PublicKey(context.Background())),
+		pythnetVaas:            make(map[string]PythNetVaaEntry),
+		updatedVAAs:            make(map[string]*updateVaaEntry),
+	}
+}
+
+func signObservation(t *testing.T, signer guardiansigner.GuardianSigner, ourObs *gossipv1.Observation) *gossipv1.Observation {
+	t.Helper()
+	sig, err := signer.Sign(context.Background(), ourObs.Hash)
+	require.NoError(t, err)
+	return &gossipv1.Observation{Hash: ourObs.Hash, Signature: sig, TxHash: ourObs.TxHash, MessageId: ourObs.MessageId}
+}
+
+func TestAggregationStateCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+
+	signers := make([]guardiansigner.GuardianSigner, 4)
+	keys := make([]ethCommon.Address, 4)
+	for i := range signers {
+		signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+		require.NoError(t, err)
+		signers[i] = signer
+		keys[i] = crypto.PubkeyToAddress(signer.PublicKey(ctx))
+	}
+	gs := common.NewGuardianSet(keys, 0)
+	require.Equal(t, 3, gs.Quorum())
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+
+	msg := &common.MessagePublication{
+		TxID:             []byte{1, 2, 3, 4},
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Nonce:            42,
+		Sequence:         7,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		Payload:          []byte{0x01, 0x02},
+		ConsistencyLevel: 32,
+	}
+
+	// We observe the message and receive one more signature, which is not enough for quorum.
+	p1 := newCheckpointTestProcessor(t, database, signers[0], gs)
+	p1.stateRestored = true
+	p1.handleMessage(ctx, msg)
+	require.Len(t, p1.state.signatures, 1)
+	var hash string
+	for h := range p1.state.signatures {
+		hash = h
+	}
+	s1 := p1.state.signatures[hash]
+	p1.handleSingleObservation(keys[1].Bytes(), signObservation(t, signers[1], s1.ourObs))
+	s1.retryCtr = 2
+	require.False(t, s1.submitted)
+
+	// Observations we didn't make ourselves are not checkpointed.
+	p1.state.signatures["ff"] = &state{firstObserved: time.Now(), signatures: map[ethCommon.Address][]byte{}}
+
+	p1.checkpointAggregationState()
+
+	// After a restart, the state is restored and our signature is rebroadcast.
+	p2 := newCheckpointTestProcessor(t, database, signers[0], gs)
+	p2.restoreAggregationState()
+	require.Len(t, p2.state.signatures, 1)
+	s2 := p2.state.signatures[hash]
+	require.NotNil(t, s2)
+	assert.Len(t, s2.signatures, 2)
+	assert.Equal(t, uint(2), s2.retryCtr)
+	assert.True(t, s1.firstObserved.Equal(s2.firstObserved))
+	assert.Equal(t, msg.TxID, s2.txHash)
+	assert.Equal(t, s1.ourObservation.MessageID(), s2.ourObservation.MessageID())
+	assert.Equal(t, s1.ourObs.Signature, s2.ourObs.Signature)
+	require.Len(t, p2.batchObsvPubC, 1)
+	assert.Equal(t, hash, hex.EncodeToString((<-p2.batchObsvPubC).Hash))
+
+	// One more signature reaches quorum, and the state is no longer checkpointed.
+	p2.handleSingleObservation(keys[2].Bytes(), signObservation(t, signers[2], s2.ourObs))
+	require.True(t, s2.submitted)
+	p2.stateRestored = true
+	p2.checkpointAggregationState()
+	states, err := database.GetAggregationStates(zap.NewNop())
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
+
+func TestAggregationStateRestoreSkipsInvalidStates(t *testing.T) {
+	ctx := context.Background()
+	dbPath := t.TempDir()
+	database := db.OpenDb(zap.NewNop(), &dbPath)
+	defer database.Close()
+
+	signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	other, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+	require.NoError(t, err)
+	ourAddr := crypto.PubkeyToAddress(signer.PublicKey(ctx))
+	otherAddr := crypto.PubkeyToAddress(other.PublicKey(ctx))
+	gs := common.NewGuardianSet([]ethCommon.Address{ourAddr, otherAddr}, 3)
+
+	newState := func(sequence uint64, gsIndex uint32, firstObserved time.Time, sigSigner guardiansigner.GuardianSigner) *db.AggregationState {
+		v := getVAA()
+		v.Sequence = sequence
+		v.GuardianSetIndex = gsIndex
+		b, err := v.Marshal()
+		require.NoError(t, err)
+		digest := v.SigningDigest().Bytes()
+		sig, err := sigSigner.Sign(ctx, digest)
+		require.NoError(t, err)
+		return &db.AggregationState{
+			Digest:        digest,
+			FirstObserved: firstObserved,
+			VAA:           b,
+			Signatures:    map[ethCommon.Address][]byte{ourAddr: sig},
+		}
+	}
+
+	valid := newState(1, 3, time.Now(), signer)
+	expired := newState(2, 3, time.Now().Add(-retryLimitOurs-time.Minute), signer)
+	oldGuardianSet := newState(3, 2, time.Now(), signer)
+	forged := newState(4, 3, time.Now(), other)
+	require.NoError(t, database.CheckpointAggregationStates([]*db.AggregationState{valid, expired, oldGuardianSet, forged}))
+
+	p := newCheckpointTestProcessor(t, database, signer, gs)
+	p.restoreAggregationState()
+	require.Len(t, p.state.signatures, 1)
+	assert.NotNil(t, p.state.signatures[hex.EncodeToString(valid.Digest)])
+}
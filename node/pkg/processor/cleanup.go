@@ -99,6 +99,10 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			}
 		}
 
+		if p.shouldFetchObservations(s, delta) {
+			p.fetchObservations(hash, s)
+		}
+
 		switch {
 		case !s.settled && delta > settlementTime:
 			// After 30 seconds, the observation is considered settled - it's unlikely that more observations will
@@ -141,6 +145,11 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					aggregationStateFulfillment.WithLabelValues(k.Hex(), s.source, "missing").Inc()
 				}
 			}
+
+			// The chain is only known for observations we made ourselves.
+			if p.performance != nil && s.ourObservation != nil {
+				p.performance.recordSettled(time.Now(), chain, s, gs.Keys)
+			}
 		case s.submitted && delta.Hours() >= 1:
 			// We could delete submitted observations right away, but then we'd lose context about additional (late)
 			// observation that come in. Therefore, keep it for a reasonable amount of time.
@@ -267,6 +276,11 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			delete(p.pythnetVaas, key)
 		}
 	}
+
+	// Checkpoint our unsubmitted observations, unless the previous checkpoint has not been restored yet.
+	if p.stateRestored {
+		p.checkpointAggregationState()
+	}
 }
 
 // signedVaaAlreadyInDB checks if the VAA is already in the DB. If it is, it makes sure the hash matches.
@@ -0,0 +1,95 @@
+package processor
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	observationFetchesRequested = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_observation_fetches_requested_total",
+			Help: "Total number of requests to fetch observations or VAAs directly from other guardians",
+		}, []string{"type"})
+	observationFetchChannelOverflow = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_observation_fetch_channel_overflow",
+			Help: "Total number of observation fetch requests dropped due to channel overflow",
+		})
+)
+
+// maxObservationFetches is how many times we try to fetch the missing observations of a message directly from other guardians.
+const maxObservationFetches = 3
+
+var (
+	// ObservationFetchDelay is how long we wait for observations to arrive on gossip before fetching them directly.
+	ObservationFetchDelay = time.Second * 10
+)
+
+// shouldFetchObservations returns true if the observations missing for an unsubmitted observation of ours should be fetched.
+func (p *Processor) shouldFetchObservations(s *state, delta time.Duration) bool {
+	return p.obsvFetchC != nil && !s.submitted && s.ourObs != nil && s.fetchCtr < maxObservationFetches && delta > ObservationFetchDelay
+}
+
+// fetchObservations asks the guardians whose signatures we are missing for their observations. It also asks the
+// guardians that did sign for the VAA, in case the network reached quorum without us.
+func (p *Processor) fetchObservations(hash string, s *state) {
+	gs := s.gs
+	if gs == nil {
+		gs = p.gs
+	}
+	if gs == nil {
+		return
+	}
+
+	digest, err := hex.DecodeString(hash)
+	if err != nil {
+		p.logger.Error("failed to decode digest", zap.String("digest", hash), zap.Error(err))
+		return
+	}
+
+	var missing, signed []ethCommon.Address
+	for _, k := range gs.Keys {
+		if k == p.ourAddr {
+			continue
+		}
+		if _, ok := s.signatures[k]; ok {
+			signed = append(signed, k)
+		} else {
+			missing = append(missing, k)
+		}
+	}
+
+	s.fetchCtr++
+
+	if len(missing) != 0 {
+		p.postObservationFetch(&p2p.ObservationFetchRequest{Digest: digest, Guardians: missing}, "observation")
+	}
+	if len(signed) != 0 {
+		p.postObservationFetch(&p2p.ObservationFetchRequest{MessageID: s.ourObservation.MessageID(), Guardians: signed}, "vaa")
+	}
+
+	p.logger.Info("fetching missing observations from guardians",
+		zap.String("message_id", s.LoggingID()),
+		zap.String("digest", hash),
+		zap.Int("numMissing", len(missing)),
+		zap.Int("numSigned", len(signed)),
+		zap.Uint("attempt", s.fetchCtr),
+	)
+}
+
+// postObservationFetch posts a fetch request to p2p without blocking.
+func (p *Processor) postObservationFetch(req *p2p.ObservationFetchRequest, reqType string) {
+	select {
+	case p.obsvFetchC <- req:
+		observationFetchesRequested.WithLabelValues(reqType).Inc()
+	default:
+		observationFetchChannelOverflow.Inc()
+	}
+}
@@ -0,0 +1,84 @@
+package processor
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	"github.com/certusone/wormhole/node/pkg/p2p"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestFetchObservations(t *testing.T) {
+	ctx := context.Background()
+
+	signers := make([]guardiansigner.GuardianSigner, 4)
+	keys := make([]ethCommon.Address, 4)
+	for i := range signers {
+		signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+		require.NoError(t, err)
+		signers[i] = signer
+		keys[i] = crypto.PubkeyToAddress(signer.PublicKey(ctx))
+	}
+	gs := common.NewGuardianSet(keys, 0)
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+	msg := &common.MessagePublication{
+		TxID:             []byte{1, 2, 3, 4},
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Sequence:         7,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		Payload:          []byte{0x01, 0x02},
+		ConsistencyLevel: 32,
+	}
+
+	obsvFetchC := make(chan *p2p.ObservationFetchRequest, 10)
+	p := newCheckpointTestProcessor(t, nil, signers[0], gs)
+	p.obsvFetchC = obsvFetchC
+	p.handleMessage(ctx, msg)
+	require.Len(t, p.state.signatures, 1)
+	var hash string
+	for h := range p.state.signatures {
+		hash = h
+	}
+	s := p.state.signatures[hash]
+	p.handleSingleObservation(keys[2].Bytes(), signObservation(t, signers[2], s.ourObs))
+
+	// Nothing is fetched before the observations had a chance to arrive on gossip.
+	p.handleCleanup(ctx)
+	assert.Empty(t, obsvFetchC)
+
+	s.firstObserved = time.Now().Add(-ObservationFetchDelay - time.Second)
+	p.handleCleanup(ctx)
+	require.Len(t, obsvFetchC, 2)
+
+	digest, err := hex.DecodeString(hash)
+	require.NoError(t, err)
+	assert.Equal(t, &p2p.ObservationFetchRequest{Digest: digest, Guardians: []ethCommon.Address{keys[1], keys[3]}}, <-obsvFetchC)
+	assert.Equal(t, &p2p.ObservationFetchRequest{MessageID: msg.MessageIDString(), Guardians: []ethCommon.Address{keys[2]}}, <-obsvFetchC)
+
+	// The number of fetches is limited.
+	for i := 1; i < maxObservationFetches+2; i++ {
+		p.handleCleanup(ctx)
+	}
+	assert.Len(t, obsvFetchC, 2*(maxObservationFetches-1))
+	assert.Equal(t, uint(maxObservationFetches), s.fetchCtr)
+
+	// Nothing is fetched once the VAA was submitted.
+	for len(obsvFetchC) != 0 {
+		<-obsvFetchC
+	}
+	s.fetchCtr = 0
+	s.submitted = true
+	p.handleCleanup(ctx)
+	assert.Empty(t, obsvFetchC)
+}
@@ -0,0 +1,127 @@
+package processor
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+// InspectRequest asks the processor for its aggregation state of a message, identified by either its message ID
+// or its hex-encoded signing digest. The processor answers on ResponseC, which must be buffered.
+type InspectRequest struct {
+	MessageID string
+	Digest    string
+	ResponseC chan<- []*ObservationInfo
+}
+
+// ObservationInfo is a snapshot of the aggregation state of an observation.
+type ObservationInfo struct {
+	Digest    string
+	MessageID string
+	// FirstObserved is the time the digest was first seen, possibly before we observed it ourselves.
+	FirstObserved time.Time
+	// NextRetry is the time before which no re-observation request is sent.
+	NextRetry time.Time
+	// RetryCount is the number of re-observation requests that were sent.
+	RetryCount uint
+	// OurObservation is set if we observed and signed the message ourselves, in which case OurObs is our signed observation.
+	OurObservation bool
+	OurObs         *gossipv1.Observation
+	Submitted      bool
+	Settled        bool
+	TxHash         []byte
+	// GuardianSetIndex and Quorum are of the guardian set the signatures are checked against.
+	GuardianSetIndex uint32
+	Quorum           int
+	// SignedBy are the guardians whose signatures were received, and Missing are the guardians of the guardian set
+	// whose signatures are missing.
+	SignedBy []ethCommon.Address
+	Missing  []ethCommon.Address
+}
+
+// Inspect sends an InspectRequest to the processor over inspectC and waits for the response.
+func Inspect(ctx context.Context, inspectC chan<- *InspectRequest, messageID string, digest string) ([]*ObservationInfo, error) {
+	responseC := make(chan []*ObservationInfo, 1)
+	req := &InspectRequest{MessageID: messageID, Digest: digest, ResponseC: responseC}
+
+	select {
+	case inspectC <- req:
+	case <-ctx.Done():
+		return nil, errors.New("timed out waiting for the processor to accept the request")
+	}
+
+	select {
+	case infos := <-responseC:
+		return infos, nil
+	case <-ctx.Done():
+		return nil, errors.New("timed out waiting for the processor to respond")
+	}
+}
+
+// handleInspectRequest answers an InspectRequest from the aggregation state.
+func (p *Processor) handleInspectRequest(req *InspectRequest) {
+	infos := []*ObservationInfo{}
+
+	if req.Digest != "" {
+		if s, exists := p.state.signatures[req.Digest]; exists {
+			infos = append(infos, p.observationInfo(req.Digest, s))
+		}
+	} else if req.MessageID != "" {
+		// The aggregation state is keyed by digest, so we can only find the observations we made ourselves.
+		for hash, s := range p.state.signatures {
+			if s.ourObservation != nil && s.ourObservation.MessageID() == req.MessageID {
+				infos = append(infos, p.observationInfo(hash, s))
+			}
+		}
+	}
+
+	req.ResponseC <- infos
+}
+
+func (p *Processor) observationInfo(hash string, s *state) *ObservationInfo {
+	info := &ObservationInfo{
+		Digest:         hash,
+		FirstObserved:  s.firstObserved,
+		NextRetry:      s.nextRetry,
+		RetryCount:     s.retryCtr,
+		OurObservation: s.ourObservation != nil,
+		OurObs:         s.ourObs,
+		Submitted:      s.submitted,
+		Settled:        s.settled,
+		TxHash:         s.txHash,
+	}
+
+	if s.ourObservation != nil {
+		info.MessageID = s.ourObservation.MessageID()
+	}
+
+	// Use either the stored guardian set or the most recent one, like the cleanup does.
+	gs := s.gs
+	if gs == nil {
+		gs = p.gs
+	}
+
+	for addr := range s.signatures {
+		info.SignedBy = append(info.SignedBy, addr)
+	}
+	sort.Slice(info.SignedBy, func(i, j int) bool {
+		return bytes.Compare(info.SignedBy[i].Bytes(), info.SignedBy[j].Bytes()) < 0
+	})
+
+	if gs != nil {
+		info.GuardianSetIndex = gs.Index
+		info.Quorum = gs.Quorum()
+		for _, k := range gs.Keys {
+			if _, ok := s.signatures[k]; !ok {
+				info.Missing = append(info.Missing, k)
+			}
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,85 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestInspect(t *testing.T) {
+	ctx := context.Background()
+
+	signers := make([]guardiansigner.GuardianSigner, 3)
+	keys := make([]ethCommon.Address, 3)
+	for i := range signers {
+		signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+		require.NoError(t, err)
+		signers[i] = signer
+		keys[i] = crypto.PubkeyToAddress(signer.PublicKey(ctx))
+	}
+	gs := common.NewGuardianSet(keys, 4)
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+	msg := &common.MessagePublication{
+		TxID:             []byte{1, 2, 3, 4},
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Sequence:         7,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		Payload:          []byte{0x01, 0x02},
+		ConsistencyLevel: 32,
+	}
+
+	p := newCheckpointTestProcessor(t, nil, signers[0], gs)
+	p.handleMessage(ctx, msg)
+	require.Len(t, p.state.signatures, 1)
+	var hash string
+	for h := range p.state.signatures {
+		hash = h
+	}
+	p.handleSingleObservation(keys[2].Bytes(), signObservation(t, signers[2], p.state.signatures[hash].ourObs))
+
+	// Observations of other guardians can only be found by digest.
+	p.state.signatures["ff"] = &state{firstObserved: time.Now(), signatures: map[ethCommon.Address][]byte{keys[1]: {1}}}
+
+	inspect := func(messageID string, digest string) []*ObservationInfo {
+		t.Helper()
+		responseC := make(chan []*ObservationInfo, 1)
+		p.handleInspectRequest(&InspectRequest{MessageID: messageID, Digest: digest, ResponseC: responseC})
+		return <-responseC
+	}
+
+	byMessageID := inspect(msg.MessageIDString(), "")
+	require.Len(t, byMessageID, 1)
+	info := byMessageID[0]
+	assert.Equal(t, hash, info.Digest)
+	assert.Equal(t, msg.MessageIDString(), info.MessageID)
+	assert.True(t, info.OurObservation)
+	assert.Equal(t, p.state.signatures[hash].ourObs, info.OurObs)
+	assert.False(t, info.Submitted)
+	assert.Equal(t, msg.TxID, info.TxHash)
+	assert.Equal(t, uint32(4), info.GuardianSetIndex)
+	assert.Equal(t, 3, info.Quorum)
+	assert.ElementsMatch(t, []ethCommon.Address{keys[0], keys[2]}, info.SignedBy)
+	assert.Equal(t, []ethCommon.Address{keys[1]}, info.Missing)
+
+	assert.Equal(t, byMessageID, inspect("", hash))
+
+	other := inspect("", "ff")
+	require.Len(t, other, 1)
+	assert.False(t, other[0].OurObservation)
+	assert.Empty(t, other[0].MessageID)
+	assert.Equal(t, []ethCommon.Address{keys[1]}, other[0].SignedBy)
+
+	assert.Empty(t, inspect("2/0000000000000000000000000000000000000000000000000000000000000004/1", ""))
+	assert.Empty(t, inspect("", "00"))
+}
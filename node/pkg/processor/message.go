@@ -15,6 +15,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -28,6 +29,12 @@ var (
 			Help: "Total number of messages observed",
 		},
 		[]string{"emitter_chain"})
+
+	observationSigningFailures = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_observation_signing_failures_total",
+			Help: "Total number of observed messages that were dropped because the guardian signer failed to sign them",
+		})
 )
 
 // handleMessage processes a message received from a chain and instantiates our deterministic copy of the VAA. An
@@ -70,9 +77,18 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 	hash := hex.EncodeToString(digest.Bytes())
 
 	// Sign the digest using the node's GuardianSigner
-	signature, err := p.guardianSigner.Sign(ctx, digest.Bytes())
+	signature, err := p.guardianSigner.Sign(guardiansigner.WithDigestType(ctx, guardiansigner.DigestTypeObservation), digest.Bytes())
 	if err != nil {
-		panic(err)
+		// The message is dropped rather than retried, so that an outage of the signer does not back up the processor.
+		// It can be reobserved once the signer is available again.
+		observationSigningFailures.Inc()
+		p.logger.Error("failed to sign observation, dropping message",
+			zap.String("message_id", k.MessageIDString()),
+			zap.String("txID", k.TxIDString()),
+			zap.String("hash", hash),
+			zap.Error(err),
+		)
+		return
 	}
 
 	shouldPublishImmediately := p.shouldPublishImmediately(&v.VAA)
@@ -117,7 +133,7 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 	s.txHash = k.TxID
 	s.source = v.GetEmitterChain().String()
 	s.gs = p.gs // guaranteed to match ourObservation - there's no concurrent access to p.gs
-	s.signatures[p.ourAddr] = signature
+	s.addSignature(p.ourAddr, signature)
 	s.ourObs = ourObs
 	s.ourMsg = msg
 
@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestHandleMessageSignatureBudget(t *testing.T) {
+	ctx := context.Background()
+	signer, err := guardiansigner.NewSupervisedSigner(ctx, []string{"file://../query/dev.guardian.key"}, true,
+		guardiansigner.SupervisedSignerConfig{MaxSignaturesPerSecond: 0.001, SignatureBurst: 1})
+	require.NoError(t, err)
+
+	keys := []ethCommon.Address{crypto.PubkeyToAddress(signer.PublicKey(ctx))}
+	for i := 0; i < 3; i++ {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys = append(keys, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	p := newCheckpointTestProcessor(t, nil, signer, common.NewGuardianSet(keys, 0))
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+	newMsg := func(sequence uint64) *common.MessagePublication {
+		return &common.MessagePublication{
+			TxID:             []byte{1, 2, 3, 4},
+			Timestamp:        time.Unix(1_700_000_000, 0),
+			Sequence:         sequence,
+			EmitterChain:     vaa.ChainIDEthereum,
+			EmitterAddress:   emitterAddress,
+			Payload:          []byte{0x01, 0x02},
+			ConsistencyLevel: 32,
+		}
+	}
+
+	// The first message uses up the budget.
+	p.handleMessage(ctx, newMsg(1))
+	require.Len(t, p.state.signatures, 1)
+
+	// Messages past the budget wait for it. If they can't be signed, they are dropped instead of crashing the node.
+	failures := testutil.ToFloat64(observationSigningFailures)
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+	require.NotPanics(t, func() { p.handleMessage(timeoutCtx, newMsg(2)) })
+	assert.Len(t, p.state.signatures, 1)
+	assert.Equal(t, failures+1, testutil.ToFloat64(observationSigningFailures))
+}
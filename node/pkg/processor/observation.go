@@ -67,16 +67,28 @@ func signaturesToVaaFormat(signatures map[common.Address][]byte, gsKeys []common
 	return sigs
 }
 
-// handleBatchObservation processes a batch of remote VAA observations.
+// handleBatchObservation processes a batch of remote VAA observations. If the batch contains an invalid signature, the peer that
+// originated it is reported to p2p, so that it is penalized.
 func (p *Processor) handleBatchObservation(m *node_common.MsgWithTimeStamp[gossipv1.SignedObservationBatch]) {
+	invalid := false
 	for _, obs := range m.Msg.Observations {
-		p.handleSingleObservation(m.Msg.Addr, obs)
+		if !p.handleSingleObservation(m.Msg.Addr, obs) {
+			invalid = true
+		}
+	}
+	if invalid && m.From != "" && p.invalidObsvC != nil {
+		select {
+		case p.invalidObsvC <- m.From:
+		default:
+			batchObservationChannelOverflow.WithLabelValues("invalidObsv").Inc()
+		}
 	}
 	batchObservationTotalDelay.Observe(float64(time.Since(m.Timestamp).Microseconds()))
 }
 
 // handleObservation processes a remote VAA observation, verifies it, checks whether the VAA has met quorum, and assembles and submits a valid VAA if possible.
-func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation) {
+// It returns false if the signature of the observation is invalid, and true otherwise.
+func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation) bool {
 	// SECURITY: at this point, observations received from the p2p network are fully untrusted (all fields!)
 	//
 	// Note that observations are never tied to the (verified) p2p identity key - the p2p network
@@ -91,7 +103,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 	if s != nil && s.submitted {
 		// already submitted; ignoring additional signatures for it.
 		timeToHandleObservation.Observe(float64(time.Since(start).Microseconds()))
-		return
+		return true
 	}
 
 	if p.logger.Core().Enabled(zapcore.DebugLevel) {
@@ -137,7 +149,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 			zap.String("their_addr", their_addr.Hex()),
 		)
 		observationsFailedTotal.WithLabelValues("uninitialized_guardian_set").Inc()
-		return
+		return true
 	}
 
 	// Verify that addr is included in the guardian set. If it's not, drop the message. In case it's us
@@ -154,7 +166,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 			)
 		}
 		observationsFailedTotal.WithLabelValues("unknown_guardian").Inc()
-		return
+		return true
 	}
 
 	// Verify the Guardian's signature. This verifies that m.Signature matches m.Hash and recovers
@@ -168,7 +180,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 			zap.String("addr", hex.EncodeToString(addr)),
 			zap.Error(err))
 		observationsFailedTotal.WithLabelValues("invalid_signature").Inc()
-		return
+		return false
 	}
 
 	// Verify that addr matches the public key that signed m.Hash.
@@ -182,7 +194,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 			zap.String("addr", hex.EncodeToString(addr)),
 			zap.String("pk", signer_pk.Hex()))
 		observationsFailedTotal.WithLabelValues("pubkey_mismatch").Inc()
-		return
+		return false
 	}
 
 	// Hooray! Now, we have verified all fields on the observation and know that it includes
@@ -214,7 +226,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 		p.state.signatures[hash] = s
 	}
 
-	s.signatures[their_addr] = m.Signature
+	s.addSignature(their_addr, m.Signature)
 
 	if s.ourObservation != nil {
 		p.checkForQuorum(m, s, gs, hash)
@@ -229,6 +241,7 @@ func (p *Processor) handleSingleObservation(addr []byte, m *gossipv1.Observation
 	}
 
 	timeToHandleObservation.Observe(float64(time.Since(start).Microseconds()))
+	return true
 }
 
 // checkForQuorum checks for quorum after a valid signature has been added to the observation state. If quorum is met, it broadcasts the signed VAA. This function
@@ -342,5 +355,11 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(m *gossipv1.SignedVAAWithQu
 		)
 	}
 
-	p.storeSignedVAA(v)
+	// If we observed this message ourselves, we also know the source transaction.
+	var txHash []byte
+	if s, exists := p.state.signatures[hex.EncodeToString(v.SigningDigest().Bytes())]; exists {
+		txHash = s.txHash
+	}
+
+	p.storeSignedVAA(v, txHash)
 }
@@ -1,16 +1,20 @@
 package processor
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/rand"
 	"testing"
 	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest/observer"
@@ -120,3 +124,42 @@ func TestHandleInboundSignedVAAWithQuorum(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleBatchObservationReportsInvalidSignatures(t *testing.T) {
+	ctx := context.Background()
+	signers := make([]guardiansigner.GuardianSigner, 2)
+	keys := make([]ethcommon.Address, 2)
+	for i := range signers {
+		signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+		require.NoError(t, err)
+		signers[i] = signer
+		keys[i] = crypto.PubkeyToAddress(signer.PublicKey(ctx))
+	}
+
+	invalidObsvC := make(chan peer.ID, 10)
+	p := newCheckpointTestProcessor(t, nil, signers[0], common.NewGuardianSet(keys, 0))
+	p.invalidObsvC = invalidObsvC
+
+	newBatch := func(signer guardiansigner.GuardianSigner, from peer.ID) *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch] {
+		hash := make([]byte, 32)
+		_, err := rand.Read(hash)
+		require.NoError(t, err)
+		obs := signObservation(t, signer, &gossipv1.Observation{Hash: hash, TxHash: []byte{1}, MessageId: "2/0000000000000000000000000000000000000000000000000000000000000004/1"})
+		m := common.CreateMsgWithTimestamp(&gossipv1.SignedObservationBatch{Addr: keys[1].Bytes(), Observations: []*gossipv1.Observation{obs}})
+		m.From = from
+		return m
+	}
+
+	// Valid observations are not reported.
+	p.handleBatchObservation(newBatch(signers[1], "relaying-peer"))
+	assert.Empty(t, invalidObsvC)
+
+	// The peer that originated a batch with a signature that does not match the guardian is reported.
+	p.handleBatchObservation(newBatch(signers[0], "relaying-peer"))
+	require.Len(t, invalidObsvC, 1)
+	assert.Equal(t, peer.ID("relaying-peer"), <-invalidObsvC)
+
+	// Observations that were not received from gossip have no peer to report.
+	p.handleBatchObservation(newBatch(signers[0], ""))
+	assert.Empty(t, invalidObsvC)
+}
@@ -0,0 +1,149 @@
+package processor
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+const (
+	// GuardianPerformanceWindow is the time range covered by the guardian performance report.
+	GuardianPerformanceWindow = 24 * time.Hour
+	// guardianPerformanceMaxSamples bounds the number of settled observations kept per guardian and chain.
+	guardianPerformanceMaxSamples = 10_000
+)
+
+type (
+	// performanceSample is the outcome of a settled observation for a single guardian.
+	performanceSample struct {
+		settled time.Time
+		present bool
+		// latency is the time between the digest being first seen and the guardian's signature arriving. It is only
+		// set if latencyKnown is, which isn't the case for signatures restored from a checkpoint.
+		latency      time.Duration
+		latencyKnown bool
+	}
+
+	performanceKey struct {
+		guardian ethCommon.Address
+		chain    vaa.ChainID
+	}
+
+	// GuardianPerformance keeps a rolling record of which guardians signed the observations that settled, per
+	// chain. It is updated by the processor and read by the admin service.
+	GuardianPerformance struct {
+		mu      sync.Mutex
+		window  time.Duration
+		samples map[performanceKey][]performanceSample
+	}
+
+	// GuardianPerformanceEntry summarizes the performance of a guardian on a chain over the report window.
+	GuardianPerformanceEntry struct {
+		Guardian ethCommon.Address
+		Chain    vaa.ChainID
+		// Observations is the number of settled observations, of which the guardian signed Signed and missed Missed.
+		Observations int
+		Signed       int
+		Missed       int
+		// ParticipationRate is Signed / Observations.
+		ParticipationRate float64
+		// MedianLatency is the median time between the digest being first seen and the guardian's signature arriving.
+		MedianLatency time.Duration
+	}
+)
+
+// NewGuardianPerformance creates a GuardianPerformance that reports over the given window.
+func NewGuardianPerformance(window time.Duration) *GuardianPerformance {
+	return &GuardianPerformance{
+		window:  window,
+		samples: make(map[performanceKey][]performanceSample),
+	}
+}
+
+// recordSettled records the outcome of a settled observation for each guardian of the guardian set.
+func (gp *GuardianPerformance) recordSettled(now time.Time, chain vaa.ChainID, s *state, keys []ethCommon.Address) {
+	gp.mu.Lock()
+	defer gp.mu.Unlock()
+
+	for _, k := range keys {
+		sample := performanceSample{settled: now}
+		if _, ok := s.signatures[k]; ok {
+			sample.present = true
+			if signedAt, ok := s.signedAt[k]; ok {
+				sample.latency = signedAt.Sub(s.firstObserved)
+				sample.latencyKnown = true
+			}
+		}
+
+		key := performanceKey{guardian: k, chain: chain}
+		samples := gp.prune(now, gp.samples[key])
+		if len(samples) >= guardianPerformanceMaxSamples {
+			samples = samples[len(samples)-guardianPerformanceMaxSamples+1:]
+		}
+		gp.samples[key] = append(samples, sample)
+	}
+}
+
+// prune drops the samples that fell out of the window. Samples are recorded in chronological order.
+func (gp *GuardianPerformance) prune(now time.Time, samples []performanceSample) []performanceSample {
+	cutoff := now.Add(-gp.window)
+	i := sort.Search(len(samples), func(i int) bool { return samples[i].settled.After(cutoff) })
+	return samples[i:]
+}
+
+// Window returns the time range covered by the report.
+func (gp *GuardianPerformance) Window() time.Duration {
+	return gp.window
+}
+
+// Report returns the performance of each guardian on each chain over the window, sorted by chain and guardian.
+func (gp *GuardianPerformance) Report(now time.Time) []*GuardianPerformanceEntry {
+	gp.mu.Lock()
+	defer gp.mu.Unlock()
+
+	entries := []*GuardianPerformanceEntry{}
+	for key, samples := range gp.samples {
+		samples = gp.prune(now, samples)
+		if len(samples) == 0 {
+			delete(gp.samples, key)
+			continue
+		}
+		gp.samples[key] = samples
+
+		entry := &GuardianPerformanceEntry{
+			Guardian:     key.guardian,
+			Chain:        key.chain,
+			Observations: len(samples),
+		}
+		latencies := []time.Duration{}
+		for _, sample := range samples {
+			if !sample.present {
+				entry.Missed++
+				continue
+			}
+			entry.Signed++
+			if sample.latencyKnown {
+				latencies = append(latencies, sample.latency)
+			}
+		}
+		entry.ParticipationRate = float64(entry.Signed) / float64(entry.Observations)
+		if len(latencies) > 0 {
+			sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+			entry.MedianLatency = latencies[len(latencies)/2]
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Chain != entries[j].Chain {
+			return entries[i].Chain < entries[j].Chain
+		}
+		return bytes.Compare(entries[i].Guardian.Bytes(), entries[j].Guardian.Bytes()) < 0
+	})
+
+	return entries
+}
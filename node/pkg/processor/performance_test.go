@@ -0,0 +1,124 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/guardiansigner"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+func TestGuardianPerformanceReport(t *testing.T) {
+	g1 := ethCommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	g2 := ethCommon.HexToAddress("0x0000000000000000000000000000000000000002")
+	keys := []ethCommon.Address{g1, g2}
+
+	start := time.Unix(1_700_000_000, 0)
+	gp := NewGuardianPerformance(time.Hour)
+
+	// g1 signs every observation with increasing latency, g2 only signs the first one.
+	for i := 0; i < 3; i++ {
+		s := &state{firstObserved: start, signatures: map[ethCommon.Address][]byte{g1: {1}}, signedAt: map[ethCommon.Address]time.Time{
+			g1: start.Add(time.Duration(i+1) * time.Second),
+		}}
+		if i == 0 {
+			s.signatures[g2] = []byte{2}
+		}
+		gp.recordSettled(start, vaa.ChainIDEthereum, s, keys)
+	}
+
+	// A restored signature counts towards participation, but not towards latency.
+	gp.recordSettled(start, vaa.ChainIDSolana, &state{firstObserved: start, signatures: map[ethCommon.Address][]byte{g2: {2}}}, keys)
+
+	entries := gp.Report(start.Add(time.Minute))
+	require.Len(t, entries, 4)
+
+	assert.Equal(t, &GuardianPerformanceEntry{
+		Guardian: g1, Chain: vaa.ChainIDSolana, Observations: 1, Missed: 1,
+	}, entries[0])
+	assert.Equal(t, &GuardianPerformanceEntry{
+		Guardian: g2, Chain: vaa.ChainIDSolana, Observations: 1, Signed: 1, ParticipationRate: 1,
+	}, entries[1])
+	assert.Equal(t, &GuardianPerformanceEntry{
+		Guardian: g1, Chain: vaa.ChainIDEthereum, Observations: 3, Signed: 3, ParticipationRate: 1, MedianLatency: 2 * time.Second,
+	}, entries[2])
+	assert.Equal(t, g2, entries[3].Guardian)
+	assert.Equal(t, 1, entries[3].Signed)
+	assert.Equal(t, 2, entries[3].Missed)
+	assert.InDelta(t, 1.0/3, entries[3].ParticipationRate, 1e-9)
+
+	// Observations that settled before the window are dropped.
+	assert.Empty(t, gp.Report(start.Add(time.Hour)))
+	assert.Empty(t, gp.samples)
+}
+
+func TestGuardianPerformanceMaxSamples(t *testing.T) {
+	g1 := ethCommon.HexToAddress("0x0000000000000000000000000000000000000001")
+	now := time.Now()
+	gp := NewGuardianPerformance(time.Hour)
+
+	s := &state{firstObserved: now, signatures: map[ethCommon.Address][]byte{}}
+	for i := 0; i < guardianPerformanceMaxSamples+10; i++ {
+		gp.recordSettled(now, vaa.ChainIDEthereum, s, []ethCommon.Address{g1})
+	}
+
+	entries := gp.Report(now)
+	require.Len(t, entries, 1)
+	assert.Equal(t, guardianPerformanceMaxSamples, entries[0].Observations)
+}
+
+func TestGuardianPerformanceRecordedOnSettlement(t *testing.T) {
+	ctx := context.Background()
+	signers, keys := generateSignersAndKeys(t, 3)
+	gs := common.NewGuardianSet(keys, 0)
+
+	p := newCheckpointTestProcessor(t, nil, signers[0], gs)
+	p.performance = NewGuardianPerformance(time.Hour)
+
+	emitterAddress, err := vaa.StringToAddress("0x3ee18B2214AFF97000D974cf647E7C347E8fa585")
+	require.NoError(t, err)
+	p.handleMessage(ctx, &common.MessagePublication{
+		TxID:             []byte{1},
+		Timestamp:        time.Unix(1_700_000_000, 0),
+		Sequence:         1,
+		EmitterChain:     vaa.ChainIDEthereum,
+		EmitterAddress:   emitterAddress,
+		ConsistencyLevel: 32,
+	})
+	require.Len(t, p.state.signatures, 1)
+	for _, s := range p.state.signatures {
+		p.handleSingleObservation(keys[1].Bytes(), signObservation(t, signers[1], s.ourObs))
+		s.firstObserved = s.firstObserved.Add(-settlementTime - time.Second)
+	}
+
+	p.handleCleanup(ctx)
+
+	entries := p.performance.Report(time.Now())
+	require.Len(t, entries, 3)
+	signed := map[ethCommon.Address]bool{}
+	for _, e := range entries {
+		assert.Equal(t, vaa.ChainIDEthereum, e.Chain)
+		assert.Equal(t, 1, e.Observations)
+		signed[e.Guardian] = e.Signed == 1
+	}
+	assert.Equal(t, map[ethCommon.Address]bool{keys[0]: true, keys[1]: true, keys[2]: false}, signed)
+}
+
+func generateSignersAndKeys(t *testing.T, n int) ([]guardiansigner.GuardianSigner, []ethCommon.Address) {
+	t.Helper()
+	signers := make([]guardiansigner.GuardianSigner, n)
+	keys := make([]ethCommon.Address, n)
+	for i := range signers {
+		signer, err := guardiansigner.GenerateSignerWithPrivatekeyUnsafe(nil)
+		require.NoError(t, err)
+		signers[i] = signer
+		keys[i] = crypto.PubkeyToAddress(signer.PublicKey(context.Background()))
+	}
+	return signers, keys
+}
@@ -0,0 +1,203 @@
+package processor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+var (
+	policyDecisionsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_processor_policy_decisions_total",
+			Help: "Total number of policy hook decisions on message publications, grouped by hook and action",
+		}, []string{"hook", "action"})
+	policyDelayedMessages = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_processor_policy_delayed_messages",
+			Help: "Number of message publications currently delayed by a policy hook",
+		})
+)
+
+const (
+	// PolicyCheckInterval is how often delayed messages are checked for release.
+	PolicyCheckInterval = time.Second
+	// defaultPolicyDelay is used if a hook delays a message without specifying for how long.
+	defaultPolicyDelay = time.Minute
+)
+
+// PolicyAction is the action a PolicyHook decides on for a message publication.
+type PolicyAction int
+
+const (
+	// PolicyAllow passes the message on to the next hook, and eventually to the governor and the accountant.
+	PolicyAllow PolicyAction = iota
+	// PolicyDelay holds the message and evaluates it again once the delay expired.
+	PolicyDelay
+	// PolicyReject drops the message. It may still be reobserved later.
+	PolicyReject
+)
+
+func (a PolicyAction) String() string {
+	switch a {
+	case PolicyAllow:
+		return "allow"
+	case PolicyDelay:
+		return "delay"
+	case PolicyReject:
+		return "reject"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(a))
+	}
+}
+
+// PolicyDecision is the result of evaluating a message publication.
+type PolicyDecision struct {
+	Action PolicyAction
+	// Reason is a human-readable explanation of the decision, which is logged.
+	Reason string
+	// Delay is how long the message is held if Action is PolicyDelay. Defaults to one minute.
+	Delay time.Duration
+}
+
+// Allow returns a decision to allow a message publication.
+func Allow() PolicyDecision {
+	return PolicyDecision{Action: PolicyAllow}
+}
+
+// Delay returns a decision to hold a message publication for the given duration.
+func Delay(delay time.Duration, reason string) PolicyDecision {
+	return PolicyDecision{Action: PolicyDelay, Reason: reason, Delay: delay}
+}
+
+// Reject returns a decision to drop a message publication.
+func Reject(reason string) PolicyDecision {
+	return PolicyDecision{Action: PolicyReject, Reason: reason}
+}
+
+// PolicyHook inspects message publications from the watchers before they are passed to the governor and the
+// accountant, and again when the governor or the accountant release a message they held, as a hook may have
+// changed its decision in the meantime. Hooks are evaluated in the order they were registered, and the first
+// decision other than PolicyAllow is final. Evaluate is called from the processor's main loop, so it must not block.
+type PolicyHook interface {
+	// Name identifies the hook in logs and metrics.
+	Name() string
+	Evaluate(ctx context.Context, msg *common.MessagePublication) PolicyDecision
+}
+
+type policyHookFunc struct {
+	name     string
+	evaluate func(ctx context.Context, msg *common.MessagePublication) PolicyDecision
+}
+
+func (h *policyHookFunc) Name() string {
+	return h.name
+}
+
+func (h *policyHookFunc) Evaluate(ctx context.Context, msg *common.MessagePublication) PolicyDecision {
+	return h.evaluate(ctx, msg)
+}
+
+// NewPolicyHook creates a PolicyHook from a function.
+func NewPolicyHook(name string, evaluate func(ctx context.Context, msg *common.MessagePublication) PolicyDecision) PolicyHook {
+	return &policyHookFunc{name: name, evaluate: evaluate}
+}
+
+// delayedMessage is a message publication held by a policy hook.
+type delayedMessage struct {
+	msg         *common.MessagePublication
+	releaseTime time.Time
+	// cleared is set if the governor and the accountant already passed the message, so it is handled directly
+	// once it is released.
+	cleared bool
+}
+
+// evaluatePolicy runs a message publication through the policy hooks and returns true if it may be processed.
+// Delayed messages are queued and evaluated again by releaseDelayedMessages. cleared is set for messages that
+// the governor and the accountant already passed.
+func (p *Processor) evaluatePolicy(ctx context.Context, k *common.MessagePublication, cleared bool) bool {
+	for _, hook := range p.policyHooks {
+		decision := hook.Evaluate(ctx, k)
+		policyDecisionsTotal.WithLabelValues(hook.Name(), decision.Action.String()).Inc()
+
+		switch decision.Action {
+		case PolicyAllow:
+			continue
+		case PolicyDelay:
+			delay := decision.Delay
+			if delay <= 0 {
+				delay = defaultPolicyDelay
+			}
+			p.logger.Info("message publication delayed by policy hook",
+				zap.String("message_id", k.MessageIDString()),
+				zap.String("hook", hook.Name()),
+				zap.String("reason", decision.Reason),
+				zap.Duration("delay", delay),
+			)
+			p.delayedMsgs = append(p.delayedMsgs, &delayedMessage{msg: k, releaseTime: time.Now().Add(delay), cleared: cleared})
+			policyDelayedMessages.Set(float64(len(p.delayedMsgs)))
+			return false
+		case PolicyReject:
+			p.logger.Warn("message publication rejected by policy hook",
+				zap.String("message_id", k.MessageIDString()),
+				zap.String("hook", hook.Name()),
+				zap.String("reason", decision.Reason),
+			)
+			return false
+		default:
+			// SECURITY defense-in-depth: fail closed on decisions we don't understand.
+			p.logger.Error("policy hook returned an unknown action, rejecting message publication",
+				zap.String("message_id", k.MessageIDString()),
+				zap.String("hook", hook.Name()),
+				zap.Stringer("action", decision.Action),
+			)
+			return false
+		}
+	}
+
+	if len(p.policyHooks) != 0 && p.logger.Level().Enabled(zapcore.DebugLevel) {
+		p.logger.Debug("message publication allowed by policy hooks", zap.String("message_id", k.MessageIDString()))
+	}
+
+	return true
+}
+
+// releaseDelayedMessages evaluates the delayed messages whose delay expired again and returns the ones that may
+// now be processed.
+func (p *Processor) releaseDelayedMessages(ctx context.Context, now time.Time) []*delayedMessage {
+	if len(p.delayedMsgs) == 0 {
+		return nil
+	}
+
+	var due []*delayedMessage
+	pending := p.delayedMsgs[:0]
+	for _, d := range p.delayedMsgs {
+		if now.Before(d.releaseTime) {
+			pending = append(pending, d)
+		} else {
+			due = append(due, d)
+		}
+	}
+	// Clear the references to the released messages beyond the new length.
+	for i := len(pending); i < len(p.delayedMsgs); i++ {
+		p.delayedMsgs[i] = nil
+	}
+	p.delayedMsgs = pending
+
+	released := make([]*delayedMessage, 0, len(due))
+	for _, d := range due {
+		// A hook may delay the message again, in which case it is re-queued.
+		if p.evaluatePolicy(ctx, d.msg, d.cleared) {
+			released = append(released, d)
+		}
+	}
+	policyDelayedMessages.Set(float64(len(p.delayedMsgs)))
+
+	return released
+}
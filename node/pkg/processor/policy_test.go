@@ -0,0 +1,112 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+func TestEvaluatePolicy(t *testing.T) {
+	ctx := context.Background()
+	msg := func(sequence uint64) *common.MessagePublication {
+		return &common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, Sequence: sequence}
+	}
+
+	var evaluated []string
+	hook := func(name string, decide func(k *common.MessagePublication) PolicyDecision) PolicyHook {
+		return NewPolicyHook(name, func(_ context.Context, k *common.MessagePublication) PolicyDecision {
+			evaluated = append(evaluated, name)
+			return decide(k)
+		})
+	}
+
+	p := &Processor{
+		logger: zap.NewNop(),
+		policyHooks: []PolicyHook{
+			hook("reject-odd", func(k *common.MessagePublication) PolicyDecision {
+				if k.Sequence%2 == 1 {
+					return Reject("odd sequence")
+				}
+				return Allow()
+			}),
+			hook("delay-four", func(k *common.MessagePublication) PolicyDecision {
+				switch k.Sequence {
+				case 4:
+					return Delay(time.Hour, "sequence four")
+				case 6:
+					return PolicyDecision{Action: PolicyAction(42)}
+				}
+				return Allow()
+			}),
+		},
+	}
+
+	assert.True(t, p.evaluatePolicy(ctx, msg(2), false))
+	assert.Equal(t, []string{"reject-odd", "delay-four"}, evaluated)
+
+	// The first decision other than allow is final.
+	evaluated = nil
+	assert.False(t, p.evaluatePolicy(ctx, msg(3), false))
+	assert.Equal(t, []string{"reject-odd"}, evaluated)
+	assert.Empty(t, p.delayedMsgs)
+
+	// Unknown actions are treated as rejections.
+	assert.False(t, p.evaluatePolicy(ctx, msg(6), false))
+	assert.Empty(t, p.delayedMsgs)
+
+	assert.False(t, p.evaluatePolicy(ctx, msg(4), false))
+	require.Len(t, p.delayedMsgs, 1)
+	assert.Equal(t, uint64(4), p.delayedMsgs[0].msg.Sequence)
+}
+
+func TestReleaseDelayedMessages(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	delays := map[uint64]int{}
+	p := &Processor{
+		logger: zap.NewNop(),
+		policyHooks: []PolicyHook{
+			// Delay every message once, and message 3 twice.
+			NewPolicyHook("delay", func(_ context.Context, k *common.MessagePublication) PolicyDecision {
+				delays[k.Sequence]++
+				if delays[k.Sequence] == 1 || (k.Sequence == 3 && delays[k.Sequence] == 2) {
+					return Delay(0, "")
+				}
+				return Allow()
+			}),
+		},
+	}
+
+	// Message 3 was already passed by the governor and the accountant.
+	for seq := uint64(1); seq <= 3; seq++ {
+		assert.False(t, p.evaluatePolicy(ctx, &common.MessagePublication{Sequence: seq}, seq == 3))
+	}
+	require.Len(t, p.delayedMsgs, 3)
+	// A delay of zero means the default delay.
+	p.delayedMsgs[0].releaseTime = now.Add(-time.Second)
+	p.delayedMsgs[2].releaseTime = now.Add(-time.Second)
+	assert.True(t, p.delayedMsgs[1].releaseTime.After(now.Add(defaultPolicyDelay/2)))
+
+	// Message 1 is released, message 2 is not due yet and message 3 is delayed again.
+	released := p.releaseDelayedMessages(ctx, now)
+	require.Len(t, released, 1)
+	assert.Equal(t, uint64(1), released[0].msg.Sequence)
+	assert.False(t, released[0].cleared)
+	require.Len(t, p.delayedMsgs, 2)
+	assert.Equal(t, uint64(2), p.delayedMsgs[0].msg.Sequence)
+	assert.Equal(t, uint64(3), p.delayedMsgs[1].msg.Sequence)
+
+	released = p.releaseDelayedMessages(ctx, now.Add(2*defaultPolicyDelay))
+	require.Len(t, released, 2)
+	assert.Equal(t, uint64(3), released[1].msg.Sequence)
+	assert.True(t, released[1].cleared)
+	assert.Empty(t, p.delayedMsgs)
+	assert.Empty(t, p.releaseDelayedMessages(ctx, now.Add(3*defaultPolicyDelay)))
+}
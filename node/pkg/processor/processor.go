@@ -14,6 +14,7 @@ import (
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"go.uber.org/zap"
 
 	"github.com/certusone/wormhole/node/pkg/accountant"
@@ -57,11 +58,15 @@ type (
 		nextRetry time.Time
 		// Number of times we sent a re-observation request
 		retryCtr uint
+		// Number of times we tried to fetch the missing observations directly from other guardians.
+		fetchCtr uint
 		// Copy of our observation.
 		ourObservation Observation
 		// Map of signatures seen by guardian. During guardian set updates, this may contain signatures belonging
 		// to either the old or new guardian set.
 		signatures map[ethcommon.Address][]byte
+		// Time at which each guardian's signature was first received, used for the guardian performance report.
+		signedAt map[ethcommon.Address]time.Time
 		// Flag set after reaching quorum and submitting the VAA.
 		submitted bool
 		// Flag set by the cleanup service after the settlement timeout has expired and misses were counted.
@@ -96,6 +101,17 @@ func (s *state) LoggingID() string {
 	return hex.EncodeToString(s.txHash)
 }
 
+// addSignature stores the signature of a guardian and, if it is the first one received from them, its arrival time.
+func (s *state) addSignature(addr ethcommon.Address, signature []byte) {
+	if _, exists := s.signatures[addr]; !exists {
+		if s.signedAt == nil {
+			s.signedAt = make(map[ethcommon.Address]time.Time)
+		}
+		s.signedAt[addr] = time.Now()
+	}
+	s.signatures[addr] = signature
+}
+
 type PythNetVaaEntry struct {
 	v          *vaa.VAA
 	updateTime time.Time // Used for determining when to delete entries
@@ -120,9 +136,18 @@ type Processor struct {
 	// obsvReqSendC is a send-only channel of outbound re-observation requests to broadcast on p2p
 	obsvReqSendC chan<- *gossipv1.ObservationRequest
 
+	// obsvFetchC is a send-only channel of requests to fetch observations directly from other guardians over p2p
+	obsvFetchC chan<- *p2p.ObservationFetchRequest
+
+	// invalidObsvC is a send-only channel of the p2p peers that originated observations with invalid signatures
+	invalidObsvC chan<- peer.ID
+
 	// signedInC is a channel of inbound signed VAA observations from p2p
 	signedInC <-chan *gossipv1.SignedVAAWithQuorum
 
+	// inspectC is a channel of requests for the aggregation state of a message from the admin service
+	inspectC <-chan *InspectRequest
+
 	// guardianSigner is the guardian node's signer
 	guardianSigner guardiansigner.GuardianSigner
 
@@ -137,9 +162,18 @@ type Processor struct {
 	// gst is managed by the processor and allows concurrent access to the
 	// guardian set by other components.
 	gst *common.GuardianSetState
+	// performance records which guardians signed the settled observations, and is read by the admin service.
+	performance *GuardianPerformance
+
+	// policyHooks are evaluated on every message publication before it is passed to the governor and the accountant.
+	policyHooks []PolicyHook
+	// delayedMsgs are the message publications held by a policy hook.
+	delayedMsgs []*delayedMessage
 
 	// state is the current runtime VAA view
 	state *aggregationState
+	// stateRestored is set once the checkpointed aggregation state was restored, which requires the guardian set.
+	stateRestored bool
 	// gk pk as eth address
 	ourAddr ethcommon.Address
 
@@ -158,8 +192,10 @@ type Processor struct {
 
 // updateVaaEntry is used to queue up a VAA to be written to the database.
 type updateVaaEntry struct {
-	v     *vaa.VAA
-	dirty bool
+	v *vaa.VAA
+	// txHash is the hash of the source transaction, if known. It is used for the tx hash index in the database.
+	txHash []byte
+	dirty  bool
 }
 
 var (
@@ -210,9 +246,14 @@ func NewProcessor(
 	gossipVaaSendC chan<- []byte,
 	batchObsvC <-chan *common.MsgWithTimeStamp[gossipv1.SignedObservationBatch],
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
+	obsvFetchC chan<- *p2p.ObservationFetchRequest,
+	invalidObsvC chan<- peer.ID,
 	signedInC <-chan *gossipv1.SignedVAAWithQuorum,
+	inspectC <-chan *InspectRequest,
 	guardianSigner guardiansigner.GuardianSigner,
 	gst *common.GuardianSetState,
+	performance *GuardianPerformance,
+	policyHooks []PolicyHook,
 	g *governor.ChainGovernor,
 	acct *accountant.Accountant,
 	acctReadC <-chan *common.MessagePublication,
@@ -227,9 +268,14 @@ func NewProcessor(
 		gossipVaaSendC:         gossipVaaSendC,
 		batchObsvC:             batchObsvC,
 		obsvReqSendC:           obsvReqSendC,
+		obsvFetchC:             obsvFetchC,
+		invalidObsvC:           invalidObsvC,
 		signedInC:              signedInC,
+		inspectC:               inspectC,
 		guardianSigner:         guardianSigner,
 		gst:                    gst,
+		performance:            performance,
+		policyHooks:            policyHooks,
 		db:                     db,
 
 		logger:         supervisor.Logger(ctx),
@@ -256,6 +302,7 @@ func (p *Processor) Run(ctx context.Context) error {
 	}
 
 	cleanup := time.NewTicker(CleanupInterval)
+	policyTicker := time.NewTicker(PolicyCheckInterval)
 
 	// Always initialize the timer so don't have a nil pointer in the case below. It won't get rearmed after that.
 	govTimer := time.NewTimer(GovInterval)
@@ -266,6 +313,9 @@ func (p *Processor) Run(ctx context.Context) error {
 			if p.acct != nil {
 				p.acct.Close()
 			}
+			if p.stateRestored {
+				p.checkpointAggregationState()
+			}
 			return ctx.Err()
 		case p.gs = <-p.setC:
 			p.logger.Info("guardian set updated",
@@ -274,22 +324,25 @@ func (p *Processor) Run(ctx context.Context) error {
 				zap.Int("quorum", p.gs.Quorum()),
 			)
 			p.gst.Set(p.gs)
+			if !p.stateRestored {
+				p.restoreAggregationState()
+				p.stateRestored = true
+			}
 		case k := <-p.msgC:
-			if p.governor != nil {
-				if !p.governor.ProcessMsg(k) {
-					continue
-				}
+			if !p.evaluatePolicy(ctx, k, false) {
+				continue
 			}
-			if p.acct != nil {
-				shouldPub, err := p.acct.SubmitObservation(k)
-				if err != nil {
-					return fmt.Errorf("failed to process message `%s`: %w", k.MessageIDString(), err)
-				}
-				if !shouldPub {
-					continue
+			if err := p.handlePublication(ctx, k); err != nil {
+				return err
+			}
+		case <-policyTicker.C:
+			for _, d := range p.releaseDelayedMessages(ctx, time.Now()) {
+				if d.cleared {
+					p.handleMessage(ctx, d.msg)
+				} else if err := p.handlePublication(ctx, d.msg); err != nil {
+					return err
 				}
 			}
-			p.handleMessage(ctx, k)
 
 		case k := <-p.acctReadC:
 			if p.acct == nil {
@@ -299,12 +352,17 @@ func (p *Processor) Run(ctx context.Context) error {
 			if !p.acct.IsMessageCoveredByAccountant(k) {
 				return fmt.Errorf("accountant published a message that is not covered by it: `%s`", k.MessageIDString())
 			}
-			p.handleMessage(ctx, k)
+			// The accountant may have held the message for a long time, so it is checked against the policy again.
+			if p.evaluatePolicy(ctx, k, true) {
+				p.handleMessage(ctx, k)
+			}
 		case m := <-p.batchObsvC:
 			batchObservationChanDelay.Observe(float64(time.Since(m.Timestamp).Microseconds()))
 			p.handleBatchObservation(m)
 		case m := <-p.signedInC:
 			p.handleInboundSignedVAAWithQuorum(m)
+		case req := <-p.inspectC:
+			p.handleInspectRequest(req)
 		case <-cleanup.C:
 			p.handleCleanup(ctx)
 		case <-govTimer.C:
@@ -330,7 +388,11 @@ func (p *Processor) Run(ctx context.Context) error {
 								continue
 							}
 						}
-						p.handleMessage(ctx, k)
+						// The governor may have held the message for a long time, so it is checked against the
+						// policy again.
+						if p.evaluatePolicy(ctx, k, true) {
+							p.handleMessage(ctx, k)
+						}
 					}
 				}
 			}
@@ -341,8 +403,29 @@ func (p *Processor) Run(ctx context.Context) error {
 	}
 }
 
-// storeSignedVAA schedules a database update for a VAA.
-func (p *Processor) storeSignedVAA(v *vaa.VAA) {
+// handlePublication passes a message publication that was allowed by the policy hooks through the governor and the
+// accountant, and handles it if they don't hold it.
+func (p *Processor) handlePublication(ctx context.Context, k *common.MessagePublication) error {
+	if p.governor != nil {
+		if !p.governor.ProcessMsg(k) {
+			return nil
+		}
+	}
+	if p.acct != nil {
+		shouldPub, err := p.acct.SubmitObservation(k)
+		if err != nil {
+			return fmt.Errorf("failed to process message `%s`: %w", k.MessageIDString(), err)
+		}
+		if !shouldPub {
+			return nil
+		}
+	}
+	p.handleMessage(ctx, k)
+	return nil
+}
+
+// storeSignedVAA schedules a database update for a VAA. The txHash is the hash of the source transaction, or nil if it is not known.
+func (p *Processor) storeSignedVAA(v *vaa.VAA, txHash []byte) {
 	if v.EmitterChain == vaa.ChainIDPythNet {
 		key := fmt.Sprintf("%v/%v", v.EmitterAddress, v.Sequence)
 		p.pythnetVaas[key] = PythNetVaaEntry{v: v, updateTime: time.Now()}
@@ -350,7 +433,7 @@ func (p *Processor) storeSignedVAA(v *vaa.VAA) {
 	}
 	key := fmt.Sprintf("%d/%v/%v", v.EmitterChain, v.EmitterAddress, v.Sequence)
 	p.updateVAALock.Lock()
-	p.updatedVAAs[key] = &updateVaaEntry{v: v, dirty: true}
+	p.updatedVAAs[key] = &updateVaaEntry{v: v, txHash: txHash, dirty: true}
 	p.updateVAALock.Unlock()
 }
 
@@ -421,11 +504,13 @@ func (p *Processor) vaaWriter(ctx context.Context) error {
 			if updatedVAAs != nil {
 				// If there's anything to write, do that.
 				vaaBatch := make([]*vaa.VAA, 0, len(updatedVAAs))
+				txHashes := make([][]byte, 0, len(updatedVAAs))
 				for _, entry := range updatedVAAs {
 					vaaBatch = append(vaaBatch, entry.v)
+					txHashes = append(txHashes, entry.txHash)
 				}
 
-				if err := p.db.StoreSignedVAABatch(vaaBatch); err != nil {
+				if err := p.db.StoreSignedVAABatchWithTxHashes(vaaBatch, txHashes); err != nil {
 					p.logger.Error("failed to write VAAs to database", zap.Int("numVAAs", len(vaaBatch)), zap.Error(err))
 				}
 
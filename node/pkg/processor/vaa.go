@@ -32,9 +32,15 @@ func (v *VAA) HandleQuorum(sigs []*vaa.Signature, hash string, p *Processor) {
 		zap.String("digest", hash),
 	)
 
+	// The source transaction is only known if we observed the message ourselves.
+	var txHash []byte
+	if s, exists := p.state.signatures[hash]; exists {
+		txHash = s.txHash
+	}
+
 	// Broadcast the VAA and store it in the database.
 	p.broadcastSignedVAA(signed)
-	p.storeSignedVAA(signed)
+	p.storeSignedVAA(signed, txHash)
 }
 
 func (v *VAA) IsReliable() bool {
@@ -23,6 +23,7 @@ type NodePrivilegedServiceClient interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(ctx context.Context, in *InjectGovernanceVAARequest, opts ...grpc.CallOption) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -48,12 +49,54 @@ type NodePrivilegedServiceClient interface {
 	ChainGovernorResetReleaseTimer(ctx context.Context, in *ChainGovernorResetReleaseTimerRequest, opts ...grpc.CallOption) (*ChainGovernorResetReleaseTimerResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(ctx context.Context, in *PurgePythNetVaasRequest, opts ...grpc.CallOption) (*PurgePythNetVaasResponse, error)
+	// ApplyVaaRetentionPolicy applies the VAA retention policy the node was started with. If dry_run is set,
+	// nothing is deleted and the response reports what would have been deleted.
+	ApplyVaaRetentionPolicy(ctx context.Context, in *ApplyVaaRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyVaaRetentionPolicyResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
 	SignExistingVAA(ctx context.Context, in *SignExistingVAARequest, opts ...grpc.CallOption) (*SignExistingVAAResponse, error)
 	// DumpRPCs returns the RPCs being used by the guardian
 	DumpRPCs(ctx context.Context, in *DumpRPCsRequest, opts ...grpc.CallOption) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(ctx context.Context, in *GetAndObserveMissingVAAsRequest, opts ...grpc.CallOption) (*GetAndObserveMissingVAAsResponse, error)
+	// InspectObservation reports what the node knows about a message: its aggregation state in the processor,
+	// whether the governor or the accountant is holding it, and whether the signed VAA is in the database.
+	InspectObservation(ctx context.Context, in *InspectObservationRequest, opts ...grpc.CallOption) (*InspectObservationResponse, error)
+	// GuardianPerformance reports, per guardian and chain, how many of the recently settled observations the guardian
+	// signed and how quickly its signatures arrived.
+	GuardianPerformance(ctx context.Context, in *GuardianPerformanceRequest, opts ...grpc.CallOption) (*GuardianPerformanceResponse, error)
+	// DenylistStatus displays the denylist entries and the messages held by the denylist.
+	DenylistStatus(ctx context.Context, in *DenylistStatusRequest, opts ...grpc.CallOption) (*DenylistStatusResponse, error)
+	// DenylistAddEntry adds an entry to the denylist, or replaces the entry with the same kind, chain and address.
+	DenylistAddEntry(ctx context.Context, in *DenylistAddEntryRequest, opts ...grpc.CallOption) (*DenylistAddEntryResponse, error)
+	// DenylistRemoveEntry removes an entry that was added through DenylistAddEntry.
+	DenylistRemoveEntry(ctx context.Context, in *DenylistRemoveEntryRequest, opts ...grpc.CallOption) (*DenylistRemoveEntryResponse, error)
+	// DenylistReleaseHeldMessage releases a message held by the denylist, publishing it immediately.
+	DenylistReleaseHeldMessage(ctx context.Context, in *DenylistReleaseHeldMessageRequest, opts ...grpc.CallOption) (*DenylistReleaseHeldMessageResponse, error)
+	// DenylistDropHeldMessage drops a message held by the denylist.
+	DenylistDropHeldMessage(ctx context.Context, in *DenylistDropHeldMessageRequest, opts ...grpc.CallOption) (*DenylistDropHeldMessageResponse, error)
+	// GatewayRelayerQueue lists the VAAs waiting to be submitted to wormchain by the gateway relayer, including the
+	// ones whose submission failed.
+	GatewayRelayerQueue(ctx context.Context, in *GatewayRelayerQueueRequest, opts ...grpc.CallOption) (*GatewayRelayerQueueResponse, error)
+	// GatewayRelayerReplay submits a VAA to wormchain again. It retries a queued VAA immediately, or queues a stored
+	// VAA that is not in the queue, for instance because it was dropped.
+	GatewayRelayerReplay(ctx context.Context, in *GatewayRelayerReplayRequest, opts ...grpc.CallOption) (*GatewayRelayerReplayResponse, error)
+	// GatewayRelayerDrop removes a VAA from the gateway relayer queue.
+	GatewayRelayerDrop(ctx context.Context, in *GatewayRelayerDropRequest, opts ...grpc.CallOption) (*GatewayRelayerDropResponse, error)
+	// AccountantPendingTransfers lists the transfers held by the accountant (base and NTT), optionally along with their
+	// status on the accountant contract.
+	AccountantPendingTransfers(ctx context.Context, in *AccountantPendingTransfersRequest, opts ...grpc.CallOption) (*AccountantPendingTransfersResponse, error)
+	// AccountantResubmitObservation forces the observation of a pending transfer to be submitted to the accountant
+	// contract again.
+	AccountantResubmitObservation(ctx context.Context, in *AccountantResubmitObservationRequest, opts ...grpc.CallOption) (*AccountantResubmitObservationResponse, error)
+	// AccountantReconciliationReport compares the transfers held by the accountant against the state of the accountant
+	// contracts and lists the disagreements. It does not change anything.
+	AccountantReconciliationReport(ctx context.Context, in *AccountantReconciliationReportRequest, opts ...grpc.CallOption) (*AccountantReconciliationReportResponse, error)
+	// P2PStatus reports the libp2p state of the node: the connected peers along with their guardian identity, mesh
+	// membership and traffic, as well as the recent disconnects.
+	P2PStatus(ctx context.Context, in *P2PStatusRequest, opts ...grpc.CallOption) (*P2PStatusResponse, error)
+	// ChainHeightStatus compares the block heights of our watchers to the median of the heights reported by the other
+	// guardians in their heartbeats.
+	ChainHeightStatus(ctx context.Context, in *ChainHeightStatusRequest, opts ...grpc.CallOption) (*ChainHeightStatusResponse, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -154,6 +197,15 @@ func (c *nodePrivilegedServiceClient) PurgePythNetVaas(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) ApplyVaaRetentionPolicy(ctx context.Context, in *ApplyVaaRetentionPolicyRequest, opts ...grpc.CallOption) (*ApplyVaaRetentionPolicyResponse, error) {
+	out := new(ApplyVaaRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ApplyVaaRetentionPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *nodePrivilegedServiceClient) SignExistingVAA(ctx context.Context, in *SignExistingVAARequest, opts ...grpc.CallOption) (*SignExistingVAAResponse, error) {
 	out := new(SignExistingVAAResponse)
 	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/SignExistingVAA", in, out, opts...)
@@ -181,6 +233,141 @@ func (c *nodePrivilegedServiceClient) GetAndObserveMissingVAAs(ctx context.Conte
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) InspectObservation(ctx context.Context, in *InspectObservationRequest, opts ...grpc.CallOption) (*InspectObservationResponse, error) {
+	out := new(InspectObservationResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/InspectObservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GuardianPerformance(ctx context.Context, in *GuardianPerformanceRequest, opts ...grpc.CallOption) (*GuardianPerformanceResponse, error) {
+	out := new(GuardianPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GuardianPerformance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DenylistStatus(ctx context.Context, in *DenylistStatusRequest, opts ...grpc.CallOption) (*DenylistStatusResponse, error) {
+	out := new(DenylistStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DenylistStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DenylistAddEntry(ctx context.Context, in *DenylistAddEntryRequest, opts ...grpc.CallOption) (*DenylistAddEntryResponse, error) {
+	out := new(DenylistAddEntryResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DenylistAddEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DenylistRemoveEntry(ctx context.Context, in *DenylistRemoveEntryRequest, opts ...grpc.CallOption) (*DenylistRemoveEntryResponse, error) {
+	out := new(DenylistRemoveEntryResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DenylistRemoveEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DenylistReleaseHeldMessage(ctx context.Context, in *DenylistReleaseHeldMessageRequest, opts ...grpc.CallOption) (*DenylistReleaseHeldMessageResponse, error) {
+	out := new(DenylistReleaseHeldMessageResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DenylistReleaseHeldMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) DenylistDropHeldMessage(ctx context.Context, in *DenylistDropHeldMessageRequest, opts ...grpc.CallOption) (*DenylistDropHeldMessageResponse, error) {
+	out := new(DenylistDropHeldMessageResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/DenylistDropHeldMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GatewayRelayerQueue(ctx context.Context, in *GatewayRelayerQueueRequest, opts ...grpc.CallOption) (*GatewayRelayerQueueResponse, error) {
+	out := new(GatewayRelayerQueueResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GatewayRelayerQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GatewayRelayerReplay(ctx context.Context, in *GatewayRelayerReplayRequest, opts ...grpc.CallOption) (*GatewayRelayerReplayResponse, error) {
+	out := new(GatewayRelayerReplayResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GatewayRelayerReplay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GatewayRelayerDrop(ctx context.Context, in *GatewayRelayerDropRequest, opts ...grpc.CallOption) (*GatewayRelayerDropResponse, error) {
+	out := new(GatewayRelayerDropResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GatewayRelayerDrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantPendingTransfers(ctx context.Context, in *AccountantPendingTransfersRequest, opts ...grpc.CallOption) (*AccountantPendingTransfersResponse, error) {
+	out := new(AccountantPendingTransfersResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantPendingTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantResubmitObservation(ctx context.Context, in *AccountantResubmitObservationRequest, opts ...grpc.CallOption) (*AccountantResubmitObservationResponse, error) {
+	out := new(AccountantResubmitObservationResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantResubmitObservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantReconciliationReport(ctx context.Context, in *AccountantReconciliationReportRequest, opts ...grpc.CallOption) (*AccountantReconciliationReportResponse, error) {
+	out := new(AccountantReconciliationReportResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantReconciliationReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) P2PStatus(ctx context.Context, in *P2PStatusRequest, opts ...grpc.CallOption) (*P2PStatusResponse, error) {
+	out := new(P2PStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/P2PStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ChainHeightStatus(ctx context.Context, in *ChainHeightStatusRequest, opts ...grpc.CallOption) (*ChainHeightStatusResponse, error) {
+	out := new(ChainHeightStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ChainHeightStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -190,6 +377,7 @@ type NodePrivilegedServiceServer interface {
 	//
 	// A consensus majority of nodes on the network will have to inject the VAA within the
 	// VAA timeout window for it to reach consensus.
+	//
 	InjectGovernanceVAA(context.Context, *InjectGovernanceVAARequest) (*InjectGovernanceVAAResponse, error)
 	// FindMissingMessages will detect message sequence gaps in the local VAA store for a
 	// specific emitter chain and address. Start and end slots are the lowest and highest
@@ -215,12 +403,54 @@ type NodePrivilegedServiceServer interface {
 	ChainGovernorResetReleaseTimer(context.Context, *ChainGovernorResetReleaseTimerRequest) (*ChainGovernorResetReleaseTimerResponse, error)
 	// PurgePythNetVaas deletes PythNet VAAs from the database that are more than the specified number of days old.
 	PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error)
+	// ApplyVaaRetentionPolicy applies the VAA retention policy the node was started with. If dry_run is set,
+	// nothing is deleted and the response reports what would have been deleted.
+	ApplyVaaRetentionPolicy(context.Context, *ApplyVaaRetentionPolicyRequest) (*ApplyVaaRetentionPolicyResponse, error)
 	// SignExistingVAA signs an existing VAA for a new guardian set using the local guardian key.
 	SignExistingVAA(context.Context, *SignExistingVAARequest) (*SignExistingVAAResponse, error)
 	// DumpRPCs returns the RPCs being used by the guardian
 	DumpRPCs(context.Context, *DumpRPCsRequest) (*DumpRPCsResponse, error)
 	// GetMissingVAAs returns the VAAs from a cloud function that need to be reobserved.
 	GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error)
+	// InspectObservation reports what the node knows about a message: its aggregation state in the processor,
+	// whether the governor or the accountant is holding it, and whether the signed VAA is in the database.
+	InspectObservation(context.Context, *InspectObservationRequest) (*InspectObservationResponse, error)
+	// GuardianPerformance reports, per guardian and chain, how many of the recently settled observations the guardian
+	// signed and how quickly its signatures arrived.
+	GuardianPerformance(context.Context, *GuardianPerformanceRequest) (*GuardianPerformanceResponse, error)
+	// DenylistStatus displays the denylist entries and the messages held by the denylist.
+	DenylistStatus(context.Context, *DenylistStatusRequest) (*DenylistStatusResponse, error)
+	// DenylistAddEntry adds an entry to the denylist, or replaces the entry with the same kind, chain and address.
+	DenylistAddEntry(context.Context, *DenylistAddEntryRequest) (*DenylistAddEntryResponse, error)
+	// DenylistRemoveEntry removes an entry that was added through DenylistAddEntry.
+	DenylistRemoveEntry(context.Context, *DenylistRemoveEntryRequest) (*DenylistRemoveEntryResponse, error)
+	// DenylistReleaseHeldMessage releases a message held by the denylist, publishing it immediately.
+	DenylistReleaseHeldMessage(context.Context, *DenylistReleaseHeldMessageRequest) (*DenylistReleaseHeldMessageResponse, error)
+	// DenylistDropHeldMessage drops a message held by the denylist.
+	DenylistDropHeldMessage(context.Context, *DenylistDropHeldMessageRequest) (*DenylistDropHeldMessageResponse, error)
+	// GatewayRelayerQueue lists the VAAs waiting to be submitted to wormchain by the gateway relayer, including the
+	// ones whose submission failed.
+	GatewayRelayerQueue(context.Context, *GatewayRelayerQueueRequest) (*GatewayRelayerQueueResponse, error)
+	// GatewayRelayerReplay submits a VAA to wormchain again. It retries a queued VAA immediately, or queues a stored
+	// VAA that is not in the queue, for instance because it was dropped.
+	GatewayRelayerReplay(context.Context, *GatewayRelayerReplayRequest) (*GatewayRelayerReplayResponse, error)
+	// GatewayRelayerDrop removes a VAA from the gateway relayer queue.
+	GatewayRelayerDrop(context.Context, *GatewayRelayerDropRequest) (*GatewayRelayerDropResponse, error)
+	// AccountantPendingTransfers lists the transfers held by the accountant (base and NTT), optionally along with their
+	// status on the accountant contract.
+	AccountantPendingTransfers(context.Context, *AccountantPendingTransfersRequest) (*AccountantPendingTransfersResponse, error)
+	// AccountantResubmitObservation forces the observation of a pending transfer to be submitted to the accountant
+	// contract again.
+	AccountantResubmitObservation(context.Context, *AccountantResubmitObservationRequest) (*AccountantResubmitObservationResponse, error)
+	// AccountantReconciliationReport compares the transfers held by the accountant against the state of the accountant
+	// contracts and lists the disagreements. It does not change anything.
+	AccountantReconciliationReport(context.Context, *AccountantReconciliationReportRequest) (*AccountantReconciliationReportResponse, error)
+	// P2PStatus reports the libp2p state of the node: the connected peers along with their guardian identity, mesh
+	// membership and traffic, as well as the recent disconnects.
+	P2PStatus(context.Context, *P2PStatusRequest) (*P2PStatusResponse, error)
+	// ChainHeightStatus compares the block heights of our watchers to the median of the heights reported by the other
+	// guardians in their heartbeats.
+	ChainHeightStatus(context.Context, *ChainHeightStatusRequest) (*ChainHeightStatusResponse, error)
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -258,6 +488,9 @@ func (UnimplementedNodePrivilegedServiceServer) ChainGovernorResetReleaseTimer(c
 func (UnimplementedNodePrivilegedServiceServer) PurgePythNetVaas(context.Context, *PurgePythNetVaasRequest) (*PurgePythNetVaasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurgePythNetVaas not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) ApplyVaaRetentionPolicy(context.Context, *ApplyVaaRetentionPolicyRequest) (*ApplyVaaRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVaaRetentionPolicy not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) SignExistingVAA(context.Context, *SignExistingVAARequest) (*SignExistingVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SignExistingVAA not implemented")
 }
@@ -267,6 +500,51 @@ func (UnimplementedNodePrivilegedServiceServer) DumpRPCs(context.Context, *DumpR
 func (UnimplementedNodePrivilegedServiceServer) GetAndObserveMissingVAAs(context.Context, *GetAndObserveMissingVAAsRequest) (*GetAndObserveMissingVAAsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAndObserveMissingVAAs not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) InspectObservation(context.Context, *InspectObservationRequest) (*InspectObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectObservation not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GuardianPerformance(context.Context, *GuardianPerformanceRequest) (*GuardianPerformanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GuardianPerformance not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DenylistStatus(context.Context, *DenylistStatusRequest) (*DenylistStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DenylistAddEntry(context.Context, *DenylistAddEntryRequest) (*DenylistAddEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistAddEntry not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DenylistRemoveEntry(context.Context, *DenylistRemoveEntryRequest) (*DenylistRemoveEntryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistRemoveEntry not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DenylistReleaseHeldMessage(context.Context, *DenylistReleaseHeldMessageRequest) (*DenylistReleaseHeldMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistReleaseHeldMessage not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) DenylistDropHeldMessage(context.Context, *DenylistDropHeldMessageRequest) (*DenylistDropHeldMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenylistDropHeldMessage not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GatewayRelayerQueue(context.Context, *GatewayRelayerQueueRequest) (*GatewayRelayerQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayRelayerQueue not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GatewayRelayerReplay(context.Context, *GatewayRelayerReplayRequest) (*GatewayRelayerReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayRelayerReplay not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GatewayRelayerDrop(context.Context, *GatewayRelayerDropRequest) (*GatewayRelayerDropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GatewayRelayerDrop not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantPendingTransfers(context.Context, *AccountantPendingTransfersRequest) (*AccountantPendingTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantPendingTransfers not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantResubmitObservation(context.Context, *AccountantResubmitObservationRequest) (*AccountantResubmitObservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantResubmitObservation not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantReconciliationReport(context.Context, *AccountantReconciliationReportRequest) (*AccountantReconciliationReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantReconciliationReport not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) P2PStatus(context.Context, *P2PStatusRequest) (*P2PStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method P2PStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ChainHeightStatus(context.Context, *ChainHeightStatusRequest) (*ChainHeightStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainHeightStatus not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -460,6 +738,24 @@ func _NodePrivilegedService_PurgePythNetVaas_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_ApplyVaaRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyVaaRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ApplyVaaRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ApplyVaaRetentionPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ApplyVaaRetentionPolicy(ctx, req.(*ApplyVaaRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NodePrivilegedService_SignExistingVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SignExistingVAARequest)
 	if err := dec(in); err != nil {
@@ -514,6 +810,276 @@ func _NodePrivilegedService_GetAndObserveMissingVAAs_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_InspectObservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectObservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).InspectObservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/InspectObservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).InspectObservation(ctx, req.(*InspectObservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GuardianPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuardianPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GuardianPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GuardianPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GuardianPerformance(ctx, req.(*GuardianPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DenylistStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenylistStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DenylistStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DenylistStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DenylistStatus(ctx, req.(*DenylistStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DenylistAddEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenylistAddEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DenylistAddEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DenylistAddEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DenylistAddEntry(ctx, req.(*DenylistAddEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DenylistRemoveEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenylistRemoveEntryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DenylistRemoveEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DenylistRemoveEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DenylistRemoveEntry(ctx, req.(*DenylistRemoveEntryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DenylistReleaseHeldMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenylistReleaseHeldMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DenylistReleaseHeldMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DenylistReleaseHeldMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DenylistReleaseHeldMessage(ctx, req.(*DenylistReleaseHeldMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_DenylistDropHeldMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenylistDropHeldMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).DenylistDropHeldMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/DenylistDropHeldMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).DenylistDropHeldMessage(ctx, req.(*DenylistDropHeldMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GatewayRelayerQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayRelayerQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GatewayRelayerQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerQueue(ctx, req.(*GatewayRelayerQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GatewayRelayerReplay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayRelayerReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerReplay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GatewayRelayerReplay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerReplay(ctx, req.(*GatewayRelayerReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GatewayRelayerDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayRelayerDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GatewayRelayerDrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GatewayRelayerDrop(ctx, req.(*GatewayRelayerDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantPendingTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantPendingTransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantPendingTransfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantPendingTransfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantPendingTransfers(ctx, req.(*AccountantPendingTransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantResubmitObservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantResubmitObservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantResubmitObservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantResubmitObservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantResubmitObservation(ctx, req.(*AccountantResubmitObservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantReconciliationReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantReconciliationReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantReconciliationReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantReconciliationReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantReconciliationReport(ctx, req.(*AccountantReconciliationReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_P2PStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(P2PStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).P2PStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/P2PStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).P2PStatus(ctx, req.(*P2PStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ChainHeightStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChainHeightStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ChainHeightStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ChainHeightStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ChainHeightStatus(ctx, req.(*ChainHeightStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -561,6 +1127,10 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurgePythNetVaas",
 			Handler:    _NodePrivilegedService_PurgePythNetVaas_Handler,
 		},
+		{
+			MethodName: "ApplyVaaRetentionPolicy",
+			Handler:    _NodePrivilegedService_ApplyVaaRetentionPolicy_Handler,
+		},
 		{
 			MethodName: "SignExistingVAA",
 			Handler:    _NodePrivilegedService_SignExistingVAA_Handler,
@@ -573,6 +1143,66 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAndObserveMissingVAAs",
 			Handler:    _NodePrivilegedService_GetAndObserveMissingVAAs_Handler,
 		},
+		{
+			MethodName: "InspectObservation",
+			Handler:    _NodePrivilegedService_InspectObservation_Handler,
+		},
+		{
+			MethodName: "GuardianPerformance",
+			Handler:    _NodePrivilegedService_GuardianPerformance_Handler,
+		},
+		{
+			MethodName: "DenylistStatus",
+			Handler:    _NodePrivilegedService_DenylistStatus_Handler,
+		},
+		{
+			MethodName: "DenylistAddEntry",
+			Handler:    _NodePrivilegedService_DenylistAddEntry_Handler,
+		},
+		{
+			MethodName: "DenylistRemoveEntry",
+			Handler:    _NodePrivilegedService_DenylistRemoveEntry_Handler,
+		},
+		{
+			MethodName: "DenylistReleaseHeldMessage",
+			Handler:    _NodePrivilegedService_DenylistReleaseHeldMessage_Handler,
+		},
+		{
+			MethodName: "DenylistDropHeldMessage",
+			Handler:    _NodePrivilegedService_DenylistDropHeldMessage_Handler,
+		},
+		{
+			MethodName: "GatewayRelayerQueue",
+			Handler:    _NodePrivilegedService_GatewayRelayerQueue_Handler,
+		},
+		{
+			MethodName: "GatewayRelayerReplay",
+			Handler:    _NodePrivilegedService_GatewayRelayerReplay_Handler,
+		},
+		{
+			MethodName: "GatewayRelayerDrop",
+			Handler:    _NodePrivilegedService_GatewayRelayerDrop_Handler,
+		},
+		{
+			MethodName: "AccountantPendingTransfers",
+			Handler:    _NodePrivilegedService_AccountantPendingTransfers_Handler,
+		},
+		{
+			MethodName: "AccountantResubmitObservation",
+			Handler:    _NodePrivilegedService_AccountantResubmitObservation_Handler,
+		},
+		{
+			MethodName: "AccountantReconciliationReport",
+			Handler:    _NodePrivilegedService_AccountantReconciliationReport_Handler,
+		},
+		{
+			MethodName: "P2PStatus",
+			Handler:    _NodePrivilegedService_P2PStatus_Handler,
+		},
+		{
+			MethodName: "ChainHeightStatus",
+			Handler:    _NodePrivilegedService_ChainHeightStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
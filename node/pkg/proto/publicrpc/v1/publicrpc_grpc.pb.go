@@ -22,7 +22,19 @@ type PublicRPCServiceClient interface {
 	// node's active guardian set. Heartbeats received by nodes not in the guardian set are ignored.
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(ctx context.Context, in *GetLastHeartbeatsRequest, opts ...grpc.CallOption) (*GetLastHeartbeatsResponse, error)
+	// GetGuardianUptime summarizes the heartbeats received from each guardian in the node's active guardian set over
+	// a time window. The node keeps a bounded history of heartbeats, so the window may be cut short, for instance after
+	// a restart. Like heartbeats, the reported data is only as trusted as the guardian nodes that sent it.
+	GetGuardianUptime(ctx context.Context, in *GetGuardianUptimeRequest, opts ...grpc.CallOption) (*GetGuardianUptimeResponse, error)
 	GetSignedVAA(ctx context.Context, in *GetSignedVAARequest, opts ...grpc.CallOption) (*GetSignedVAAResponse, error)
+	// GetSignedVAAByDigest returns a VAA by its signing digest. This requires the node to run with VAA indexes enabled.
+	GetSignedVAAByDigest(ctx context.Context, in *GetSignedVAAByDigestRequest, opts ...grpc.CallOption) (*GetSignedVAAByDigestResponse, error)
+	// GetSignedVAAsByTxHash returns the VAAs emitted by a source transaction. Only VAAs of messages this node observed
+	// itself can be found. This requires the node to run with VAA indexes enabled.
+	GetSignedVAAsByTxHash(ctx context.Context, in *GetSignedVAAsByTxHashRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTxHashResponse, error)
+	// GetSignedVAAsByTimeRange returns the VAAs with a timestamp in [start_time, end_time), ordered by timestamp.
+	// This requires the node to run with VAA indexes enabled.
+	GetSignedVAAsByTimeRange(ctx context.Context, in *GetSignedVAAsByTimeRangeRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTimeRangeResponse, error)
 	GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(ctx context.Context, in *GovernorGetAvailableNotionalByChainRequest, opts ...grpc.CallOption) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(ctx context.Context, in *GovernorGetEnqueuedVAAsRequest, opts ...grpc.CallOption) (*GovernorGetEnqueuedVAAsResponse, error)
@@ -47,6 +59,15 @@ func (c *publicRPCServiceClient) GetLastHeartbeats(ctx context.Context, in *GetL
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetGuardianUptime(ctx context.Context, in *GetGuardianUptimeRequest, opts ...grpc.CallOption) (*GetGuardianUptimeResponse, error) {
+	out := new(GetGuardianUptimeResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetGuardianUptime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicRPCServiceClient) GetSignedVAA(ctx context.Context, in *GetSignedVAARequest, opts ...grpc.CallOption) (*GetSignedVAAResponse, error) {
 	out := new(GetSignedVAAResponse)
 	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAA", in, out, opts...)
@@ -56,6 +77,33 @@ func (c *publicRPCServiceClient) GetSignedVAA(ctx context.Context, in *GetSigned
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) GetSignedVAAByDigest(ctx context.Context, in *GetSignedVAAByDigestRequest, opts ...grpc.CallOption) (*GetSignedVAAByDigestResponse, error) {
+	out := new(GetSignedVAAByDigestResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAByDigest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicRPCServiceClient) GetSignedVAAsByTxHash(ctx context.Context, in *GetSignedVAAsByTxHashRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTxHashResponse, error) {
+	out := new(GetSignedVAAsByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *publicRPCServiceClient) GetSignedVAAsByTimeRange(ctx context.Context, in *GetSignedVAAsByTimeRangeRequest, opts ...grpc.CallOption) (*GetSignedVAAsByTimeRangeResponse, error) {
+	out := new(GetSignedVAAsByTimeRangeResponse)
+	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *publicRPCServiceClient) GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error) {
 	out := new(GetCurrentGuardianSetResponse)
 	err := c.cc.Invoke(ctx, "/publicrpc.v1.PublicRPCService/GetCurrentGuardianSet", in, out, opts...)
@@ -109,7 +157,19 @@ type PublicRPCServiceServer interface {
 	// node's active guardian set. Heartbeats received by nodes not in the guardian set are ignored.
 	// The heartbeat value is null if no heartbeat has yet been received.
 	GetLastHeartbeats(context.Context, *GetLastHeartbeatsRequest) (*GetLastHeartbeatsResponse, error)
+	// GetGuardianUptime summarizes the heartbeats received from each guardian in the node's active guardian set over
+	// a time window. The node keeps a bounded history of heartbeats, so the window may be cut short, for instance after
+	// a restart. Like heartbeats, the reported data is only as trusted as the guardian nodes that sent it.
+	GetGuardianUptime(context.Context, *GetGuardianUptimeRequest) (*GetGuardianUptimeResponse, error)
 	GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error)
+	// GetSignedVAAByDigest returns a VAA by its signing digest. This requires the node to run with VAA indexes enabled.
+	GetSignedVAAByDigest(context.Context, *GetSignedVAAByDigestRequest) (*GetSignedVAAByDigestResponse, error)
+	// GetSignedVAAsByTxHash returns the VAAs emitted by a source transaction. Only VAAs of messages this node observed
+	// itself can be found. This requires the node to run with VAA indexes enabled.
+	GetSignedVAAsByTxHash(context.Context, *GetSignedVAAsByTxHashRequest) (*GetSignedVAAsByTxHashResponse, error)
+	// GetSignedVAAsByTimeRange returns the VAAs with a timestamp in [start_time, end_time), ordered by timestamp.
+	// This requires the node to run with VAA indexes enabled.
+	GetSignedVAAsByTimeRange(context.Context, *GetSignedVAAsByTimeRangeRequest) (*GetSignedVAAsByTimeRangeResponse, error)
 	GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error)
 	GovernorGetAvailableNotionalByChain(context.Context, *GovernorGetAvailableNotionalByChainRequest) (*GovernorGetAvailableNotionalByChainResponse, error)
 	GovernorGetEnqueuedVAAs(context.Context, *GovernorGetEnqueuedVAAsRequest) (*GovernorGetEnqueuedVAAsResponse, error)
@@ -125,9 +185,21 @@ type UnimplementedPublicRPCServiceServer struct {
 func (UnimplementedPublicRPCServiceServer) GetLastHeartbeats(context.Context, *GetLastHeartbeatsRequest) (*GetLastHeartbeatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLastHeartbeats not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetGuardianUptime(context.Context, *GetGuardianUptimeRequest) (*GetGuardianUptimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianUptime not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) GetSignedVAA(context.Context, *GetSignedVAARequest) (*GetSignedVAAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAA not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAByDigest(context.Context, *GetSignedVAAByDigestRequest) (*GetSignedVAAByDigestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAByDigest not implemented")
+}
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAsByTxHash(context.Context, *GetSignedVAAsByTxHashRequest) (*GetSignedVAAsByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAsByTxHash not implemented")
+}
+func (UnimplementedPublicRPCServiceServer) GetSignedVAAsByTimeRange(context.Context, *GetSignedVAAsByTimeRangeRequest) (*GetSignedVAAsByTimeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSignedVAAsByTimeRange not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentGuardianSet not implemented")
 }
@@ -174,6 +246,24 @@ func _PublicRPCService_GetLastHeartbeats_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetGuardianUptime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianUptimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetGuardianUptime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetGuardianUptime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetGuardianUptime(ctx, req.(*GetGuardianUptimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicRPCService_GetSignedVAA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSignedVAARequest)
 	if err := dec(in); err != nil {
@@ -192,6 +282,60 @@ func _PublicRPCService_GetSignedVAA_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_GetSignedVAAByDigest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAByDigestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAByDigest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAByDigest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAByDigest(ctx, req.(*GetSignedVAAByDigestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicRPCService_GetSignedVAAsByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAsByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTxHash(ctx, req.(*GetSignedVAAsByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PublicRPCService_GetSignedVAAsByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSignedVAAsByTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/publicrpc.v1.PublicRPCService/GetSignedVAAsByTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PublicRPCServiceServer).GetSignedVAAsByTimeRange(ctx, req.(*GetSignedVAAsByTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PublicRPCService_GetCurrentGuardianSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentGuardianSetRequest)
 	if err := dec(in); err != nil {
@@ -293,10 +437,26 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLastHeartbeats",
 			Handler:    _PublicRPCService_GetLastHeartbeats_Handler,
 		},
+		{
+			MethodName: "GetGuardianUptime",
+			Handler:    _PublicRPCService_GetGuardianUptime_Handler,
+		},
 		{
 			MethodName: "GetSignedVAA",
 			Handler:    _PublicRPCService_GetSignedVAA_Handler,
 		},
+		{
+			MethodName: "GetSignedVAAByDigest",
+			Handler:    _PublicRPCService_GetSignedVAAByDigest_Handler,
+		},
+		{
+			MethodName: "GetSignedVAAsByTxHash",
+			Handler:    _PublicRPCService_GetSignedVAAsByTxHash_Handler,
+		},
+		{
+			MethodName: "GetSignedVAAsByTimeRange",
+			Handler:    _PublicRPCService_GetSignedVAAsByTimeRange_Handler,
+		},
 		{
 			MethodName: "GetCurrentGuardianSet",
 			Handler:    _PublicRPCService_GetCurrentGuardianSet_Handler,
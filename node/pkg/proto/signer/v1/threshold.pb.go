@@ -0,0 +1,2279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: signer/v1/threshold.proto
+
+package signerv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ThresholdInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ThresholdInfoRequest) Reset() {
+	*x = ThresholdInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdInfoRequest) ProtoMessage() {}
+
+func (x *ThresholdInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdInfoRequest.ProtoReflect.Descriptor instead.
+func (*ThresholdInfoRequest) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{0}
+}
+
+type ThresholdInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the participant, which is also the x coordinate of its share (starting at 1).
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// The number of participants that are required to sign, or 0 before the key generation.
+	Threshold uint32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The total number of participants, or 0 before the key generation.
+	Parties uint32 `protobuf:"varint,3,opt,name=parties,proto3" json:"parties,omitempty"`
+	// The 65-byte uncompressed public key of the guardian key, or empty before the key generation.
+	PublicKey []byte `protobuf:"bytes,4,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The ed25519 public key that the participant signs its messages with.
+	SigningKey []byte `protobuf:"bytes,5,opt,name=signing_key,json=signingKey,proto3" json:"signing_key,omitempty"`
+	// The X25519 public key that the messages addressed to the participant are encrypted to.
+	EncryptionKey []byte `protobuf:"bytes,6,opt,name=encryption_key,json=encryptionKey,proto3" json:"encryption_key,omitempty"`
+}
+
+func (x *ThresholdInfoResponse) Reset() {
+	*x = ThresholdInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdInfoResponse) ProtoMessage() {}
+
+func (x *ThresholdInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdInfoResponse.ProtoReflect.Descriptor instead.
+func (*ThresholdInfoResponse) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ThresholdInfoResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ThresholdInfoResponse) GetThreshold() uint32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *ThresholdInfoResponse) GetParties() uint32 {
+	if x != nil {
+		return x.Parties
+	}
+	return 0
+}
+
+func (x *ThresholdInfoResponse) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ThresholdInfoResponse) GetSigningKey() []byte {
+	if x != nil {
+		return x.SigningKey
+	}
+	return nil
+}
+
+func (x *ThresholdInfoResponse) GetEncryptionKey() []byte {
+	if x != nil {
+		return x.EncryptionKey
+	}
+	return nil
+}
+
+type ThresholdSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*ThresholdSessionRequest_Start
+	//	*ThresholdSessionRequest_Envelope
+	Request isThresholdSessionRequest_Request `protobuf_oneof:"request"`
+}
+
+func (x *ThresholdSessionRequest) Reset() {
+	*x = ThresholdSessionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSessionRequest) ProtoMessage() {}
+
+func (x *ThresholdSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSessionRequest.ProtoReflect.Descriptor instead.
+func (*ThresholdSessionRequest) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *ThresholdSessionRequest) GetRequest() isThresholdSessionRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *ThresholdSessionRequest) GetStart() *ThresholdSessionStart {
+	if x, ok := x.GetRequest().(*ThresholdSessionRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ThresholdSessionRequest) GetEnvelope() *ThresholdEnvelope {
+	if x, ok := x.GetRequest().(*ThresholdSessionRequest_Envelope); ok {
+		return x.Envelope
+	}
+	return nil
+}
+
+type isThresholdSessionRequest_Request interface {
+	isThresholdSessionRequest_Request()
+}
+
+type ThresholdSessionRequest_Start struct {
+	Start *ThresholdSessionStart `protobuf:"bytes,1,opt,name=start,proto3,oneof"`
+}
+
+type ThresholdSessionRequest_Envelope struct {
+	Envelope *ThresholdEnvelope `protobuf:"bytes,2,opt,name=envelope,proto3,oneof"`
+}
+
+func (*ThresholdSessionRequest_Start) isThresholdSessionRequest_Request() {}
+
+func (*ThresholdSessionRequest_Envelope) isThresholdSessionRequest_Request() {}
+
+type ThresholdSessionStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unique identifier of the session, between 16 and 64 bytes.
+	SessionId []byte `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Types that are assignable to Session:
+	//	*ThresholdSessionStart_Keygen
+	//	*ThresholdSessionStart_Sign
+	Session isThresholdSessionStart_Session `protobuf_oneof:"session"`
+}
+
+func (x *ThresholdSessionStart) Reset() {
+	*x = ThresholdSessionStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSessionStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSessionStart) ProtoMessage() {}
+
+func (x *ThresholdSessionStart) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSessionStart.ProtoReflect.Descriptor instead.
+func (*ThresholdSessionStart) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ThresholdSessionStart) GetSessionId() []byte {
+	if x != nil {
+		return x.SessionId
+	}
+	return nil
+}
+
+func (m *ThresholdSessionStart) GetSession() isThresholdSessionStart_Session {
+	if m != nil {
+		return m.Session
+	}
+	return nil
+}
+
+func (x *ThresholdSessionStart) GetKeygen() *ThresholdKeygenStart {
+	if x, ok := x.GetSession().(*ThresholdSessionStart_Keygen); ok {
+		return x.Keygen
+	}
+	return nil
+}
+
+func (x *ThresholdSessionStart) GetSign() *ThresholdSignStart {
+	if x, ok := x.GetSession().(*ThresholdSessionStart_Sign); ok {
+		return x.Sign
+	}
+	return nil
+}
+
+type isThresholdSessionStart_Session interface {
+	isThresholdSessionStart_Session()
+}
+
+type ThresholdSessionStart_Keygen struct {
+	Keygen *ThresholdKeygenStart `protobuf:"bytes,2,opt,name=keygen,proto3,oneof"`
+}
+
+type ThresholdSessionStart_Sign struct {
+	Sign *ThresholdSignStart `protobuf:"bytes,3,opt,name=sign,proto3,oneof"`
+}
+
+func (*ThresholdSessionStart_Keygen) isThresholdSessionStart_Session() {}
+
+func (*ThresholdSessionStart_Sign) isThresholdSessionStart_Session() {}
+
+// ThresholdKeygenStart starts the key generation among all participants. The threshold and the identities of the
+// participants are taken from the configuration of each participant, not from the coordinator.
+type ThresholdKeygenStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ThresholdKeygenStart) Reset() {
+	*x = ThresholdKeygenStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdKeygenStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdKeygenStart) ProtoMessage() {}
+
+func (x *ThresholdKeygenStart) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdKeygenStart.ProtoReflect.Descriptor instead.
+func (*ThresholdKeygenStart) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{4}
+}
+
+type ThresholdSignStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 32-byte digest to sign.
+	Digest []byte `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	// The indexes of the participants taking part in the session, including the receiving participant.
+	Signers []uint32 `protobuf:"varint,2,rep,packed,name=signers,proto3" json:"signers,omitempty"`
+}
+
+func (x *ThresholdSignStart) Reset() {
+	*x = ThresholdSignStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignStart) ProtoMessage() {}
+
+func (x *ThresholdSignStart) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignStart.ProtoReflect.Descriptor instead.
+func (*ThresholdSignStart) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ThresholdSignStart) GetDigest() []byte {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+func (x *ThresholdSignStart) GetSigners() []uint32 {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+type ThresholdSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*ThresholdSessionResponse_Envelope
+	//	*ThresholdSessionResponse_Result
+	//	*ThresholdSessionResponse_Failure
+	Response isThresholdSessionResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *ThresholdSessionResponse) Reset() {
+	*x = ThresholdSessionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSessionResponse) ProtoMessage() {}
+
+func (x *ThresholdSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSessionResponse.ProtoReflect.Descriptor instead.
+func (*ThresholdSessionResponse) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{6}
+}
+
+func (m *ThresholdSessionResponse) GetResponse() isThresholdSessionResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *ThresholdSessionResponse) GetEnvelope() *ThresholdEnvelope {
+	if x, ok := x.GetResponse().(*ThresholdSessionResponse_Envelope); ok {
+		return x.Envelope
+	}
+	return nil
+}
+
+func (x *ThresholdSessionResponse) GetResult() *ThresholdSessionResult {
+	if x, ok := x.GetResponse().(*ThresholdSessionResponse_Result); ok {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *ThresholdSessionResponse) GetFailure() *ThresholdSessionFailure {
+	if x, ok := x.GetResponse().(*ThresholdSessionResponse_Failure); ok {
+		return x.Failure
+	}
+	return nil
+}
+
+type isThresholdSessionResponse_Response interface {
+	isThresholdSessionResponse_Response()
+}
+
+type ThresholdSessionResponse_Envelope struct {
+	Envelope *ThresholdEnvelope `protobuf:"bytes,1,opt,name=envelope,proto3,oneof"`
+}
+
+type ThresholdSessionResponse_Result struct {
+	Result *ThresholdSessionResult `protobuf:"bytes,2,opt,name=result,proto3,oneof"`
+}
+
+type ThresholdSessionResponse_Failure struct {
+	Failure *ThresholdSessionFailure `protobuf:"bytes,3,opt,name=failure,proto3,oneof"`
+}
+
+func (*ThresholdSessionResponse_Envelope) isThresholdSessionResponse_Response() {}
+
+func (*ThresholdSessionResponse_Result) isThresholdSessionResponse_Response() {}
+
+func (*ThresholdSessionResponse_Failure) isThresholdSessionResponse_Response() {}
+
+type ThresholdSessionResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The 65-byte uncompressed public key of the generated key (key generation sessions).
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// The 32-byte r and s values of the signature (signing sessions).
+	R []byte `protobuf:"bytes,2,opt,name=r,proto3" json:"r,omitempty"`
+	S []byte `protobuf:"bytes,3,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *ThresholdSessionResult) Reset() {
+	*x = ThresholdSessionResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSessionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSessionResult) ProtoMessage() {}
+
+func (x *ThresholdSessionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSessionResult.ProtoReflect.Descriptor instead.
+func (*ThresholdSessionResult) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ThresholdSessionResult) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *ThresholdSessionResult) GetR() []byte {
+	if x != nil {
+		return x.R
+	}
+	return nil
+}
+
+func (x *ThresholdSessionResult) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+type ThresholdSessionFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// The indexes of the participants whose messages failed verification, if the failure can be attributed.
+	Culprits []uint32 `protobuf:"varint,2,rep,packed,name=culprits,proto3" json:"culprits,omitempty"`
+}
+
+func (x *ThresholdSessionFailure) Reset() {
+	*x = ThresholdSessionFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSessionFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSessionFailure) ProtoMessage() {}
+
+func (x *ThresholdSessionFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSessionFailure.ProtoReflect.Descriptor instead.
+func (*ThresholdSessionFailure) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ThresholdSessionFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ThresholdSessionFailure) GetCulprits() []uint32 {
+	if x != nil {
+		return x.Culprits
+	}
+	return nil
+}
+
+// ThresholdEnvelope carries a message of a participant, which is relayed by the coordinator.
+type ThresholdEnvelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The index of the sending participant.
+	From uint32 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	// The index of the receiving participant, or 0 for messages that are broadcast to all participants.
+	To uint32 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// The protocol round the message belongs to, starting at 1.
+	Round uint32 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// The serialized ThresholdMessage. Messages addressed to a single participant are sealed with NaCl box to the
+	// encryption key of the recipient.
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The ed25519 signature of the sender over the session, the routing fields and the payload.
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ThresholdEnvelope) Reset() {
+	*x = ThresholdEnvelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdEnvelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdEnvelope) ProtoMessage() {}
+
+func (x *ThresholdEnvelope) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdEnvelope.ProtoReflect.Descriptor instead.
+func (*ThresholdEnvelope) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ThresholdEnvelope) GetFrom() uint32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ThresholdEnvelope) GetTo() uint32 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ThresholdEnvelope) GetRound() uint32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *ThresholdEnvelope) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ThresholdEnvelope) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+// ThresholdMessage is a protocol message. The key generation and signing protocols are those of GG18 (Gennaro and
+// Goldfeder, "Fast Multiparty Threshold ECDSA with Fast Trustless Setup"), as implemented by tss-lib.
+type ThresholdMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Message:
+	//	*ThresholdMessage_KeygenCommitment
+	//	*ThresholdMessage_KeygenShare
+	//	*ThresholdMessage_KeygenDecommitment
+	//	*ThresholdMessage_KeygenPaillierProof
+	//	*ThresholdMessage_SignMtaInit
+	//	*ThresholdMessage_SignCommitment
+	//	*ThresholdMessage_SignMtaResponse
+	//	*ThresholdMessage_SignTheta
+	//	*ThresholdMessage_SignGammaDecommitment
+	//	*ThresholdMessage_SignCheckDecommitment
+	//	*ThresholdMessage_SignDecommitment
+	//	*ThresholdMessage_SignShare
+	Message isThresholdMessage_Message `protobuf_oneof:"message"`
+}
+
+func (x *ThresholdMessage) Reset() {
+	*x = ThresholdMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdMessage) ProtoMessage() {}
+
+func (x *ThresholdMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdMessage.ProtoReflect.Descriptor instead.
+func (*ThresholdMessage) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{10}
+}
+
+func (m *ThresholdMessage) GetMessage() isThresholdMessage_Message {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetKeygenCommitment() *ThresholdKeygenCommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_KeygenCommitment); ok {
+		return x.KeygenCommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetKeygenShare() *ThresholdKeygenShare {
+	if x, ok := x.GetMessage().(*ThresholdMessage_KeygenShare); ok {
+		return x.KeygenShare
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetKeygenDecommitment() *ThresholdDecommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_KeygenDecommitment); ok {
+		return x.KeygenDecommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetKeygenPaillierProof() *ThresholdKeygenPaillierProof {
+	if x, ok := x.GetMessage().(*ThresholdMessage_KeygenPaillierProof); ok {
+		return x.KeygenPaillierProof
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignMtaInit() *ThresholdSignMtAInit {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignMtaInit); ok {
+		return x.SignMtaInit
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignCommitment() *ThresholdCommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignCommitment); ok {
+		return x.SignCommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignMtaResponse() *ThresholdSignMtAResponse {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignMtaResponse); ok {
+		return x.SignMtaResponse
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignTheta() *ThresholdSignTheta {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignTheta); ok {
+		return x.SignTheta
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignGammaDecommitment() *ThresholdSignGammaDecommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignGammaDecommitment); ok {
+		return x.SignGammaDecommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignCheckDecommitment() *ThresholdSignCheckDecommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignCheckDecommitment); ok {
+		return x.SignCheckDecommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignDecommitment() *ThresholdDecommitment {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignDecommitment); ok {
+		return x.SignDecommitment
+	}
+	return nil
+}
+
+func (x *ThresholdMessage) GetSignShare() *ThresholdSignShare {
+	if x, ok := x.GetMessage().(*ThresholdMessage_SignShare); ok {
+		return x.SignShare
+	}
+	return nil
+}
+
+type isThresholdMessage_Message interface {
+	isThresholdMessage_Message()
+}
+
+type ThresholdMessage_KeygenCommitment struct {
+	KeygenCommitment *ThresholdKeygenCommitment `protobuf:"bytes,1,opt,name=keygen_commitment,json=keygenCommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_KeygenShare struct {
+	KeygenShare *ThresholdKeygenShare `protobuf:"bytes,2,opt,name=keygen_share,json=keygenShare,proto3,oneof"`
+}
+
+type ThresholdMessage_KeygenDecommitment struct {
+	KeygenDecommitment *ThresholdDecommitment `protobuf:"bytes,3,opt,name=keygen_decommitment,json=keygenDecommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_KeygenPaillierProof struct {
+	KeygenPaillierProof *ThresholdKeygenPaillierProof `protobuf:"bytes,4,opt,name=keygen_paillier_proof,json=keygenPaillierProof,proto3,oneof"`
+}
+
+type ThresholdMessage_SignMtaInit struct {
+	SignMtaInit *ThresholdSignMtAInit `protobuf:"bytes,5,opt,name=sign_mta_init,json=signMtaInit,proto3,oneof"`
+}
+
+type ThresholdMessage_SignCommitment struct {
+	SignCommitment *ThresholdCommitment `protobuf:"bytes,6,opt,name=sign_commitment,json=signCommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_SignMtaResponse struct {
+	SignMtaResponse *ThresholdSignMtAResponse `protobuf:"bytes,7,opt,name=sign_mta_response,json=signMtaResponse,proto3,oneof"`
+}
+
+type ThresholdMessage_SignTheta struct {
+	SignTheta *ThresholdSignTheta `protobuf:"bytes,8,opt,name=sign_theta,json=signTheta,proto3,oneof"`
+}
+
+type ThresholdMessage_SignGammaDecommitment struct {
+	SignGammaDecommitment *ThresholdSignGammaDecommitment `protobuf:"bytes,9,opt,name=sign_gamma_decommitment,json=signGammaDecommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_SignCheckDecommitment struct {
+	SignCheckDecommitment *ThresholdSignCheckDecommitment `protobuf:"bytes,10,opt,name=sign_check_decommitment,json=signCheckDecommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_SignDecommitment struct {
+	SignDecommitment *ThresholdDecommitment `protobuf:"bytes,11,opt,name=sign_decommitment,json=signDecommitment,proto3,oneof"`
+}
+
+type ThresholdMessage_SignShare struct {
+	SignShare *ThresholdSignShare `protobuf:"bytes,12,opt,name=sign_share,json=signShare,proto3,oneof"`
+}
+
+func (*ThresholdMessage_KeygenCommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_KeygenShare) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_KeygenDecommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_KeygenPaillierProof) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignMtaInit) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignCommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignMtaResponse) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignTheta) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignGammaDecommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignCheckDecommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignDecommitment) isThresholdMessage_Message() {}
+
+func (*ThresholdMessage_SignShare) isThresholdMessage_Message() {}
+
+type ThresholdCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment []byte `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (x *ThresholdCommitment) Reset() {
+	*x = ThresholdCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdCommitment) ProtoMessage() {}
+
+func (x *ThresholdCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdCommitment.ProtoReflect.Descriptor instead.
+func (*ThresholdCommitment) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ThresholdCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+type ThresholdDecommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decommitment [][]byte `protobuf:"bytes,1,rep,name=decommitment,proto3" json:"decommitment,omitempty"`
+}
+
+func (x *ThresholdDecommitment) Reset() {
+	*x = ThresholdDecommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdDecommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdDecommitment) ProtoMessage() {}
+
+func (x *ThresholdDecommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdDecommitment.ProtoReflect.Descriptor instead.
+func (*ThresholdDecommitment) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ThresholdDecommitment) GetDecommitment() [][]byte {
+	if x != nil {
+		return x.Decommitment
+	}
+	return nil
+}
+
+type ThresholdSchnorrProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlphaX []byte `protobuf:"bytes,1,opt,name=alpha_x,json=alphaX,proto3" json:"alpha_x,omitempty"`
+	AlphaY []byte `protobuf:"bytes,2,opt,name=alpha_y,json=alphaY,proto3" json:"alpha_y,omitempty"`
+	T      []byte `protobuf:"bytes,3,opt,name=t,proto3" json:"t,omitempty"`
+	// Only set for proofs of knowledge of two exponents (ZKV proofs).
+	U []byte `protobuf:"bytes,4,opt,name=u,proto3" json:"u,omitempty"`
+}
+
+func (x *ThresholdSchnorrProof) Reset() {
+	*x = ThresholdSchnorrProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSchnorrProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSchnorrProof) ProtoMessage() {}
+
+func (x *ThresholdSchnorrProof) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSchnorrProof.ProtoReflect.Descriptor instead.
+func (*ThresholdSchnorrProof) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ThresholdSchnorrProof) GetAlphaX() []byte {
+	if x != nil {
+		return x.AlphaX
+	}
+	return nil
+}
+
+func (x *ThresholdSchnorrProof) GetAlphaY() []byte {
+	if x != nil {
+		return x.AlphaY
+	}
+	return nil
+}
+
+func (x *ThresholdSchnorrProof) GetT() []byte {
+	if x != nil {
+		return x.T
+	}
+	return nil
+}
+
+func (x *ThresholdSchnorrProof) GetU() []byte {
+	if x != nil {
+		return x.U
+	}
+	return nil
+}
+
+// Keygen round 1 (broadcast): the commitment to the participant's Feldman VSS polynomial, its Paillier public
+// key, and its parameters for the range proofs with the proofs of their construction.
+type ThresholdKeygenCommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commitment []byte   `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	PaillierN  []byte   `protobuf:"bytes,2,opt,name=paillier_n,json=paillierN,proto3" json:"paillier_n,omitempty"`
+	NTilde     []byte   `protobuf:"bytes,3,opt,name=n_tilde,json=nTilde,proto3" json:"n_tilde,omitempty"`
+	H1         []byte   `protobuf:"bytes,4,opt,name=h1,proto3" json:"h1,omitempty"`
+	H2         []byte   `protobuf:"bytes,5,opt,name=h2,proto3" json:"h2,omitempty"`
+	DlnProof_1 [][]byte `protobuf:"bytes,6,rep,name=dln_proof_1,json=dlnProof1,proto3" json:"dln_proof_1,omitempty"`
+	DlnProof_2 [][]byte `protobuf:"bytes,7,rep,name=dln_proof_2,json=dlnProof2,proto3" json:"dln_proof_2,omitempty"`
+}
+
+func (x *ThresholdKeygenCommitment) Reset() {
+	*x = ThresholdKeygenCommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdKeygenCommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdKeygenCommitment) ProtoMessage() {}
+
+func (x *ThresholdKeygenCommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdKeygenCommitment.ProtoReflect.Descriptor instead.
+func (*ThresholdKeygenCommitment) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ThresholdKeygenCommitment) GetCommitment() []byte {
+	if x != nil {
+		return x.Commitment
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetPaillierN() []byte {
+	if x != nil {
+		return x.PaillierN
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetNTilde() []byte {
+	if x != nil {
+		return x.NTilde
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetH1() []byte {
+	if x != nil {
+		return x.H1
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetH2() []byte {
+	if x != nil {
+		return x.H2
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetDlnProof_1() [][]byte {
+	if x != nil {
+		return x.DlnProof_1
+	}
+	return nil
+}
+
+func (x *ThresholdKeygenCommitment) GetDlnProof_2() [][]byte {
+	if x != nil {
+		return x.DlnProof_2
+	}
+	return nil
+}
+
+// Keygen round 2 (to a single participant): the share of the participant's secret for the recipient.
+type ThresholdKeygenShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+}
+
+func (x *ThresholdKeygenShare) Reset() {
+	*x = ThresholdKeygenShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdKeygenShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdKeygenShare) ProtoMessage() {}
+
+func (x *ThresholdKeygenShare) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdKeygenShare.ProtoReflect.Descriptor instead.
+func (*ThresholdKeygenShare) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ThresholdKeygenShare) GetShare() []byte {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// Keygen round 3 (broadcast): the proof that the participant's Paillier modulus is well formed.
+type ThresholdKeygenPaillierProof struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof [][]byte `protobuf:"bytes,1,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *ThresholdKeygenPaillierProof) Reset() {
+	*x = ThresholdKeygenPaillierProof{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdKeygenPaillierProof) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdKeygenPaillierProof) ProtoMessage() {}
+
+func (x *ThresholdKeygenPaillierProof) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdKeygenPaillierProof.ProtoReflect.Descriptor instead.
+func (*ThresholdKeygenPaillierProof) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ThresholdKeygenPaillierProof) GetProof() [][]byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// Sign round 1 (to a single participant): the participant's encrypted nonce share, with a range proof.
+type ThresholdSignMtAInit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C          []byte   `protobuf:"bytes,1,opt,name=c,proto3" json:"c,omitempty"`
+	RangeProof [][]byte `protobuf:"bytes,2,rep,name=range_proof,json=rangeProof,proto3" json:"range_proof,omitempty"`
+}
+
+func (x *ThresholdSignMtAInit) Reset() {
+	*x = ThresholdSignMtAInit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignMtAInit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignMtAInit) ProtoMessage() {}
+
+func (x *ThresholdSignMtAInit) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignMtAInit.ProtoReflect.Descriptor instead.
+func (*ThresholdSignMtAInit) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ThresholdSignMtAInit) GetC() []byte {
+	if x != nil {
+		return x.C
+	}
+	return nil
+}
+
+func (x *ThresholdSignMtAInit) GetRangeProof() [][]byte {
+	if x != nil {
+		return x.RangeProof
+	}
+	return nil
+}
+
+// Sign round 2 (to a single participant): the responses to the MtA conversions of k * gamma and k * w.
+type ThresholdSignMtAResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	C1         []byte   `protobuf:"bytes,1,opt,name=c1,proto3" json:"c1,omitempty"`
+	C2         []byte   `protobuf:"bytes,2,opt,name=c2,proto3" json:"c2,omitempty"`
+	ProofBob   [][]byte `protobuf:"bytes,3,rep,name=proof_bob,json=proofBob,proto3" json:"proof_bob,omitempty"`
+	ProofBobWc [][]byte `protobuf:"bytes,4,rep,name=proof_bob_wc,json=proofBobWc,proto3" json:"proof_bob_wc,omitempty"`
+}
+
+func (x *ThresholdSignMtAResponse) Reset() {
+	*x = ThresholdSignMtAResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignMtAResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignMtAResponse) ProtoMessage() {}
+
+func (x *ThresholdSignMtAResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignMtAResponse.ProtoReflect.Descriptor instead.
+func (*ThresholdSignMtAResponse) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ThresholdSignMtAResponse) GetC1() []byte {
+	if x != nil {
+		return x.C1
+	}
+	return nil
+}
+
+func (x *ThresholdSignMtAResponse) GetC2() []byte {
+	if x != nil {
+		return x.C2
+	}
+	return nil
+}
+
+func (x *ThresholdSignMtAResponse) GetProofBob() [][]byte {
+	if x != nil {
+		return x.ProofBob
+	}
+	return nil
+}
+
+func (x *ThresholdSignMtAResponse) GetProofBobWc() [][]byte {
+	if x != nil {
+		return x.ProofBobWc
+	}
+	return nil
+}
+
+// Sign round 3 (broadcast): the participant's share of theta = k * gamma.
+type ThresholdSignTheta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Theta []byte `protobuf:"bytes,1,opt,name=theta,proto3" json:"theta,omitempty"`
+}
+
+func (x *ThresholdSignTheta) Reset() {
+	*x = ThresholdSignTheta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignTheta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignTheta) ProtoMessage() {}
+
+func (x *ThresholdSignTheta) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignTheta.ProtoReflect.Descriptor instead.
+func (*ThresholdSignTheta) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ThresholdSignTheta) GetTheta() []byte {
+	if x != nil {
+		return x.Theta
+	}
+	return nil
+}
+
+// Sign round 4 (broadcast): the decommitment of the participant's nonce point Gamma, with a proof of knowledge of
+// its discrete logarithm.
+type ThresholdSignGammaDecommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decommitment [][]byte               `protobuf:"bytes,1,rep,name=decommitment,proto3" json:"decommitment,omitempty"`
+	Proof        *ThresholdSchnorrProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *ThresholdSignGammaDecommitment) Reset() {
+	*x = ThresholdSignGammaDecommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignGammaDecommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignGammaDecommitment) ProtoMessage() {}
+
+func (x *ThresholdSignGammaDecommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignGammaDecommitment.ProtoReflect.Descriptor instead.
+func (*ThresholdSignGammaDecommitment) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ThresholdSignGammaDecommitment) GetDecommitment() [][]byte {
+	if x != nil {
+		return x.Decommitment
+	}
+	return nil
+}
+
+func (x *ThresholdSignGammaDecommitment) GetProof() *ThresholdSchnorrProof {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+// Sign round 6 (broadcast): the decommitment of the participant's V and A points, with their proofs.
+type ThresholdSignCheckDecommitment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Decommitment [][]byte               `protobuf:"bytes,1,rep,name=decommitment,proto3" json:"decommitment,omitempty"`
+	ProofA       *ThresholdSchnorrProof `protobuf:"bytes,2,opt,name=proof_a,json=proofA,proto3" json:"proof_a,omitempty"`
+	ProofV       *ThresholdSchnorrProof `protobuf:"bytes,3,opt,name=proof_v,json=proofV,proto3" json:"proof_v,omitempty"`
+}
+
+func (x *ThresholdSignCheckDecommitment) Reset() {
+	*x = ThresholdSignCheckDecommitment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignCheckDecommitment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignCheckDecommitment) ProtoMessage() {}
+
+func (x *ThresholdSignCheckDecommitment) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignCheckDecommitment.ProtoReflect.Descriptor instead.
+func (*ThresholdSignCheckDecommitment) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ThresholdSignCheckDecommitment) GetDecommitment() [][]byte {
+	if x != nil {
+		return x.Decommitment
+	}
+	return nil
+}
+
+func (x *ThresholdSignCheckDecommitment) GetProofA() *ThresholdSchnorrProof {
+	if x != nil {
+		return x.ProofA
+	}
+	return nil
+}
+
+func (x *ThresholdSignCheckDecommitment) GetProofV() *ThresholdSchnorrProof {
+	if x != nil {
+		return x.ProofV
+	}
+	return nil
+}
+
+// Sign round 9 (broadcast): the participant's share of s.
+type ThresholdSignShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	S []byte `protobuf:"bytes,1,opt,name=s,proto3" json:"s,omitempty"`
+}
+
+func (x *ThresholdSignShare) Reset() {
+	*x = ThresholdSignShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_v1_threshold_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThresholdSignShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThresholdSignShare) ProtoMessage() {}
+
+func (x *ThresholdSignShare) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_v1_threshold_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThresholdSignShare.ProtoReflect.Descriptor instead.
+func (*ThresholdSignShare) Descriptor() ([]byte, []int) {
+	return file_signer_v1_threshold_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ThresholdSignShare) GetS() []byte {
+	if x != nil {
+		return x.S
+	}
+	return nil
+}
+
+var File_signer_v1_threshold_proto protoreflect.FileDescriptor
+
+var file_signer_v1_threshold_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x22, 0x16, 0x0a, 0x14, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xcc,
+	0x01, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x73, 0x69, 0x67, 0x6e,
+	0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x9a, 0x01,
+	0x0a, 0x17, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x76, 0x65, 0x6c,
+	0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x42,
+	0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x15, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x12, 0x33,
+	0x0a, 0x04, 0x73, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x04, 0x73,
+	0x69, 0x67, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16,
+	0x0a, 0x14, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65,
+	0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0xdf,
+	0x01, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x65,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x48, 0x00, 0x52, 0x07, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x53, 0x0a, 0x16, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x01, 0x73, 0x22, 0x4b, 0x0a, 0x17, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x6c, 0x70, 0x72, 0x69,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x08, 0x63, 0x75, 0x6c, 0x70, 0x72, 0x69,
+	0x74, 0x73, 0x22, 0x85, 0x01, 0x0a, 0x11, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xec, 0x07, 0x0a, 0x10, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x53, 0x0a, 0x11, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x48, 0x00, 0x52, 0x10, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x5f, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x6b,
+	0x65, 0x79, 0x67, 0x65, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x53, 0x0a, 0x13, 0x6b, 0x65,
+	0x79, 0x67, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x6b, 0x65, 0x79,
+	0x67, 0x65, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x5d, 0x0a, 0x15, 0x6b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69,
+	0x65, 0x72, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x6c, 0x6c, 0x69,
+	0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x48, 0x00, 0x52, 0x13, 0x6b, 0x65, 0x79, 0x67, 0x65,
+	0x6e, 0x50, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x45,
+	0x0a, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x74, 0x61, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x4d,
+	0x74, 0x41, 0x49, 0x6e, 0x69, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x74,
+	0x61, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00,
+	0x52, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x51, 0x0a, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x74, 0x61, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x74, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0f, 0x73, 0x69, 0x67, 0x6e, 0x4d, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x74, 0x68, 0x65, 0x74,
+	0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x54, 0x68, 0x65, 0x74, 0x61, 0x48, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x54, 0x68,
+	0x65, 0x74, 0x61, 0x12, 0x63, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x67, 0x61, 0x6d, 0x6d,
+	0x61, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x61,
+	0x6d, 0x6d, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x48,
+	0x00, 0x52, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x6d, 0x6d, 0x61, 0x44, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x63, 0x0a, 0x17, 0x73, 0x69, 0x67, 0x6e,
+	0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53,
+	0x69, 0x67, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x15, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x4f, 0x0a,
+	0x11, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x65,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x10, 0x73, 0x69,
+	0x67, 0x6e, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x3e,
+	0x0a, 0x0a, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x48, 0x00, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x13, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0x3b, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x44, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x63,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x0c, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a,
+	0x15, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72,
+	0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x58, 0x12,
+	0x17, 0x0a, 0x07, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x59, 0x12, 0x0c, 0x0a, 0x01, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x01, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x75, 0x22, 0xd3, 0x01, 0x0a, 0x19, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x69, 0x6c, 0x6c, 0x69, 0x65, 0x72,
+	0x4e, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x5f, 0x74, 0x69, 0x6c, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x6e, 0x54, 0x69, 0x6c, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x31,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x68, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x32,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x68, 0x32, 0x12, 0x1e, 0x0a, 0x0b, 0x64, 0x6c,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x31, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x09, 0x64, 0x6c, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x31, 0x12, 0x1e, 0x0a, 0x0b, 0x64, 0x6c,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x32, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52,
+	0x09, 0x64, 0x6c, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x32, 0x22, 0x2c, 0x0a, 0x14, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x65, 0x22, 0x34, 0x0a, 0x1c, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4b, 0x65, 0x79, 0x67, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x6c, 0x6c,
+	0x69, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
+	0x66, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x45,
+	0x0a, 0x14, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x4d,
+	0x74, 0x41, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x01, 0x63, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x79, 0x0a, 0x18, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x4d, 0x74, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x63,
+	0x31, 0x12, 0x0e, 0x0a, 0x02, 0x63, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x63,
+	0x32, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6f, 0x62, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f, 0x62, 0x12, 0x20,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x62, 0x6f, 0x62, 0x5f, 0x77, 0x63, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x42, 0x6f, 0x62, 0x57, 0x63,
+	0x22, 0x2a, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67,
+	0x6e, 0x54, 0x68, 0x65, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x74, 0x68, 0x65, 0x74, 0x61, 0x22, 0x7c, 0x0a, 0x1e,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x47, 0x61, 0x6d,
+	0x6d, 0x61, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xba, 0x01, 0x0a, 0x1e, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x44, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x61, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x41, 0x12, 0x39, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6f, 0x66, 0x5f, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x53, 0x63, 0x68, 0x6e, 0x6f, 0x72, 0x72, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52,
+	0x06, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x56, 0x22, 0x22, 0x0a, 0x12, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x0c, 0x0a,
+	0x01, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x01, 0x73, 0x32, 0xbb, 0x01, 0x0a, 0x16,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x49, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x56, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x75, 0x73, 0x6f, 0x6e,
+	0x65, 0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x2f, 0x76, 0x31, 0x3b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_signer_v1_threshold_proto_rawDescOnce sync.Once
+	file_signer_v1_threshold_proto_rawDescData = file_signer_v1_threshold_proto_rawDesc
+)
+
+func file_signer_v1_threshold_proto_rawDescGZIP() []byte {
+	file_signer_v1_threshold_proto_rawDescOnce.Do(func() {
+		file_signer_v1_threshold_proto_rawDescData = protoimpl.X.CompressGZIP(file_signer_v1_threshold_proto_rawDescData)
+	})
+	return file_signer_v1_threshold_proto_rawDescData
+}
+
+var file_signer_v1_threshold_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_signer_v1_threshold_proto_goTypes = []interface{}{
+	(*ThresholdInfoRequest)(nil),           // 0: signer.v1.ThresholdInfoRequest
+	(*ThresholdInfoResponse)(nil),          // 1: signer.v1.ThresholdInfoResponse
+	(*ThresholdSessionRequest)(nil),        // 2: signer.v1.ThresholdSessionRequest
+	(*ThresholdSessionStart)(nil),          // 3: signer.v1.ThresholdSessionStart
+	(*ThresholdKeygenStart)(nil),           // 4: signer.v1.ThresholdKeygenStart
+	(*ThresholdSignStart)(nil),             // 5: signer.v1.ThresholdSignStart
+	(*ThresholdSessionResponse)(nil),       // 6: signer.v1.ThresholdSessionResponse
+	(*ThresholdSessionResult)(nil),         // 7: signer.v1.ThresholdSessionResult
+	(*ThresholdSessionFailure)(nil),        // 8: signer.v1.ThresholdSessionFailure
+	(*ThresholdEnvelope)(nil),              // 9: signer.v1.ThresholdEnvelope
+	(*ThresholdMessage)(nil),               // 10: signer.v1.ThresholdMessage
+	(*ThresholdCommitment)(nil),            // 11: signer.v1.ThresholdCommitment
+	(*ThresholdDecommitment)(nil),          // 12: signer.v1.ThresholdDecommitment
+	(*ThresholdSchnorrProof)(nil),          // 13: signer.v1.ThresholdSchnorrProof
+	(*ThresholdKeygenCommitment)(nil),      // 14: signer.v1.ThresholdKeygenCommitment
+	(*ThresholdKeygenShare)(nil),           // 15: signer.v1.ThresholdKeygenShare
+	(*ThresholdKeygenPaillierProof)(nil),   // 16: signer.v1.ThresholdKeygenPaillierProof
+	(*ThresholdSignMtAInit)(nil),           // 17: signer.v1.ThresholdSignMtAInit
+	(*ThresholdSignMtAResponse)(nil),       // 18: signer.v1.ThresholdSignMtAResponse
+	(*ThresholdSignTheta)(nil),             // 19: signer.v1.ThresholdSignTheta
+	(*ThresholdSignGammaDecommitment)(nil), // 20: signer.v1.ThresholdSignGammaDecommitment
+	(*ThresholdSignCheckDecommitment)(nil), // 21: signer.v1.ThresholdSignCheckDecommitment
+	(*ThresholdSignShare)(nil),             // 22: signer.v1.ThresholdSignShare
+}
+var file_signer_v1_threshold_proto_depIdxs = []int32{
+	3,  // 0: signer.v1.ThresholdSessionRequest.start:type_name -> signer.v1.ThresholdSessionStart
+	9,  // 1: signer.v1.ThresholdSessionRequest.envelope:type_name -> signer.v1.ThresholdEnvelope
+	4,  // 2: signer.v1.ThresholdSessionStart.keygen:type_name -> signer.v1.ThresholdKeygenStart
+	5,  // 3: signer.v1.ThresholdSessionStart.sign:type_name -> signer.v1.ThresholdSignStart
+	9,  // 4: signer.v1.ThresholdSessionResponse.envelope:type_name -> signer.v1.ThresholdEnvelope
+	7,  // 5: signer.v1.ThresholdSessionResponse.result:type_name -> signer.v1.ThresholdSessionResult
+	8,  // 6: signer.v1.ThresholdSessionResponse.failure:type_name -> signer.v1.ThresholdSessionFailure
+	14, // 7: signer.v1.ThresholdMessage.keygen_commitment:type_name -> signer.v1.ThresholdKeygenCommitment
+	15, // 8: signer.v1.ThresholdMessage.keygen_share:type_name -> signer.v1.ThresholdKeygenShare
+	12, // 9: signer.v1.ThresholdMessage.keygen_decommitment:type_name -> signer.v1.ThresholdDecommitment
+	16, // 10: signer.v1.ThresholdMessage.keygen_paillier_proof:type_name -> signer.v1.ThresholdKeygenPaillierProof
+	17, // 11: signer.v1.ThresholdMessage.sign_mta_init:type_name -> signer.v1.ThresholdSignMtAInit
+	11, // 12: signer.v1.ThresholdMessage.sign_commitment:type_name -> signer.v1.ThresholdCommitment
+	18, // 13: signer.v1.ThresholdMessage.sign_mta_response:type_name -> signer.v1.ThresholdSignMtAResponse
+	19, // 14: signer.v1.ThresholdMessage.sign_theta:type_name -> signer.v1.ThresholdSignTheta
+	20, // 15: signer.v1.ThresholdMessage.sign_gamma_decommitment:type_name -> signer.v1.ThresholdSignGammaDecommitment
+	21, // 16: signer.v1.ThresholdMessage.sign_check_decommitment:type_name -> signer.v1.ThresholdSignCheckDecommitment
+	12, // 17: signer.v1.ThresholdMessage.sign_decommitment:type_name -> signer.v1.ThresholdDecommitment
+	22, // 18: signer.v1.ThresholdMessage.sign_share:type_name -> signer.v1.ThresholdSignShare
+	13, // 19: signer.v1.ThresholdSignGammaDecommitment.proof:type_name -> signer.v1.ThresholdSchnorrProof
+	13, // 20: signer.v1.ThresholdSignCheckDecommitment.proof_a:type_name -> signer.v1.ThresholdSchnorrProof
+	13, // 21: signer.v1.ThresholdSignCheckDecommitment.proof_v:type_name -> signer.v1.ThresholdSchnorrProof
+	0,  // 22: signer.v1.ThresholdSignerService.Info:input_type -> signer.v1.ThresholdInfoRequest
+	2,  // 23: signer.v1.ThresholdSignerService.Session:input_type -> signer.v1.ThresholdSessionRequest
+	1,  // 24: signer.v1.ThresholdSignerService.Info:output_type -> signer.v1.ThresholdInfoResponse
+	6,  // 25: signer.v1.ThresholdSignerService.Session:output_type -> signer.v1.ThresholdSessionResponse
+	24, // [24:26] is the sub-list for method output_type
+	22, // [22:24] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
+}
+
+func init() { file_signer_v1_threshold_proto_init() }
+func file_signer_v1_threshold_proto_init() {
+	if File_signer_v1_threshold_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_signer_v1_threshold_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSessionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSessionStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdKeygenStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSessionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSessionResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSessionFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdEnvelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdDecommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSchnorrProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdKeygenCommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdKeygenShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdKeygenPaillierProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignMtAInit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignMtAResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignTheta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignGammaDecommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignCheckDecommitment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_v1_threshold_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThresholdSignShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_signer_v1_threshold_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*ThresholdSessionRequest_Start)(nil),
+		(*ThresholdSessionRequest_Envelope)(nil),
+	}
+	file_signer_v1_threshold_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ThresholdSessionStart_Keygen)(nil),
+		(*ThresholdSessionStart_Sign)(nil),
+	}
+	file_signer_v1_threshold_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ThresholdSessionResponse_Envelope)(nil),
+		(*ThresholdSessionResponse_Result)(nil),
+		(*ThresholdSessionResponse_Failure)(nil),
+	}
+	file_signer_v1_threshold_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*ThresholdMessage_KeygenCommitment)(nil),
+		(*ThresholdMessage_KeygenShare)(nil),
+		(*ThresholdMessage_KeygenDecommitment)(nil),
+		(*ThresholdMessage_KeygenPaillierProof)(nil),
+		(*ThresholdMessage_SignMtaInit)(nil),
+		(*ThresholdMessage_SignCommitment)(nil),
+		(*ThresholdMessage_SignMtaResponse)(nil),
+		(*ThresholdMessage_SignTheta)(nil),
+		(*ThresholdMessage_SignGammaDecommitment)(nil),
+		(*ThresholdMessage_SignCheckDecommitment)(nil),
+		(*ThresholdMessage_SignDecommitment)(nil),
+		(*ThresholdMessage_SignShare)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_signer_v1_threshold_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_signer_v1_threshold_proto_goTypes,
+		DependencyIndexes: file_signer_v1_threshold_proto_depIdxs,
+		MessageInfos:      file_signer_v1_threshold_proto_msgTypes,
+	}.Build()
+	File_signer_v1_threshold_proto = out.File
+	file_signer_v1_threshold_proto_rawDesc = nil
+	file_signer_v1_threshold_proto_goTypes = nil
+	file_signer_v1_threshold_proto_depIdxs = nil
+}
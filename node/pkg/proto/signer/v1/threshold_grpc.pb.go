@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package signerv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ThresholdSignerServiceClient is the client API for ThresholdSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ThresholdSignerServiceClient interface {
+	// Info returns the index and the identity of the participant, and the parameters of its key share.
+	Info(ctx context.Context, in *ThresholdInfoRequest, opts ...grpc.CallOption) (*ThresholdInfoResponse, error)
+	// Session runs a key generation or signing session. The first request starts the session, and the following
+	// requests relay the messages of the other participants. The participant responds with its own messages, and
+	// ends the session with a result or a failure.
+	Session(ctx context.Context, opts ...grpc.CallOption) (ThresholdSignerService_SessionClient, error)
+}
+
+type thresholdSignerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewThresholdSignerServiceClient(cc grpc.ClientConnInterface) ThresholdSignerServiceClient {
+	return &thresholdSignerServiceClient{cc}
+}
+
+func (c *thresholdSignerServiceClient) Info(ctx context.Context, in *ThresholdInfoRequest, opts ...grpc.CallOption) (*ThresholdInfoResponse, error) {
+	out := new(ThresholdInfoResponse)
+	err := c.cc.Invoke(ctx, "/signer.v1.ThresholdSignerService/Info", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *thresholdSignerServiceClient) Session(ctx context.Context, opts ...grpc.CallOption) (ThresholdSignerService_SessionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ThresholdSignerService_ServiceDesc.Streams[0], "/signer.v1.ThresholdSignerService/Session", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &thresholdSignerServiceSessionClient{stream}
+	return x, nil
+}
+
+type ThresholdSignerService_SessionClient interface {
+	Send(*ThresholdSessionRequest) error
+	Recv() (*ThresholdSessionResponse, error)
+	grpc.ClientStream
+}
+
+type thresholdSignerServiceSessionClient struct {
+	grpc.ClientStream
+}
+
+func (x *thresholdSignerServiceSessionClient) Send(m *ThresholdSessionRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *thresholdSignerServiceSessionClient) Recv() (*ThresholdSessionResponse, error) {
+	m := new(ThresholdSessionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ThresholdSignerServiceServer is the server API for ThresholdSignerService service.
+// All implementations must embed UnimplementedThresholdSignerServiceServer
+// for forward compatibility
+type ThresholdSignerServiceServer interface {
+	// Info returns the index and the identity of the participant, and the parameters of its key share.
+	Info(context.Context, *ThresholdInfoRequest) (*ThresholdInfoResponse, error)
+	// Session runs a key generation or signing session. The first request starts the session, and the following
+	// requests relay the messages of the other participants. The participant responds with its own messages, and
+	// ends the session with a result or a failure.
+	Session(ThresholdSignerService_SessionServer) error
+	mustEmbedUnimplementedThresholdSignerServiceServer()
+}
+
+// UnimplementedThresholdSignerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedThresholdSignerServiceServer struct {
+}
+
+func (UnimplementedThresholdSignerServiceServer) Info(context.Context, *ThresholdInfoRequest) (*ThresholdInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Info not implemented")
+}
+func (UnimplementedThresholdSignerServiceServer) Session(ThresholdSignerService_SessionServer) error {
+	return status.Errorf(codes.Unimplemented, "method Session not implemented")
+}
+func (UnimplementedThresholdSignerServiceServer) mustEmbedUnimplementedThresholdSignerServiceServer() {
+}
+
+// UnsafeThresholdSignerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ThresholdSignerServiceServer will
+// result in compilation errors.
+type UnsafeThresholdSignerServiceServer interface {
+	mustEmbedUnimplementedThresholdSignerServiceServer()
+}
+
+func RegisterThresholdSignerServiceServer(s grpc.ServiceRegistrar, srv ThresholdSignerServiceServer) {
+	s.RegisterService(&ThresholdSignerService_ServiceDesc, srv)
+}
+
+func _ThresholdSignerService_Info_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThresholdInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ThresholdSignerServiceServer).Info(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/signer.v1.ThresholdSignerService/Info",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ThresholdSignerServiceServer).Info(ctx, req.(*ThresholdInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ThresholdSignerService_Session_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ThresholdSignerServiceServer).Session(&thresholdSignerServiceSessionServer{stream})
+}
+
+type ThresholdSignerService_SessionServer interface {
+	Send(*ThresholdSessionResponse) error
+	Recv() (*ThresholdSessionRequest, error)
+	grpc.ServerStream
+}
+
+type thresholdSignerServiceSessionServer struct {
+	grpc.ServerStream
+}
+
+func (x *thresholdSignerServiceSessionServer) Send(m *ThresholdSessionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *thresholdSignerServiceSessionServer) Recv() (*ThresholdSessionRequest, error) {
+	m := new(ThresholdSessionRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ThresholdSignerService_ServiceDesc is the grpc.ServiceDesc for ThresholdSignerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ThresholdSignerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "signer.v1.ThresholdSignerService",
+	HandlerType: (*ThresholdSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Info",
+			Handler:    _ThresholdSignerService_Info_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Session",
+			Handler:       _ThresholdSignerService_Session_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "signer/v1/threshold.proto",
+}
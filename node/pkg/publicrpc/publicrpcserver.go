@@ -3,7 +3,9 @@ package publicrpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/certusone/wormhole/node/pkg/common"
 	"github.com/certusone/wormhole/node/pkg/db"
@@ -63,6 +65,60 @@ func (s *PublicrpcServer) GetLastHeartbeats(ctx context.Context, req *publicrpcv
 	return resp, nil
 }
 
+// defaultUptimeWindow is the window used by GetGuardianUptime if none is requested.
+const defaultUptimeWindow = time.Hour
+
+func (s *PublicrpcServer) GetGuardianUptime(ctx context.Context, req *publicrpcv1.GetGuardianUptimeRequest) (*publicrpcv1.GetGuardianUptimeResponse, error) {
+	gs := s.gst.Get()
+	if gs == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not fetched from chain yet")
+	}
+
+	window := defaultUptimeWindow
+	if req.WindowSeconds != 0 {
+		if req.WindowSeconds > uint64(common.MaxHeartbeatHistoryAge/time.Second) {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("window may not be longer than %s", common.MaxHeartbeatHistoryAge))
+		}
+		window = time.Duration(req.WindowSeconds) * time.Second // #nosec G115 -- checked against MaxHeartbeatHistoryAge above
+	}
+
+	uptimes, observed := s.gst.History().Report(gs.Keys, window, time.Now())
+
+	resp := &publicrpcv1.GetGuardianUptimeResponse{
+		WindowSeconds: uint64(observed / time.Second), // #nosec G115 -- the duration is never negative
+		Entries:       make([]*publicrpcv1.GetGuardianUptimeResponse_Entry, 0, len(uptimes)),
+	}
+
+	for _, u := range uptimes {
+		entry := &publicrpcv1.GetGuardianUptimeResponse_Entry{
+			VerifiedGuardianAddr: u.Guardian.Hex(),
+			NodeName:             u.NodeName,
+			Version:              u.Version,
+			Uptime:               u.Uptime,
+			RestartCount:         uint32(u.Restarts), // #nosec G115 -- bounded by the number of samples in the history
+		}
+		for _, vc := range u.VersionChanges {
+			entry.VersionChanges = append(entry.VersionChanges, &publicrpcv1.GetGuardianUptimeResponse_VersionChange{
+				Timestamp:       vc.Time.Unix(),
+				P2PNodeAddr:     vc.PeerID.String(),
+				PreviousVersion: vc.PreviousVersion,
+				Version:         vc.Version,
+			})
+		}
+		for _, cl := range u.ChainLags {
+			entry.ChainLags = append(entry.ChainLags, &publicrpcv1.GetGuardianUptimeResponse_ChainLag{
+				ChainId: uint32(cl.ChainID),
+				Height:  cl.Height,
+				MaxLag:  cl.MaxLag,
+				AvgLag:  cl.AvgLag,
+			})
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	return resp, nil
+}
+
 func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.GetSignedVAARequest) (*publicrpcv1.GetSignedVAAResponse, error) {
 	if req.MessageId == nil {
 		return nil, status.Error(codes.InvalidArgument, "no message ID specified")
@@ -105,6 +161,75 @@ func (s *PublicrpcServer) GetSignedVAA(ctx context.Context, req *publicrpcv1.Get
 	}, nil
 }
 
+func (s *PublicrpcServer) GetSignedVAAByDigest(ctx context.Context, req *publicrpcv1.GetSignedVAAByDigestRequest) (*publicrpcv1.GetSignedVAAByDigestResponse, error) {
+	digest, err := hex.DecodeString(req.Digest)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode digest: %v", err))
+	}
+	if len(digest) != 32 {
+		return nil, status.Error(codes.InvalidArgument, "digest must be 32 bytes")
+	}
+
+	b, err := s.db.GetSignedVAABytesByDigest(digest)
+	if err != nil {
+		return nil, s.vaaIndexError(err, req)
+	}
+
+	return &publicrpcv1.GetSignedVAAByDigestResponse{
+		VaaBytes: b,
+	}, nil
+}
+
+func (s *PublicrpcServer) GetSignedVAAsByTxHash(ctx context.Context, req *publicrpcv1.GetSignedVAAsByTxHashRequest) (*publicrpcv1.GetSignedVAAsByTxHashResponse, error) {
+	txHash, err := hex.DecodeString(req.TxHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode tx hash: %v", err))
+	}
+	if len(txHash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no tx hash specified")
+	}
+
+	vaas, err := s.db.GetSignedVAABytesByTxHash(txHash)
+	if err != nil {
+		return nil, s.vaaIndexError(err, req)
+	}
+
+	return &publicrpcv1.GetSignedVAAsByTxHashResponse{
+		VaaBytes: vaas,
+	}, nil
+}
+
+func (s *PublicrpcServer) GetSignedVAAsByTimeRange(ctx context.Context, req *publicrpcv1.GetSignedVAAsByTimeRangeRequest) (*publicrpcv1.GetSignedVAAsByTimeRangeResponse, error) {
+	if req.EndTime <= req.StartTime {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+
+	vaas, nextPageToken, err := s.db.GetSignedVAABytesByTimeRange(time.Unix(int64(req.StartTime), 0), time.Unix(int64(req.EndTime), 0), int(req.Limit), req.PageToken)
+	if err != nil {
+		return nil, s.vaaIndexError(err, req)
+	}
+
+	return &publicrpcv1.GetSignedVAAsByTimeRangeResponse{
+		VaaBytes:      vaas,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// vaaIndexError maps an error returned by a VAA index lookup to a gRPC status.
+func (s *PublicrpcServer) vaaIndexError(err error, req interface{}) error {
+	switch {
+	case errors.Is(err, db.ErrVAANotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, db.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, db.ErrVAAIndexesDisabled):
+		return status.Error(codes.Unimplemented, "VAA indexes are not enabled on this node")
+	default:
+		s.logger.Error("failed to fetch VAA from index", zap.Error(err), zap.Any("request", req))
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
+
 func (s *PublicrpcServer) GetCurrentGuardianSet(ctx context.Context, req *publicrpcv1.GetCurrentGuardianSetRequest) (*publicrpcv1.GetCurrentGuardianSetResponse, error) {
 	gs := s.gst.Get()
 	if gs == nil {
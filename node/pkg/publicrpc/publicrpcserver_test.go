@@ -5,9 +5,14 @@ import (
 	"testing"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/governor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/certusone/wormhole/node/pkg/proto/publicrpc/v1"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -81,3 +86,78 @@ func TestGovernorIsVAAEnqueuedNoMessage(t *testing.T) {
 		assert.Equal(t, expected_err, err)
 	})
 }
+
+func TestGetSignedVAAByDigestBadDigest(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewProduction()
+	server := &PublicrpcServer{logger: logger}
+
+	resp, err := server.GetSignedVAAByDigest(ctx, &publicrpcv1.GetSignedVAAByDigestRequest{Digest: "AAAA"})
+	assert.Nil(t, resp)
+
+	expected_err := status.Error(codes.InvalidArgument, "digest must be 32 bytes")
+	assert.Equal(t, expected_err, err)
+}
+
+func TestGetSignedVAAsByTimeRangeInvalidRange(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewProduction()
+	server := &PublicrpcServer{logger: logger}
+
+	resp, err := server.GetSignedVAAsByTimeRange(ctx, &publicrpcv1.GetSignedVAAsByTimeRangeRequest{StartTime: 100, EndTime: 100})
+	assert.Nil(t, resp)
+
+	expected_err := status.Error(codes.InvalidArgument, "end time must be after start time")
+	assert.Equal(t, expected_err, err)
+}
+
+func TestGetSignedVAAsByTxHashIndexesDisabled(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewProduction()
+	dbPath := t.TempDir()
+	database := db.OpenDb(logger, &dbPath)
+	defer database.Close()
+	server := &PublicrpcServer{logger: logger, db: database}
+
+	resp, err := server.GetSignedVAAsByTxHash(ctx, &publicrpcv1.GetSignedVAAsByTxHashRequest{TxHash: "0102"})
+	assert.Nil(t, resp)
+
+	expected_err := status.Error(codes.Unimplemented, "VAA indexes are not enabled on this node")
+	assert.Equal(t, expected_err, err)
+}
+
+func TestGetGuardianUptime(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := zap.NewProduction()
+	gst := common.NewGuardianSetState(nil)
+	server := &PublicrpcServer{logger: logger, gst: gst}
+
+	resp, err := server.GetGuardianUptime(ctx, &publicrpcv1.GetGuardianUptimeRequest{})
+	assert.Nil(t, resp)
+	assert.Equal(t, status.Error(codes.Unavailable, "guardian set not fetched from chain yet"), err)
+
+	guardianAddr := ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	gst.Set(common.NewGuardianSet([]ethcommon.Address{guardianAddr}, 0))
+	require.NoError(t, gst.SetHeartbeat(guardianAddr, "peer-1", &gossipv1.Heartbeat{
+		NodeName: "guardian-0",
+		Version:  "v1",
+		Networks: []*gossipv1.Heartbeat_Network{{Id: uint32(vaa.ChainIDEthereum), Height: 100}},
+	}))
+
+	resp, err = server.GetGuardianUptime(ctx, &publicrpcv1.GetGuardianUptimeRequest{WindowSeconds: 60})
+	require.NoError(t, err)
+	assert.LessOrEqual(t, resp.WindowSeconds, uint64(60))
+	require.Len(t, resp.Entries, 1)
+	entry := resp.Entries[0]
+	assert.Equal(t, guardianAddr.Hex(), entry.VerifiedGuardianAddr)
+	assert.Equal(t, "guardian-0", entry.NodeName)
+	assert.Equal(t, "v1", entry.Version)
+	assert.Greater(t, entry.Uptime, 0.0)
+	require.Len(t, entry.ChainLags, 1)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), entry.ChainLags[0].ChainId)
+	assert.Equal(t, int64(100), entry.ChainLags[0].Height)
+
+	resp, err = server.GetGuardianUptime(ctx, &publicrpcv1.GetGuardianUptimeRequest{WindowSeconds: 2 * 24 * 3600})
+	assert.Nil(t, resp)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "window may not be longer than 24h0m0s"), err)
+}
@@ -17,6 +17,9 @@ var (
 	NoPanic  = false
 	mu       = sync.Mutex{}
 	registry = map[string]bool{}
+
+	// warnings are conditions an operator should look into, which do not affect the readiness state.
+	warnings = map[string]string{}
 )
 
 type Component string
@@ -43,6 +46,32 @@ func SetReady(component Component) {
 	}
 }
 
+// SetWarning raises a warning for the given component, replacing any previous warning. Warnings are listed by the
+// readiness check but, unlike the component states, don't make it fail.
+func SetWarning(component Component, warning string) {
+	mu.Lock()
+	defer mu.Unlock()
+	warnings[string(component)] = warning
+}
+
+// ClearWarning clears the warning of the given component, if any.
+func ClearWarning(component Component) {
+	mu.Lock()
+	defer mu.Unlock()
+	delete(warnings, string(component))
+}
+
+// Warnings returns the currently raised warnings by component.
+func Warnings() map[Component]string {
+	mu.Lock()
+	defer mu.Unlock()
+	ret := make(map[Component]string, len(warnings))
+	for k, v := range warnings {
+		ret[Component(k)] = v
+	}
+	return ret
+}
+
 // Handler returns a net/http handler for the readiness check. It returns 200 OK if all components are ready,
 // or 412 Precondition Failed otherwise. For operator convenience, a list of components and their states
 // is returned as plain text (not meant for machine consumption!).
@@ -72,6 +101,19 @@ func Handler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if len(warnings) != 0 {
+		_, err = resp.Write([]byte("\n[warnings - these do not affect readiness]\n\n"))
+		if err != nil {
+			panic(err)
+		}
+		for k, v := range warnings {
+			_, err = fmt.Fprintf(resp, "%s\t%s\n", k, v)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	if !ready {
 		w.WriteHeader(http.StatusPreconditionFailed)
 	} else {
@@ -0,0 +1,523 @@
+// Package recovery implements a background service that finds gaps in the sequences of the VAAs stored for the known
+// emitters and fetches the missing VAAs from the public RPC of peer guardians. It automates what FindMissingMessages
+// and hack/findmissing do manually after a guardian was down.
+//
+// Observation requests are by transaction, and neither the database nor the peers know the transaction of a missing
+// VAA. A reobservation of a gap no peer has is therefore only requested if the processor still holds our own
+// observation of the message, i.e. it was observed but did not reach quorum. Messages this guardian never observed,
+// e.g. because it was down, and that no peer has are reported as unrecoverable and need to be reobserved manually.
+package recovery
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	"github.com/certusone/wormhole/node/pkg/supervisor"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/wormhole-foundation/wormhole/sdk"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultInterval is how often the known emitters are scanned for gaps by default.
+	DefaultInterval = time.Hour
+	// DefaultMaxPerInterval is the default number of missing messages handled per scan.
+	DefaultMaxPerInterval = 100
+	// DefaultRequestsPerSecond is the default rate of requests to the peer guardians.
+	DefaultRequestsPerSecond = 2.0
+	// DefaultLookback is the default number of sequences, counted back from the latest stored one, in which gaps are
+	// recovered. Older gaps are most likely VAAs deleted by the retention policy or never stored by this guardian.
+	DefaultLookback = 1000
+
+	// fetchTimeout bounds a single request to a peer guardian.
+	fetchTimeout = 5 * time.Second
+	// inspectTimeout bounds the query of the processor's aggregation state.
+	inspectTimeout = 5 * time.Second
+	// reobservationCooldown is the minimum time between two reobservation requests for the same message.
+	reobservationCooldown = 6 * time.Hour
+	// maxSkippedScans bounds the backoff of messages that could not be recovered, in number of scans.
+	maxSkippedScans = 24
+)
+
+var (
+	recoveryScansTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_recovery_scans_total",
+			Help: "Total number of scans of the known emitters for missing messages",
+		})
+	recoveryMissingMessages = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_recovery_missing_messages",
+			Help: "Number of missing messages found by the latest scan, grouped by emitter chain",
+		}, []string{"emitter_chain"})
+	recoveryOutcomesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_recovery_outcomes_total",
+			Help: "Total number of missing messages handled, grouped by outcome (fetched, reobserved, pending, unrecoverable)",
+		}, []string{"outcome"})
+	recoveryFetchErrorsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_recovery_fetch_errors_total",
+			Help: "Total number of failed requests to peer guardians, grouped by reason",
+		}, []string{"reason"})
+)
+
+const (
+	outcomeFetched       = "fetched"
+	outcomeReobserved    = "reobserved"
+	outcomePending       = "pending"
+	outcomeUnrecoverable = "unrecoverable"
+)
+
+// GapFinder is the subset of the database used to find missing messages.
+type GapFinder interface {
+	FindEmitterSequenceGap(prefix db.VAAID) (resp []uint64, firstSeq uint64, lastSeq uint64, err error)
+}
+
+// Config configures the recovery service.
+type Config struct {
+	// Interval is how often the known emitters are scanned for gaps.
+	Interval time.Duration
+	// Peers are the public RPC endpoints of the guardians the missing VAAs are fetched from.
+	Peers []string
+	// MaxPerInterval bounds the number of missing messages handled per scan. The remaining ones are handled by the
+	// following scans.
+	MaxPerInterval int
+	// RequestsPerSecond limits the rate of requests to the peer guardians.
+	RequestsPerSecond float64
+	// Lookback is the number of sequences, counted back from the latest stored one, in which gaps are recovered.
+	Lookback uint64
+}
+
+// DefaultConfig returns the default configuration, using the public RPC endpoints of the SDK as peers.
+func DefaultConfig() Config {
+	return Config{
+		Interval:          DefaultInterval,
+		Peers:             sdk.PublicRPCEndpoints,
+		MaxPerInterval:    DefaultMaxPerInterval,
+		RequestsPerSecond: DefaultRequestsPerSecond,
+		Lookback:          DefaultLookback,
+	}
+}
+
+// Service periodically recovers the messages missing from the database.
+type Service struct {
+	logger       *zap.Logger
+	db           GapFinder
+	gst          *common.GuardianSetState
+	cfg          Config
+	emitters     []db.VAAID
+	client       *http.Client
+	limiter      *rate.Limiter
+	signedInC    chan<- *gossipv1.SignedVAAWithQuorum
+	obsvReqSendC chan<- *gossipv1.ObservationRequest
+	inspectC     chan<- *processor.InspectRequest
+
+	// reobserved is when a reobservation request was last sent for a message ID.
+	reobserved map[string]time.Time
+	// unrecoverable tracks the backoff of the message IDs that could not be recovered, so that they don't take up the
+	// MaxPerInterval budget of every scan.
+	unrecoverable map[string]backoff
+}
+
+// backoff is the number of failed attempts to recover a message, and the number of scans left to skip it for.
+type backoff struct {
+	attempts     int
+	skippedScans int
+}
+
+// NewService creates the recovery service for the known emitters of the environment. Fetched VAAs are verified
+// against the current guardian set and then injected into signedInC, where the processor stores them.
+func NewService(
+	logger *zap.Logger,
+	gapFinder GapFinder,
+	gst *common.GuardianSetState,
+	env common.Environment,
+	cfg Config,
+	signedInC chan<- *gossipv1.SignedVAAWithQuorum,
+	obsvReqSendC chan<- *gossipv1.ObservationRequest,
+	inspectC chan<- *processor.InspectRequest,
+) (*Service, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("interval must be positive")
+	}
+	if cfg.MaxPerInterval <= 0 {
+		return nil, errors.New("max per interval must be positive")
+	}
+	if cfg.RequestsPerSecond <= 0 {
+		return nil, errors.New("requests per second must be positive")
+	}
+	if cfg.Lookback == 0 {
+		return nil, errors.New("lookback must be positive")
+	}
+
+	emitters, err := knownEmitters(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		logger:       logger.With(zap.String("component", "recovery")),
+		db:           gapFinder,
+		gst:          gst,
+		cfg:          cfg,
+		emitters:     emitters,
+		client:       &http.Client{},
+		limiter:      rate.NewLimiter(rate.Limit(cfg.RequestsPerSecond), 1),
+		signedInC:    signedInC,
+		obsvReqSendC: obsvReqSendC,
+		inspectC:     inspectC,
+		reobserved:   make(map[string]time.Time),
+
+		unrecoverable: make(map[string]backoff),
+	}, nil
+}
+
+// knownEmitters returns the known emitters of the environment.
+func knownEmitters(env common.Environment) ([]db.VAAID, error) {
+	infos := sdk.KnownEmitters
+	if env == common.TestNet {
+		infos = sdk.KnownTestnetEmitters
+	} else if env == common.UnsafeDevNet || env == common.GoTest || env == common.AccountantMock {
+		infos = sdk.KnownDevnetEmitters
+	}
+
+	emitters := make([]db.VAAID, 0, len(infos))
+	for _, info := range infos {
+		addr, err := vaa.StringToAddress(info.Emitter)
+		if err != nil {
+			return nil, fmt.Errorf("invalid known emitter %s on chain %s: %w", info.Emitter, info.ChainID, err)
+		}
+		emitters = append(emitters, db.VAAID{EmitterChain: info.ChainID, EmitterAddress: addr})
+	}
+	return emitters, nil
+}
+
+// Run scans for missing messages every interval until the context is canceled.
+func (s *Service) Run(ctx context.Context) error {
+	supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.Scan(ctx)
+		}
+	}
+}
+
+// missingMessage identifies a gap in the sequences of an emitter.
+type missingMessage struct {
+	emitter  db.VAAID
+	sequence uint64
+}
+
+func (m missingMessage) MessageID() string {
+	return fmt.Sprintf("%d/%s/%d", m.emitter.EmitterChain, m.emitter.EmitterAddress, m.sequence)
+}
+
+// Scan looks for missing messages of the known emitters and tries to recover up to MaxPerInterval of them. Messages
+// with a pending reobservation request and messages that could not be recovered recently are skipped.
+func (s *Service) Scan(ctx context.Context) {
+	recoveryScansTotal.Inc()
+	start := time.Now()
+
+	missingByChain := make(map[vaa.ChainID]int)
+	missing := []missingMessage{}
+	for _, emitter := range s.emitters {
+		gaps, _, lastSeq, err := s.db.FindEmitterSequenceGap(emitter)
+		if err != nil {
+			s.logger.Error("failed to find sequence gaps",
+				zap.Stringer("emitter_chain", emitter.EmitterChain),
+				zap.Stringer("emitter_address", emitter.EmitterAddress),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		var minSeq uint64
+		if lastSeq >= s.cfg.Lookback {
+			minSeq = lastSeq - s.cfg.Lookback + 1
+		}
+		for _, seq := range gaps {
+			if seq < minSeq {
+				continue
+			}
+			missingByChain[emitter.EmitterChain]++
+			missing = append(missing, missingMessage{emitter: emitter, sequence: seq})
+		}
+	}
+
+	for _, emitter := range s.emitters {
+		recoveryMissingMessages.WithLabelValues(emitter.EmitterChain.String()).Set(float64(missingByChain[emitter.EmitterChain]))
+	}
+
+	// Forget reobservation requests that are past the cooldown.
+	for id, t := range s.reobserved {
+		if start.Sub(t) > reobservationCooldown {
+			delete(s.reobserved, id)
+		}
+	}
+
+	// Skip the messages whose reobservation is pending or that are backing off before applying MaxPerInterval, so that
+	// messages nobody can recover don't keep other emitters from being recovered.
+	numPending, numBackingOff := 0, 0
+	stillMissing := make(map[string]struct{}, len(missing))
+	candidates := make([]missingMessage, 0, len(missing))
+	for _, m := range missing {
+		msgID := m.MessageID()
+		stillMissing[msgID] = struct{}{}
+
+		// Don't request the reobservation of a message again while the previous request may still succeed.
+		if _, ok := s.reobserved[msgID]; ok {
+			numPending++
+			recoveryOutcomesTotal.WithLabelValues(outcomePending).Inc()
+			continue
+		}
+
+		if b, ok := s.unrecoverable[msgID]; ok && b.skippedScans > 0 {
+			b.skippedScans--
+			s.unrecoverable[msgID] = b
+			numBackingOff++
+			continue
+		}
+
+		candidates = append(candidates, m)
+	}
+
+	// Forget the backoff of messages that were recovered in the meantime or fell out of the lookback.
+	for id := range s.unrecoverable {
+		if _, ok := stillMissing[id]; !ok {
+			delete(s.unrecoverable, id)
+		}
+	}
+
+	if len(candidates) > s.cfg.MaxPerInterval {
+		s.logger.Info("more missing messages than can be handled in one scan, the remaining ones are handled later",
+			zap.Int("numMissing", len(candidates)),
+			zap.Int("maxPerInterval", s.cfg.MaxPerInterval),
+		)
+		candidates = candidates[:s.cfg.MaxPerInterval]
+	}
+
+	numFetched, numReobserved, numUnrecoverable := 0, 0, 0
+	for _, m := range candidates {
+		if ctx.Err() != nil {
+			return
+		}
+
+		fetched, err := s.fetch(ctx, m)
+		if err != nil {
+			s.logger.Error("failed to recover missing message", zap.String("message_id", m.MessageID()), zap.Error(err))
+		}
+		if fetched {
+			numFetched++
+			recoveryOutcomesTotal.WithLabelValues(outcomeFetched).Inc()
+			delete(s.unrecoverable, m.MessageID())
+			continue
+		}
+
+		if s.reobserve(ctx, m) {
+			numReobserved++
+			recoveryOutcomesTotal.WithLabelValues(outcomeReobserved).Inc()
+			delete(s.unrecoverable, m.MessageID())
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+
+		numUnrecoverable++
+		recoveryOutcomesTotal.WithLabelValues(outcomeUnrecoverable).Inc()
+		s.backOff(m.MessageID())
+	}
+
+	s.logger.Info("scanned for missing messages",
+		zap.Int("numHandled", len(candidates)),
+		zap.Int("numFetched", numFetched),
+		zap.Int("numReobserved", numReobserved),
+		zap.Int("numPending", numPending),
+		zap.Int("numUnrecoverable", numUnrecoverable),
+		zap.Int("numBackingOff", numBackingOff),
+		zap.Duration("duration", time.Since(start)),
+	)
+}
+
+// backOff records a failed attempt to recover a message. The message is skipped for a number of scans that doubles
+// with every attempt, up to maxSkippedScans.
+func (s *Service) backOff(msgID string) {
+	b := s.unrecoverable[msgID]
+	b.attempts++
+	// The shift is bounded so that it can't overflow; 1<<5 is already beyond maxSkippedScans.
+	b.skippedScans = min(1<<min(b.attempts-1, 5), maxSkippedScans)
+	s.unrecoverable[msgID] = b
+}
+
+// fetch tries to get the missing VAA from the peers, in random order. It returns true if a valid VAA was injected.
+func (s *Service) fetch(ctx context.Context, m missingMessage) (bool, error) {
+	peers := make([]string, len(s.cfg.Peers))
+	copy(peers, s.cfg.Peers)
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+
+	for _, peer := range peers {
+		if err := s.limiter.Wait(ctx); err != nil {
+			return false, err
+		}
+
+		vaaBytes, err := s.fetchFromPeer(ctx, peer, m)
+		if err != nil {
+			recoveryFetchErrorsTotal.WithLabelValues("request").Inc()
+			s.logger.Warn("failed to fetch missing VAA",
+				zap.String("peer", peer),
+				zap.String("message_id", m.MessageID()),
+				zap.Error(err),
+			)
+			continue
+		}
+		if vaaBytes == nil {
+			continue
+		}
+
+		if err := s.verify(vaaBytes, m); err != nil {
+			// SECURITY: a peer returned a VAA that is not the one we asked for or isn't signed by the guardian set.
+			recoveryFetchErrorsTotal.WithLabelValues("invalid").Inc()
+			s.logger.Warn("peer returned an invalid VAA",
+				zap.String("peer", peer),
+				zap.String("message_id", m.MessageID()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Inject into the gossip signed VAA receive path, which verifies it again and stores it.
+		select {
+		case s.signedInC <- &gossipv1.SignedVAAWithQuorum{Vaa: vaaBytes}:
+		case <-ctx.Done():
+			return false, ctx.Err()
+		}
+
+		s.logger.Info("recovered missing VAA", zap.String("peer", peer), zap.String("message_id", m.MessageID()))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// fetchFromPeer requests the missing VAA from the public RPC of a peer. It returns nil if the peer doesn't have it.
+func (s *Service) fetchFromPeer(ctx context.Context, peer string, m missingMessage) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf(
+		"%s/v1/signed_vaa/%d/%s/%d", peer, m.emitter.EmitterChain, m.emitter.EmitterAddress, m.sequence), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return nil, nil
+	case http.StatusOK:
+		var respBody struct {
+			VaaBytes string `json:"vaaBytes"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&respBody); err != nil {
+			return nil, fmt.Errorf("failed to decode response: %w", err)
+		}
+		vaaBytes, err := base64.StdEncoding.DecodeString(respBody.VaaBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode VAA: %w", err)
+		}
+		return vaaBytes, nil
+	default:
+		return nil, fmt.Errorf("unexpected response status: %d", resp.StatusCode)
+	}
+}
+
+// verify checks that the VAA is the missing message and is signed by a quorum of the current guardian set.
+func (s *Service) verify(vaaBytes []byte, m missingMessage) error {
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+
+	if v.EmitterChain != m.emitter.EmitterChain || v.EmitterAddress != m.emitter.EmitterAddress || v.Sequence != m.sequence {
+		return fmt.Errorf("VAA has message ID %s", v.MessageID())
+	}
+
+	gs := s.gst.Get()
+	if gs == nil {
+		return errors.New("guardian set is not initialized")
+	}
+	if v.GuardianSetIndex != gs.Index {
+		return fmt.Errorf("VAA is signed by guardian set %d, the current one is %d", v.GuardianSetIndex, gs.Index)
+	}
+
+	return v.Verify(gs.Keys)
+}
+
+// reobserve requests a reobservation of a message no peer has. Observation requests are by transaction, so this is
+// only possible if the processor still knows the transaction hash of our own observation, which is not the case for
+// messages that were published while this guardian was down. It returns true if a request was sent.
+func (s *Service) reobserve(ctx context.Context, m missingMessage) bool {
+	msgID := m.MessageID()
+	ctx, cancel := context.WithTimeout(ctx, inspectTimeout)
+	defer cancel()
+
+	infos, err := processor.Inspect(ctx, s.inspectC, msgID, "")
+	if err != nil {
+		s.logger.Warn("failed to query the processor for a missing message", zap.String("message_id", msgID), zap.Error(err))
+		return false
+	}
+
+	for _, info := range infos {
+		if len(info.TxHash) == 0 {
+			continue
+		}
+
+		req := &gossipv1.ObservationRequest{
+			ChainId: uint32(m.emitter.EmitterChain),
+			TxHash:  info.TxHash,
+		}
+		if err := common.PostObservationRequest(s.obsvReqSendC, req); err != nil {
+			s.logger.Warn("failed to send reobservation request", zap.String("message_id", msgID), zap.Error(err))
+			return false
+		}
+
+		s.reobserved[msgID] = time.Now()
+		s.logger.Info("requested reobservation of missing message",
+			zap.String("message_id", msgID),
+			zap.String("tx_hash", hex.EncodeToString(info.TxHash)),
+		)
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,268 @@
+package recovery
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/certusone/wormhole/node/pkg/processor"
+	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+	"go.uber.org/zap"
+)
+
+type mockGapFinder struct {
+	gaps map[db.VAAID][]uint64
+	last map[db.VAAID]uint64
+}
+
+func (m *mockGapFinder) FindEmitterSequenceGap(prefix db.VAAID) ([]uint64, uint64, uint64, error) {
+	return m.gaps[prefix], 0, m.last[prefix], nil
+}
+
+func signedVAA(t *testing.T, key *ecdsa.PrivateKey, emitter db.VAAID, seq uint64) []byte {
+	t.Helper()
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        time.Unix(1700000000, 0),
+		Nonce:            1,
+		Sequence:         seq,
+		EmitterChain:     emitter.EmitterChain,
+		EmitterAddress:   emitter.EmitterAddress,
+		Payload:          []byte("payload"),
+	}
+	v.AddSignature(key, 0)
+	b, err := v.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+// newPeer serves the VAAs in vaas, keyed by "chain/emitter/sequence", like the public RPC of a guardian.
+func newPeer(t *testing.T, vaas map[string][]byte) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var chain uint16
+		var emitter string
+		var seq uint64
+		if _, err := fmt.Sscanf(r.URL.Path, "/v1/signed_vaa/%d/%64s/%d", &chain, &emitter, &seq); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		b, ok := vaas[fmt.Sprintf("%d/%s/%d", chain, emitter, seq)]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"vaaBytes": base64.StdEncoding.EncodeToString(b)})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestService(t *testing.T, gapFinder GapFinder, peers []string) (*Service, chan *gossipv1.SignedVAAWithQuorum, chan *gossipv1.ObservationRequest, chan *processor.InspectRequest, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(common.NewGuardianSet([]ethCommon.Address{crypto.PubkeyToAddress(key.PublicKey)}, 0))
+
+	signedInC := make(chan *gossipv1.SignedVAAWithQuorum, 10)
+	obsvReqSendC := make(chan *gossipv1.ObservationRequest, 10)
+	inspectC := make(chan *processor.InspectRequest, 10)
+
+	cfg := DefaultConfig()
+	cfg.Peers = peers
+	cfg.RequestsPerSecond = 1000
+	cfg.Lookback = 10
+
+	s, err := NewService(zap.NewNop(), gapFinder, gst, common.GoTest, cfg, signedInC, obsvReqSendC, inspectC)
+	require.NoError(t, err)
+	return s, signedInC, obsvReqSendC, inspectC, key
+}
+
+func testEmitter(t *testing.T) db.VAAID {
+	t.Helper()
+	emitters, err := knownEmitters(common.GoTest)
+	require.NoError(t, err)
+	require.NotEmpty(t, emitters)
+	return emitters[0]
+}
+
+func TestScanFetchesMissingVAAs(t *testing.T) {
+	emitter := testEmitter(t)
+	// Sequence 3 is outside of the lookback.
+	gapFinder := &mockGapFinder{
+		gaps: map[db.VAAID][]uint64{emitter: {3, 15, 16}},
+		last: map[db.VAAID]uint64{emitter: 20},
+	}
+
+	// The first peer doesn't have anything, the second one returns a VAA for the wrong sequence for 16.
+	emptyPeer := newPeer(t, map[string][]byte{})
+	vaas := map[string][]byte{}
+	peer := newPeer(t, vaas)
+
+	s, signedInC, _, inspectC, key := newTestService(t, gapFinder, []string{emptyPeer.URL, peer.URL})
+	id := func(seq uint64) string {
+		return fmt.Sprintf("%d/%s/%d", emitter.EmitterChain, emitter.EmitterAddress, seq)
+	}
+	vaas[id(3)] = signedVAA(t, key, emitter, 3)
+	vaas[id(15)] = signedVAA(t, key, emitter, 15)
+	vaas[id(16)] = signedVAA(t, key, emitter, 17)
+
+	// Answer the processor queries for sequence 16 without an observation of our own.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-inspectC:
+				req.ResponseC <- []*processor.ObservationInfo{}
+			}
+		}
+	}()
+
+	s.Scan(ctx)
+
+	require.Len(t, signedInC, 1)
+	m := <-signedInC
+	v, err := vaa.Unmarshal(m.Vaa)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), v.Sequence)
+}
+
+func TestFetchRejectsVAAsNotSignedByGuardianSet(t *testing.T) {
+	emitter := testEmitter(t)
+	gapFinder := &mockGapFinder{
+		gaps: map[db.VAAID][]uint64{emitter: {5}},
+		last: map[db.VAAID]uint64{emitter: 6},
+	}
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	peer := newPeer(t, map[string][]byte{
+		fmt.Sprintf("%d/%s/%d", emitter.EmitterChain, emitter.EmitterAddress, 5): signedVAA(t, otherKey, emitter, 5),
+	})
+
+	s, signedInC, _, _, _ := newTestService(t, gapFinder, []string{peer.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	fetched, err := s.fetch(ctx, missingMessage{emitter: emitter, sequence: 5})
+	require.NoError(t, err)
+	assert.False(t, fetched)
+	assert.Empty(t, signedInC)
+}
+
+func TestScanRequestsReobservation(t *testing.T) {
+	emitter := testEmitter(t)
+	gapFinder := &mockGapFinder{
+		gaps: map[db.VAAID][]uint64{emitter: {7}},
+		last: map[db.VAAID]uint64{emitter: 8},
+	}
+	peer := newPeer(t, map[string][]byte{})
+
+	s, signedInC, obsvReqSendC, inspectC, _ := newTestService(t, gapFinder, []string{peer.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	msgID := fmt.Sprintf("%d/%s/%d", emitter.EmitterChain, emitter.EmitterAddress, 7)
+	txHash := []byte{0x01, 0x02, 0x03}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-inspectC:
+				assert.Equal(t, msgID, req.MessageID)
+				req.ResponseC <- []*processor.ObservationInfo{{MessageID: msgID, TxHash: txHash}}
+			}
+		}
+	}()
+
+	s.Scan(ctx)
+	assert.Empty(t, signedInC)
+	require.Len(t, obsvReqSendC, 1)
+	req := <-obsvReqSendC
+	assert.Equal(t, uint32(emitter.EmitterChain), req.ChainId)
+	assert.Equal(t, txHash, req.TxHash)
+
+	// The reobservation isn't requested again while it is pending.
+	s.Scan(ctx)
+	assert.Empty(t, obsvReqSendC)
+}
+
+func TestScanSkipsUnrecoverableMessages(t *testing.T) {
+	emitters, err := knownEmitters(common.GoTest)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(emitters), 2)
+
+	// The first emitter has more gaps than can be handled in one scan, and nobody has them.
+	gapFinder := &mockGapFinder{
+		gaps: map[db.VAAID][]uint64{emitters[0]: {1, 2, 3, 4, 5, 6}, emitters[1]: {1}},
+		last: map[db.VAAID]uint64{emitters[0]: 10, emitters[1]: 10},
+	}
+	vaas := map[string][]byte{}
+	peer := newPeer(t, vaas)
+	s, signedInC, obsvReqSendC, inspectC, key := newTestService(t, gapFinder, []string{peer.URL})
+	s.cfg.MaxPerInterval = 5
+	vaas[fmt.Sprintf("%d/%s/%d", emitters[1].EmitterChain, emitters[1].EmitterAddress, 1)] = signedVAA(t, key, emitters[1], 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case req := <-inspectC:
+				req.ResponseC <- []*processor.ObservationInfo{}
+			}
+		}
+	}()
+
+	// The first scan only gets to the gaps of the first emitter.
+	s.Scan(ctx)
+	assert.Empty(t, signedInC)
+	assert.Len(t, s.unrecoverable, 5)
+
+	// The unrecoverable gaps back off, so that the second emitter is recovered.
+	s.Scan(ctx)
+	require.Len(t, signedInC, 1)
+	v, err := vaa.Unmarshal((<-signedInC).Vaa)
+	require.NoError(t, err)
+	assert.Equal(t, emitters[1].EmitterChain, v.EmitterChain)
+	assert.Len(t, s.unrecoverable, 6)
+	assert.Empty(t, obsvReqSendC)
+
+	// The backoff doubles with every failed attempt.
+	s.Scan(ctx)
+	msgID := fmt.Sprintf("%d/%s/%d", emitters[0].EmitterChain, emitters[0].EmitterAddress, 1)
+	assert.Equal(t, backoff{attempts: 2, skippedScans: 2}, s.unrecoverable[msgID])
+
+	// Gaps that were filled are forgotten.
+	gapFinder.gaps[emitters[0]] = nil
+	s.Scan(ctx)
+	assert.Empty(t, s.unrecoverable)
+}
+
+func TestNewServiceValidatesConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.Lookback = 0
+	_, err := NewService(zap.NewNop(), &mockGapFinder{}, common.NewGuardianSetState(nil), common.GoTest, cfg, nil, nil, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,281 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	solanaBackfilledSlots = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_backfilled_slots_total",
+			Help: "Total number of slots fetched by the Solana watcher to fill a gap since the last processed slot",
+		}, []string{"solana_network", "commitment"})
+	solanaSlotsBeyondLookback = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_slots_beyond_lookback_total",
+			Help: "Total number of missed slots that were not backfilled because they are older than the lookback",
+		}, []string{"solana_network", "commitment"})
+)
+
+const (
+	// DefaultBackfillLookback is the default maximum number of slots backfilled, which is roughly 20 minutes.
+	DefaultBackfillLookback = 3000
+
+	// maxSlotsPerTick is the largest number of slots fetched all at once. Larger gaps are backfilled with bounded concurrency.
+	maxSlotsPerTick = 50
+
+	// maxConcurrentBackfillFetches is how many blocks are fetched in parallel while backfilling.
+	maxConcurrentBackfillFetches = 10
+
+	// checkpointInterval is how often the last processed slot is written to the database.
+	checkpointInterval = 10 * time.Second
+)
+
+// slotTracker keeps track of the slots being fetched to determine the slot up to which all slots were processed.
+type slotTracker struct {
+	mu      sync.Mutex
+	pending map[uint64]struct{}
+	// failed are the pending slots that could not be fetched and need to be retried.
+	failed map[uint64]struct{}
+	// last is the highest slot added.
+	last uint64
+}
+
+func newSlotTracker() *slotTracker {
+	return &slotTracker{pending: make(map[uint64]struct{}), failed: make(map[uint64]struct{})}
+}
+
+// add marks the slots from..to, inclusive, as being fetched.
+func (t *slotTracker) add(from uint64, to uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for slot := from; slot <= to; slot++ {
+		t.pending[slot] = struct{}{}
+	}
+	if to > t.last {
+		t.last = to
+	}
+}
+
+// done marks a slot as processed.
+func (t *slotTracker) done(slot uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, slot)
+}
+
+// fail marks a slot that could not be fetched. It stays pending until it is retried successfully or given up on.
+func (t *slotTracker) fail(slot uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.failed[slot] = struct{}{}
+}
+
+// takeFailed returns the failed slots in ascending order, and clears them so that they are retried only once.
+func (t *slotTracker) takeFailed() []uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ret := make([]uint64, 0, len(t.failed))
+	for slot := range t.failed {
+		ret = append(ret, slot)
+	}
+	t.failed = make(map[uint64]struct{})
+	sort.Slice(ret, func(i, j int) bool { return ret[i] < ret[j] })
+	return ret
+}
+
+// checkpoint returns the highest slot up to which all slots were processed, or zero if no slot was added yet.
+func (t *slotTracker) checkpoint() uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	ret := t.last
+	for slot := range t.pending {
+		if slot-1 < ret {
+			ret = slot - 1
+		}
+	}
+	return ret
+}
+
+// backfillEnabled returns true if the watcher records the last processed slot and backfills from it.
+func (s *SolanaWatcher) backfillEnabled() bool {
+	return s.checkpointDB != nil && s.backfillLookback != 0
+}
+
+// initialLastSlot returns the slot to consider processed when the watcher starts and the current slot is the given
+// one. If a checkpoint was stored by a previous run, the slots since then are backfilled, bounded by the lookback.
+func (s *SolanaWatcher) initialLastSlot(logger *zap.Logger, slot uint64) uint64 {
+	if !s.backfillEnabled() {
+		return slot - 1
+	}
+
+	checkpoint, err := s.checkpointDB.GetWatcherCheckpoint(s.checkpointKey)
+	if err != nil {
+		if !errors.Is(err, db.ErrWatcherCheckpointNotFound) {
+			logger.Error("failed to read the last processed slot, not backfilling", zap.String("commitment", string(s.commitment)), zap.Error(err))
+		}
+		return slot - 1
+	}
+
+	if checkpoint >= slot {
+		return slot - 1
+	}
+
+	logger.Info("backfilling slots since the last processed slot",
+		zap.String("commitment", string(s.commitment)),
+		zap.Uint64("lastProcessedSlot", checkpoint),
+		zap.Uint64("slot", slot),
+	)
+	return checkpoint
+}
+
+// limitToLookback returns the last slot to consider processed so that no more than the lookback is backfilled.
+func (s *SolanaWatcher) limitToLookback(logger *zap.Logger, lastSlot uint64, slot uint64) uint64 {
+	if s.backfillLookback == 0 || slot-lastSlot <= s.backfillLookback {
+		return lastSlot
+	}
+
+	limited := slot - s.backfillLookback
+	logger.Warn("gap since the last processed slot exceeds the lookback, older slots need to be reobserved manually",
+		zap.String("commitment", string(s.commitment)),
+		zap.Uint64("lastProcessedSlot", lastSlot),
+		zap.Uint64("slot", slot),
+		zap.Uint64("lookback", s.backfillLookback),
+		zap.Uint64("firstSkippedSlot", lastSlot+1),
+		zap.Uint64("lastSkippedSlot", limited),
+	)
+	solanaSlotsBeyondLookback.WithLabelValues(s.networkName, string(s.commitment)).Add(float64(limited - lastSlot))
+	return limited
+}
+
+// fetchSlots fetches the slots from..to, inclusive. Up to maxSlotsPerTick slots are fetched all at once, larger
+// ranges are backfilled in the background with bounded concurrency.
+func (s *SolanaWatcher) fetchSlots(ctx context.Context, logger *zap.Logger, from uint64, to uint64) {
+	s.slots.add(from, to)
+
+	if to-from < maxSlotsPerTick {
+		for slot := from; slot <= to; slot++ {
+			_slot := slot
+			common.RunWithScissors(ctx, s.errC, "SolanaWatcherSlotFetcher", func(ctx context.Context) error {
+				s.fetchSlot(ctx, logger, _slot)
+				return nil
+			})
+		}
+		return
+	}
+
+	common.RunWithScissors(ctx, s.errC, "SolanaWatcherBackfill", func(ctx context.Context) error {
+		logger.Info("backfilling slots",
+			zap.String("commitment", string(s.commitment)),
+			zap.Uint64("from", from),
+			zap.Uint64("to", to),
+		)
+		start := time.Now()
+
+		sem := make(chan struct{}, maxConcurrentBackfillFetches)
+		var wg sync.WaitGroup
+		for slot := from; slot <= to; slot++ {
+			select {
+			case <-ctx.Done():
+				return nil
+			case sem <- struct{}{}:
+			}
+
+			_slot := slot
+			wg.Add(1)
+			common.RunWithScissors(ctx, s.errC, "SolanaWatcherBackfillFetcher", func(ctx context.Context) error {
+				defer wg.Done()
+				defer func() { <-sem }()
+				if s.fetchSlot(ctx, logger, _slot) {
+					solanaBackfilledSlots.WithLabelValues(s.networkName, string(s.commitment)).Inc()
+				}
+				return nil
+			})
+		}
+		wg.Wait()
+
+		logger.Info("finished backfilling slots",
+			zap.String("commitment", string(s.commitment)),
+			zap.Uint64("from", from),
+			zap.Uint64("to", to),
+			zap.Duration("took", time.Since(start)),
+		)
+		return nil
+	})
+}
+
+// fetchSlot fetches a slot and returns true if it was processed. With backfilling enabled, a slot that could not be
+// fetched stays pending, so that the checkpoint doesn't advance past it, and is retried by retryFailedSlots.
+func (s *SolanaWatcher) fetchSlot(ctx context.Context, logger *zap.Logger, slot uint64) bool {
+	ok := s.retryFetchBlock(ctx, logger, slot, false)
+	if ctx.Err() != nil {
+		return false
+	}
+	if !ok && s.backfillEnabled() {
+		s.slots.fail(slot)
+		return false
+	}
+	s.slots.done(slot)
+	return ok
+}
+
+// retryFailedSlots fetches the slots that could not be fetched before in the background. Failed slots that are more
+// than the lookback behind the current slot are given up on, so that they don't hold back the checkpoint forever.
+func (s *SolanaWatcher) retryFailedSlots(ctx context.Context, logger *zap.Logger, slot uint64) {
+	var retry []uint64
+	for _, failed := range s.slots.takeFailed() {
+		if failed >= slot || slot-failed < s.backfillLookback {
+			retry = append(retry, failed)
+			continue
+		}
+		logger.Error("giving up on slot that could not be fetched within the lookback, it needs to be reobserved manually",
+			zap.String("commitment", string(s.commitment)),
+			zap.Uint64("slot", failed),
+		)
+		solanaSlotsBeyondLookback.WithLabelValues(s.networkName, string(s.commitment)).Inc()
+		s.slots.done(failed)
+	}
+	if len(retry) == 0 {
+		return
+	}
+
+	logger.Info("retrying slots that could not be fetched",
+		zap.String("commitment", string(s.commitment)),
+		zap.Uint64s("slots", retry),
+	)
+	common.RunWithScissors(ctx, s.errC, "SolanaWatcherFailedSlotRetry", func(ctx context.Context) error {
+		for _, slot := range retry {
+			if s.fetchSlot(ctx, logger, slot) {
+				solanaBackfilledSlots.WithLabelValues(s.networkName, string(s.commitment)).Inc()
+			}
+			if ctx.Err() != nil {
+				return nil
+			}
+		}
+		return nil
+	})
+}
+
+// storeCheckpoint writes the slot up to which all slots were processed to the database, if it advanced.
+func (s *SolanaWatcher) storeCheckpoint(logger *zap.Logger) {
+	checkpoint := s.slots.checkpoint()
+	if checkpoint == 0 || checkpoint <= s.lastCheckpoint {
+		return
+	}
+
+	if err := s.checkpointDB.StoreWatcherCheckpoint(s.checkpointKey, checkpoint); err != nil {
+		logger.Error("failed to store the last processed slot", zap.String("commitment", string(s.commitment)), zap.Uint64("slot", checkpoint), zap.Error(err))
+		return
+	}
+	s.lastCheckpoint = checkpoint
+}
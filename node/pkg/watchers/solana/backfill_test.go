@@ -0,0 +1,166 @@
+package solana
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/certusone/wormhole/node/pkg/db"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type mockCheckpointDB struct {
+	checkpoints map[string]uint64
+	err         error
+}
+
+func (d *mockCheckpointDB) StoreWatcherCheckpoint(watcher string, height uint64) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.checkpoints[watcher] = height
+	return nil
+}
+
+func (d *mockCheckpointDB) GetWatcherCheckpoint(watcher string) (uint64, error) {
+	if d.err != nil {
+		return 0, d.err
+	}
+	height, exists := d.checkpoints[watcher]
+	if !exists {
+		return 0, db.ErrWatcherCheckpointNotFound
+	}
+	return height, nil
+}
+
+func newBackfillTestWatcher(checkpointDB db.WatcherCheckpointDB, lookback uint64) *SolanaWatcher {
+	return &SolanaWatcher{
+		networkName:      "solana",
+		commitment:       rpc.CommitmentFinalized,
+		checkpointDB:     checkpointDB,
+		checkpointKey:    "solana-finalized",
+		backfillLookback: lookback,
+		slots:            newSlotTracker(),
+	}
+}
+
+func TestSlotTracker(t *testing.T) {
+	tr := newSlotTracker()
+	assert.Equal(t, uint64(0), tr.checkpoint())
+
+	tr.add(100, 104)
+	assert.Equal(t, uint64(99), tr.checkpoint())
+
+	tr.done(100)
+	tr.done(101)
+	tr.done(103)
+	assert.Equal(t, uint64(101), tr.checkpoint())
+
+	tr.add(105, 105)
+	tr.done(102)
+	tr.done(105)
+	assert.Equal(t, uint64(103), tr.checkpoint())
+
+	tr.done(104)
+	assert.Equal(t, uint64(105), tr.checkpoint())
+
+	// Failed slots hold back the checkpoint until they are processed.
+	tr.add(106, 108)
+	tr.fail(108)
+	tr.fail(106)
+	tr.done(107)
+	assert.Equal(t, uint64(105), tr.checkpoint())
+	assert.Equal(t, []uint64{106, 108}, tr.takeFailed())
+	assert.Empty(t, tr.takeFailed())
+	assert.Equal(t, uint64(105), tr.checkpoint())
+
+	tr.done(106)
+	tr.done(108)
+	assert.Equal(t, uint64(108), tr.checkpoint())
+}
+
+func TestInitialLastSlot(t *testing.T) {
+	logger := zap.NewNop()
+	checkpointDB := &mockCheckpointDB{checkpoints: map[string]uint64{}}
+
+	// Without a checkpoint, we start at the current slot.
+	s := newBackfillTestWatcher(checkpointDB, 1000)
+	assert.Equal(t, uint64(4999), s.initialLastSlot(logger, 5000))
+
+	// With a checkpoint, we continue after it.
+	checkpointDB.checkpoints["solana-finalized"] = 4500
+	assert.Equal(t, uint64(4500), s.initialLastSlot(logger, 5000))
+
+	// A checkpoint ahead of the current slot, e.g. because of a lagging RPC node, is ignored.
+	checkpointDB.checkpoints["solana-finalized"] = 6000
+	assert.Equal(t, uint64(4999), s.initialLastSlot(logger, 5000))
+
+	// Disabled.
+	checkpointDB.checkpoints["solana-finalized"] = 4500
+	s = newBackfillTestWatcher(checkpointDB, 0)
+	assert.Equal(t, uint64(4999), s.initialLastSlot(logger, 5000))
+	s = newBackfillTestWatcher(nil, 1000)
+	assert.Equal(t, uint64(4999), s.initialLastSlot(logger, 5000))
+
+	// Errors reading the checkpoint don't prevent us from starting.
+	s = newBackfillTestWatcher(&mockCheckpointDB{err: errors.New("failed")}, 1000)
+	assert.Equal(t, uint64(4999), s.initialLastSlot(logger, 5000))
+}
+
+func TestLimitToLookback(t *testing.T) {
+	logger := zap.NewNop()
+
+	s := newBackfillTestWatcher(nil, 1000)
+	assert.Equal(t, uint64(4500), s.limitToLookback(logger, 4500, 5000))
+	assert.Equal(t, uint64(4000), s.limitToLookback(logger, 4000, 5000))
+	assert.Equal(t, uint64(4000), s.limitToLookback(logger, 100, 5000))
+
+	// Without a lookback the gap is not limited.
+	s = newBackfillTestWatcher(nil, 0)
+	assert.Equal(t, uint64(100), s.limitToLookback(logger, 100, 5000))
+}
+
+func TestRetryFailedSlotsGivesUpBeyondLookback(t *testing.T) {
+	s := newBackfillTestWatcher(&mockCheckpointDB{checkpoints: map[string]uint64{}}, 1000)
+	s.slots.add(100, 101)
+	s.slots.fail(100)
+	s.slots.done(101)
+
+	s.retryFailedSlots(context.Background(), zap.NewNop(), 1100)
+	assert.Equal(t, uint64(101), s.slots.checkpoint())
+	assert.Empty(t, s.slots.takeFailed())
+}
+
+func TestStoreCheckpoint(t *testing.T) {
+	logger := zap.NewNop()
+	checkpointDB := &mockCheckpointDB{checkpoints: map[string]uint64{}}
+	s := newBackfillTestWatcher(checkpointDB, 1000)
+
+	// Nothing is stored before the first slot is fetched.
+	s.storeCheckpoint(logger)
+	assert.Empty(t, checkpointDB.checkpoints)
+
+	s.slots.add(100, 102)
+	s.slots.done(100)
+	s.storeCheckpoint(logger)
+	assert.Equal(t, uint64(100), checkpointDB.checkpoints["solana-finalized"])
+	assert.Equal(t, uint64(100), s.lastCheckpoint)
+
+	s.slots.done(101)
+	s.slots.done(102)
+	s.storeCheckpoint(logger)
+	assert.Equal(t, uint64(102), checkpointDB.checkpoints["solana-finalized"])
+
+	// A failure to store is retried on the next call.
+	s.slots.add(103, 103)
+	s.slots.done(103)
+	checkpointDB.err = errors.New("failed")
+	s.storeCheckpoint(logger)
+	assert.Equal(t, uint64(102), s.lastCheckpoint)
+	checkpointDB.err = nil
+	s.storeCheckpoint(logger)
+	require.Equal(t, uint64(103), checkpointDB.checkpoints["solana-finalized"])
+}
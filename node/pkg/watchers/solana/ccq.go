@@ -151,7 +151,7 @@ func (w *SolanaWatcher) ccqBaseHandleSolanaAccountQueryRequest(
 	var numBlockReadAttempts int
 	for {
 		maxSupportedTransactionVersion := uint64(0)
-		block, err = w.rpcClient.GetBlockWithOpts(rCtx, info.Context.Slot, &rpc.GetBlockOpts{
+		block, err = w.rpcPool.client().GetBlockWithOpts(rCtx, info.Context.Slot, &rpc.GetBlockOpts{
 			Encoding:                       solana.EncodingBase64,
 			Commitment:                     params.Commitment,
 			TransactionDetails:             rpc.TransactionDetailsNone,
@@ -559,7 +559,7 @@ func (w *SolanaWatcher) getMultipleAccountsWithOpts(
 		}
 	}
 
-	err = w.rpcClient.RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
+	err = w.rpcPool.client().RPCCallForInto(ctx, &out, "getMultipleAccounts", params)
 	if err != nil {
 		return nil, err
 	}
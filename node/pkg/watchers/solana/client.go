@@ -14,6 +14,7 @@ import (
 	"encoding/json"
 
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	"github.com/certusone/wormhole/node/pkg/p2p"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
@@ -48,7 +49,8 @@ type (
 		obsvReqC    <-chan *gossipv1.ObservationRequest
 		errC        chan error
 		pumpData    chan []byte
-		rpcClient   *rpc.Client
+		// rpcPool holds the RPC endpoints, rpcUrl being the first one.
+		rpcPool *rpcPool
 		// Readiness component
 		readinessSync readiness.Component
 		// VAA ChainID of the network we're connecting to.
@@ -57,6 +59,16 @@ type (
 		networkName string
 		// The last slot processed by the watcher.
 		lastSlot uint64
+		// slots tracks the slots being fetched to determine the slot up to which all slots were processed.
+		slots *slotTracker
+
+		// checkpointDB stores the last processed slot under checkpointKey, so that the watcher can backfill the slots
+		// it missed, up to backfillLookback slots. Backfilling is disabled if checkpointDB is nil.
+		checkpointDB     db.WatcherCheckpointDB
+		checkpointKey    string
+		backfillLookback uint64
+		// lastCheckpoint is the last slot stored in checkpointDB.
+		lastCheckpoint uint64
 		// subscriber id
 		subId string
 
@@ -231,6 +243,7 @@ type PostMessageData struct {
 
 func NewSolanaWatcher(
 	rpcUrl string,
+	backupRpcUrls []string,
 	wsUrl *string,
 	contractAddress solana.PublicKey,
 	rawContract string,
@@ -242,6 +255,9 @@ func NewSolanaWatcher(
 	queryResponseC chan<- *query.PerChainQueryResponseInternal,
 	shimContractStr string,
 	shimContractAddr solana.PublicKey,
+	checkpointDB db.WatcherCheckpointDB,
+	checkpointKey string,
+	backfillLookback uint64,
 ) *SolanaWatcher {
 	msgObservedLogLevel := zapcore.InfoLevel
 	if chainID == vaa.ChainIDPythNet {
@@ -257,7 +273,7 @@ func NewSolanaWatcher(
 		msgC:                msgC,
 		obsvReqC:            obsvReqC,
 		commitment:          commitment,
-		rpcClient:           rpc.New(rpcUrl),
+		rpcPool:             newRPCPool(chainID.String(), commitment, append([]string{rpcUrl}, backupRpcUrls...)),
 		readinessSync:       common.MustConvertChainIdToReadinessSyncing(chainID),
 		chainID:             chainID,
 		networkName:         chainID.String(),
@@ -266,6 +282,9 @@ func NewSolanaWatcher(
 		ccqConfig:           query.GetPerChainConfig(chainID),
 		shimContractStr:     shimContractStr,
 		shimContractAddr:    shimContractAddr,
+		checkpointDB:        checkpointDB,
+		checkpointKey:       checkpointKey,
+		backfillLookback:    backfillLookback,
 	}
 }
 
@@ -357,6 +376,13 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 	if s.ccqLogger == nil {
 		s.ccqLogger = s.logger.With(zap.String("component", "ccqsol"))
 	}
+	s.rpcPool.logger = logger
+
+	// Fetches of the previous run were canceled, so start over from the slot up to which all slots were processed.
+	if s.slots != nil && s.lastSlot != 0 {
+		s.lastSlot = s.slots.checkpoint()
+	}
+	s.slots = newSlotTracker()
 
 	wsUrl := ""
 	if s.wsUrl != nil {
@@ -366,6 +392,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 	logger.Info("Starting watcher",
 		zap.String("watcher_name", "solana"),
 		zap.String("rpcUrl", s.rpcUrl),
+		zap.Int("numBackupRpcUrls", len(s.rpcPool.endpoints)-1),
 		zap.String("wsUrl", wsUrl),
 		zap.String("contract", contractAddr),
 		zap.String("rawContract", s.rawContract),
@@ -392,9 +419,16 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 		timer := time.NewTicker(time.Second * 1)
 		defer timer.Stop()
 
+		checkpointTimer := time.NewTicker(checkpointInterval)
+		defer checkpointTimer.Stop()
+
 		for {
 			select {
 			case <-ctx.Done():
+				// Slots that were being fetched stay pending, so the checkpoint doesn't skip them.
+				if s.backfillEnabled() {
+					s.storeCheckpoint(logger)
+				}
 				return nil
 			case msg := <-s.pumpData:
 				err := s.processAccountSubscriptionData(ctx, logger, msg, false)
@@ -405,7 +439,7 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 					return err
 				}
 			case m := <-s.obsvReqC:
-				numObservations, err := s.handleReobservationRequest(vaa.ChainID(m.ChainId), m.TxHash, s.rpcClient)
+				numObservations, err := s.handleReobservationRequest(vaa.ChainID(m.ChainId), m.TxHash, s.rpcPool.client())
 				if err != nil {
 					logger.Error("failed to process observation request",
 						zap.Uint32("chainID", m.ChainId),
@@ -419,12 +453,22 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 						zap.Uint32("numObservations", numObservations),
 					)
 				}
+			case <-checkpointTimer.C:
+				if s.backfillEnabled() {
+					s.retryFailedSlots(ctx, logger, s.lastSlot)
+					s.storeCheckpoint(logger)
+				}
 			case <-timer.C:
 				// Get current slot height
-				rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
 				start := time.Now()
-				slot, err := s.rpcClient.GetSlot(rCtx, s.commitment)
-				cancel()
+				var slot uint64
+				err := s.rpcPool.call(func(client *rpc.Client) error {
+					rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+					defer cancel()
+					var err error
+					slot, err = client.GetSlot(rCtx, s.commitment)
+					return err
+				})
 				queryLatency.WithLabelValues(s.networkName, "get_slot", string(s.commitment)).Observe(time.Since(start).Seconds())
 				if err != nil {
 					p2p.DefaultRegistry.AddErrorCount(s.chainID, 1)
@@ -435,7 +479,10 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 
 				lastSlot := s.lastSlot
 				if lastSlot == 0 {
-					lastSlot = slot - 1
+					lastSlot = s.initialLastSlot(logger, slot)
+				}
+				if slot > lastSlot {
+					lastSlot = s.limitToLookback(logger, lastSlot, slot)
 				}
 				currentSolanaHeight.WithLabelValues(s.networkName, string(s.commitment)).Set(float64(slot))
 				readiness.SetReady(s.readinessSync)
@@ -458,13 +505,8 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 							zap.Duration("took", time.Since(start)))
 					}
 
-					// Requesting each slot
-					for slot := rangeStart; slot <= rangeEnd; slot++ {
-						_slot := slot
-						common.RunWithScissors(ctx, s.errC, "SolanaWatcherSlotFetcher", func(ctx context.Context) error {
-							s.retryFetchBlock(ctx, logger, _slot, 0, false)
-							return nil
-						})
+					if rangeStart <= rangeEnd {
+						s.fetchSlots(ctx, logger, rangeStart, rangeEnd)
 					}
 				}
 
@@ -485,19 +527,23 @@ func (s *SolanaWatcher) Run(ctx context.Context) error {
 	}
 }
 
-func (s *SolanaWatcher) retryFetchBlock(ctx context.Context, logger *zap.Logger, slot uint64, retry uint, isReobservation bool) {
-	ok := s.fetchBlock(ctx, logger, slot, 0, isReobservation)
-
-	if !ok {
+// retryFetchBlock fetches a block, retrying up to maxRetries times. It returns true once the block was processed, and
+// false if the retries are exhausted or the context is canceled.
+func (s *SolanaWatcher) retryFetchBlock(ctx context.Context, logger *zap.Logger, slot uint64, isReobservation bool) bool {
+	for retry := uint(0); !s.fetchBlock(ctx, logger, slot, 0, isReobservation); retry++ {
 		if retry >= maxRetries {
 			logger.Error("max retries for block",
 				zap.Uint64("slot", slot),
 				zap.String("commitment", string(s.commitment)),
 				zap.Uint("retry", retry))
-			return
+			return false
 		}
 
-		time.Sleep(retryDelay)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(retryDelay):
+		}
 
 		if logger.Level().Enabled(zapcore.DebugLevel) {
 			logger.Debug("retrying block",
@@ -505,12 +551,8 @@ func (s *SolanaWatcher) retryFetchBlock(ctx context.Context, logger *zap.Logger,
 				zap.String("commitment", string(s.commitment)),
 				zap.Uint("retry", retry))
 		}
-
-		common.RunWithScissors(ctx, s.errC, "retryFetchBlock", func(ctx context.Context) error {
-			s.retryFetchBlock(ctx, logger, slot, retry+1, isReobservation)
-			return nil
-		})
 	}
+	return true
 }
 
 func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot uint64, emptyRetry uint, isReobservation bool) (ok bool) {
@@ -520,24 +562,35 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 			zap.String("commitment", string(s.commitment)),
 			zap.Uint("empty_retry", emptyRetry))
 	}
-	rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
-	defer cancel()
 	start := time.Now()
 	rewards := false
 
 	maxSupportedTransactionVersion := uint64(0)
-	out, err := s.rpcClient.GetBlockWithOpts(rCtx, slot, &rpc.GetBlockOpts{
-		Encoding:                       solana.EncodingBase64, // solana-go doesn't support json encoding.
-		TransactionDetails:             "full",
-		Rewards:                        &rewards,
-		Commitment:                     s.commitment,
-		MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
+	var rpcClient *rpc.Client
+	var out *rpc.GetBlockResult
+	var err error
+	// Skipped and unavailable slots are not held against the endpoint, so they don't make us fail over.
+	_ = s.rpcPool.call(func(client *rpc.Client) error {
+		rCtx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		defer cancel()
+		rpcClient = client
+		out, err = client.GetBlockWithOpts(rCtx, slot, &rpc.GetBlockOpts{
+			Encoding:                       solana.EncodingBase64, // solana-go doesn't support json encoding.
+			TransactionDetails:             "full",
+			Rewards:                        &rewards,
+			Commitment:                     s.commitment,
+			MaxSupportedTransactionVersion: &maxSupportedTransactionVersion,
+		})
+		if isSkippedSlotError(err) {
+			return nil
+		}
+		return err
 	})
 
 	queryLatency.WithLabelValues(s.networkName, "get_confirmed_block", string(s.commitment)).Observe(time.Since(start).Seconds())
 	if err != nil {
 		var rpcErr *jsonrpc.RPCError
-		if errors.As(err, &rpcErr) && (rpcErr.Code == -32007 /* SLOT_SKIPPED */ || rpcErr.Code == -32004 /* BLOCK_NOT_AVAILABLE */) {
+		if errors.As(err, &rpcErr) && isSkippedSlotError(err) {
 			if logger.Level().Enabled(zapcore.DebugLevel) {
 				logger.Debug("empty slot", zap.Uint64("slot", slot),
 					zap.Int("code", rpcErr.Code),
@@ -623,7 +676,7 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 			continue
 		}
 
-		s.processTransaction(ctx, s.rpcClient, tx, txRpc.Meta, slot, false)
+		s.processTransaction(ctx, rpcClient, tx, txRpc.Meta, slot, false)
 	}
 
 	if emptyRetry > 0 && logger.Level().Enabled(zapcore.DebugLevel) {
@@ -636,6 +689,13 @@ func (s *SolanaWatcher) fetchBlock(ctx context.Context, logger *zap.Logger, slot
 	return true
 }
 
+// isSkippedSlotError returns true if the error returned when fetching a block means that the slot was skipped or
+// the block is not available.
+func isSkippedSlotError(err error) bool {
+	var rpcErr *jsonrpc.RPCError
+	return errors.As(err, &rpcErr) && (rpcErr.Code == -32007 /* SLOT_SKIPPED */ || rpcErr.Code == -32004 /* BLOCK_NOT_AVAILABLE */)
+}
+
 // processTransaction processes a transaction and publishes any Wormhole events.
 func (s *SolanaWatcher) processTransaction(ctx context.Context, rpcClient *rpc.Client, tx *solana.Transaction, meta *rpc.TransactionMeta, slot uint64, isReobservation bool) (numObservations uint32) {
 	signature := tx.Signatures[0]
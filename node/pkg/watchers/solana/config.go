@@ -2,6 +2,7 @@ package solana
 
 import (
 	"github.com/certusone/wormhole/node/pkg/common"
+	"github.com/certusone/wormhole/node/pkg/db"
 	gossipv1 "github.com/certusone/wormhole/node/pkg/proto/gossip/v1"
 	"github.com/certusone/wormhole/node/pkg/query"
 	"github.com/certusone/wormhole/node/pkg/supervisor"
@@ -18,10 +19,16 @@ type WatcherConfig struct {
 	ChainID       vaa.ChainID        // ChainID
 	ReceiveObsReq bool               // if false, this watcher will not get access to the observation request channel
 	Rpc           string             // RPC URL
+	BackupRpcs    []string           // RPC URLs to fail over to when Rpc is unhealthy
 	Websocket     string             // Websocket URL
 	Contract      string             // hex representation of the contract address
 	ShimContract  string             // Address of the shim contract (empty string if disabled)
 	Commitment    solana_rpc.CommitmentType
+
+	// CheckpointDB stores the last processed slot, from which up to BackfillLookback slots are backfilled after a
+	// restart or an outage. Backfilling is disabled if it is nil.
+	CheckpointDB     db.WatcherCheckpointDB
+	BackfillLookback uint64
 }
 
 func (wc *WatcherConfig) GetNetworkID() watchers.NetworkID {
@@ -65,7 +72,7 @@ func (wc *WatcherConfig) Create(
 		obsvReqC = nil
 	}
 
-	watcher := NewSolanaWatcher(wc.Rpc, &wc.Websocket, solAddress, wc.Contract, msgC, obsvReqC, wc.Commitment, wc.ChainID, queryReqC, queryResponseC, wc.ShimContract, shimContractAddr)
+	watcher := NewSolanaWatcher(wc.Rpc, wc.BackupRpcs, &wc.Websocket, solAddress, wc.Contract, msgC, obsvReqC, wc.Commitment, wc.ChainID, queryReqC, queryResponseC, wc.ShimContract, shimContractAddr, wc.CheckpointDB, string(wc.NetworkID), wc.BackfillLookback)
 
 	var reobserver interfaces.Reobserver
 	if wc.Commitment == solana_rpc.CommitmentFinalized {
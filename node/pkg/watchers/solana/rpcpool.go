@@ -0,0 +1,181 @@
+package solana
+
+import (
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	solanaRpcEndpointScore = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_solana_rpc_endpoint_score",
+			Help: "Health score of each configured Solana RPC endpoint, between 0 (failing) and 1 (healthy)",
+		}, []string{"solana_network", "commitment", "endpoint"})
+	solanaRpcEndpointSwitches = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_solana_rpc_endpoint_switches_total",
+			Help: "Total number of times the Solana watcher switched to a different RPC endpoint",
+		}, []string{"solana_network", "commitment"})
+)
+
+const (
+	// rpcScoreWeight is the weight of the most recent request in the score of an endpoint. With 0.1, a single failure
+	// does not make us switch endpoints but two in a row do.
+	rpcScoreWeight = 0.1
+
+	// rpcScoreTolerance is how much lower than the best score the score of an endpoint may be for it to still be
+	// preferred because it comes first in the configuration.
+	rpcScoreTolerance = 0.1
+
+	// rpcScoreRecoveryHalfLife is the time after which half of the score an endpoint lost is recovered, so that
+	// endpoints we switched away from are tried again.
+	rpcScoreRecoveryHalfLife = 2 * time.Minute
+)
+
+// rpcEndpoint is an RPC endpoint in the pool.
+type rpcEndpoint struct {
+	index  int
+	url    string
+	client *rpc.Client
+	// score is the exponentially weighted average of the success of the requests, as of updatedAt.
+	score     float64
+	updatedAt time.Time
+}
+
+// rpcPool is a pool of RPC endpoints of the same network. Requests go to the first configured endpoint that is
+// about as healthy as the healthiest one, and fail over to the others when they fail.
+type rpcPool struct {
+	logger      *zap.Logger
+	networkName string
+	commitment  string
+
+	mu        sync.Mutex
+	endpoints []*rpcEndpoint
+	// current is the index of the endpoint used last.
+	current int
+}
+
+func newRPCPool(networkName string, commitment rpc.CommitmentType, urls []string) *rpcPool {
+	p := &rpcPool{
+		logger:      zap.NewNop(),
+		networkName: networkName,
+		commitment:  string(commitment),
+	}
+	for i, url := range urls {
+		p.endpoints = append(p.endpoints, &rpcEndpoint{
+			index:  i,
+			url:    url,
+			client: rpc.New(url),
+			score:  1,
+		})
+		solanaRpcEndpointScore.WithLabelValues(networkName, p.commitment, strconv.Itoa(i)).Set(1)
+	}
+	return p
+}
+
+// effectiveScore returns the score of an endpoint including the part recovered since it was last updated.
+func (e *rpcEndpoint) effectiveScore(now time.Time) float64 {
+	elapsed := now.Sub(e.updatedAt)
+	if elapsed <= 0 {
+		return e.score
+	}
+	return 1 - (1-e.score)*math.Pow(0.5, float64(elapsed)/float64(rpcScoreRecoveryHalfLife))
+}
+
+// ranked returns the endpoints in the order they should be tried in.
+func (p *rpcPool) ranked(now time.Time) []*rpcEndpoint {
+	ret := make([]*rpcEndpoint, 0, len(p.endpoints))
+	remaining := append([]*rpcEndpoint{}, p.endpoints...)
+	for len(remaining) != 0 {
+		best := 0.0
+		for _, e := range remaining {
+			best = math.Max(best, e.effectiveScore(now))
+		}
+		for i, e := range remaining {
+			if e.effectiveScore(now) >= best-rpcScoreTolerance {
+				ret = append(ret, e)
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// get returns the endpoint to use for the next request.
+func (p *rpcPool) get() *rpcEndpoint {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.selectLocked(p.ranked(time.Now())[0])
+}
+
+// selectLocked records that the given endpoint is used and logs when that is a switch.
+func (p *rpcPool) selectLocked(e *rpcEndpoint) *rpcEndpoint {
+	if e.index != p.current {
+		p.logger.Info("switching Solana RPC endpoint",
+			zap.String("commitment", p.commitment),
+			zap.Int("from", p.current),
+			zap.Int("to", e.index),
+			zap.String("url", e.url),
+		)
+		solanaRpcEndpointSwitches.WithLabelValues(p.networkName, p.commitment).Inc()
+		p.current = e.index
+	}
+	return e
+}
+
+// client returns the RPC client of the endpoint to use for the next request.
+func (p *rpcPool) client() *rpc.Client {
+	return p.get().client
+}
+
+// report updates the score of an endpoint with the result of a request.
+func (p *rpcPool) report(e *rpcEndpoint, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	result := 1.0
+	if err != nil {
+		result = 0
+	}
+	e.score = (1-rpcScoreWeight)*e.effectiveScore(now) + rpcScoreWeight*result
+	e.updatedAt = now
+	solanaRpcEndpointScore.WithLabelValues(p.networkName, p.commitment, strconv.Itoa(e.index)).Set(e.score)
+}
+
+// call invokes f with the client of the endpoint to use, and fails over to the other endpoints in order if it fails.
+// It returns the error of the last endpoint tried.
+func (p *rpcPool) call(f func(client *rpc.Client) error) error {
+	p.mu.Lock()
+	endpoints := p.ranked(time.Now())
+	p.mu.Unlock()
+
+	var err error
+	for i, e := range endpoints {
+		p.mu.Lock()
+		p.selectLocked(e)
+		p.mu.Unlock()
+
+		err = f(e.client)
+		p.report(e, err)
+		if err == nil {
+			return nil
+		}
+		if i+1 < len(endpoints) {
+			p.logger.Warn("Solana RPC request failed, trying the next endpoint",
+				zap.String("commitment", p.commitment),
+				zap.Int("endpoint", e.index),
+				zap.Error(err),
+			)
+		}
+	}
+	return err
+}
@@ -0,0 +1,102 @@
+package solana
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSlotServer returns an RPC server that answers getSlot with the given slot.
+func newSlotServer(t *testing.T, slot uint64) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": slot})
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestRPCPoolRanking(t *testing.T) {
+	p := newRPCPool("solana", rpc.CommitmentFinalized, []string{"http://primary", "http://backup1", "http://backup2"})
+	primary, backup1, backup2 := p.endpoints[0], p.endpoints[1], p.endpoints[2]
+
+	// All healthy, the configured order wins.
+	assert.Equal(t, primary, p.get())
+
+	// A single failure is tolerated.
+	p.report(primary, errors.New("failed"))
+	assert.Equal(t, primary, p.get())
+
+	// A second one makes us switch to the first healthy backup.
+	p.report(primary, errors.New("failed"))
+	assert.Equal(t, backup1, p.get())
+	assert.Equal(t, 1, p.current)
+
+	p.report(backup1, errors.New("failed"))
+	p.report(backup1, errors.New("failed"))
+	p.report(backup1, errors.New("failed"))
+	assert.Equal(t, []*rpcEndpoint{backup2, primary, backup1}, p.ranked(time.Now()))
+
+	// Scores recover over time, so the primary is preferred again eventually.
+	assert.Equal(t, []*rpcEndpoint{primary, backup1, backup2}, p.ranked(time.Now().Add(10*rpcScoreRecoveryHalfLife)))
+}
+
+func TestRPCPoolEffectiveScore(t *testing.T) {
+	now := time.Now()
+	e := &rpcEndpoint{score: 0.5, updatedAt: now}
+	assert.InDelta(t, 0.5, e.effectiveScore(now), 1e-9)
+	assert.InDelta(t, 0.75, e.effectiveScore(now.Add(rpcScoreRecoveryHalfLife)), 1e-9)
+	assert.InDelta(t, 0.875, e.effectiveScore(now.Add(2*rpcScoreRecoveryHalfLife)), 1e-9)
+}
+
+func TestRPCPoolCallFailsOver(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	up := newSlotServer(t, 42)
+
+	p := newRPCPool("solana", rpc.CommitmentFinalized, []string{down.URL, up.URL})
+
+	getSlot := func() (uint64, error) {
+		var slot uint64
+		err := p.call(func(client *rpc.Client) error {
+			var err error
+			slot, err = client.GetSlot(context.Background(), rpc.CommitmentFinalized)
+			return err
+		})
+		return slot, err
+	}
+
+	slot, err := getSlot()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), slot)
+	assert.Less(t, p.endpoints[0].score, 1.0)
+	assert.Equal(t, 1.0, p.endpoints[1].score)
+
+	// After the second failure the backup is tried first.
+	_, err = getSlot()
+	require.NoError(t, err)
+	assert.Equal(t, p.endpoints[1], p.get())
+
+	// The error of the last endpoint is returned if all of them fail.
+	p = newRPCPool("solana", rpc.CommitmentFinalized, []string{down.URL})
+	_, err = getSlot()
+	require.Error(t, err)
+}
@@ -31,6 +31,12 @@ type ClientConn struct {
 	senderAddress string
 	chainId       string
 	mutex         sync.Mutex // Protects the account / sequence number
+
+	// The account number and the sequence of the next transaction are tracked locally, so that they don't need to be
+	// queried for every transaction. They are queried again if accountKnown is cleared.
+	accountKnown  bool
+	accountNumber uint64
+	sequence      uint64
 }
 
 // NewConn creates a new connection to the wormhole-chain instance at `target`.
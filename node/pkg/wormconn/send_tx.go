@@ -2,44 +2,177 @@ package wormconn
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
 
 	txclient "github.com/cosmos/cosmos-sdk/client/tx"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
+const (
+	// GasAdjustment is applied to the simulated gas usage, since the state may change between the simulation and the
+	// execution of the transaction.
+	GasAdjustment = 1.5
+
+	// FallbackGasLimit is used if the simulation fails. The transaction is still broadcast so that the failure is
+	// reported in the response, which the callers analyze.
+	FallbackGasLimit = 2000000
+)
+
+// errSequenceMismatch is returned if the locally tracked sequence is out of sync with the chain.
+var errSequenceMismatch = errors.New("account sequence mismatch")
+
+// SignAndBroadcastTx signs a transaction with a single message and broadcasts it.
 func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	return c.SignAndBroadcastBatch(ctx, []sdktypes.Msg{msg})
+}
+
+// SignAndBroadcastBatch packs the messages into a single transaction, signs it and broadcasts it. The transaction is
+// atomic, so if one of the messages fails, none of them is executed.
+func (c *ClientConn) SignAndBroadcastBatch(ctx context.Context, msgs []sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("no messages to broadcast")
+	}
+
 	// Lock to protect the wallet sequence number.
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
+	txResp, err := c.signAndBroadcast(ctx, msgs)
+	if errors.Is(err, errSequenceMismatch) {
+		// Someone else used the account, or a previous transaction was dropped. Resync the sequence and try again.
+		c.accountKnown = false
+		txResp, err = c.signAndBroadcast(ctx, msgs)
+	}
+
+	return txResp, err
+}
+
+// signAndBroadcast does the work of SignAndBroadcastBatch. The caller must hold the mutex.
+func (c *ClientConn) signAndBroadcast(ctx context.Context, msgs []sdktypes.Msg) (*sdktx.BroadcastTxResponse, error) {
+	if !c.accountKnown {
+		if err := c.syncAccount(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	client := sdktx.NewServiceClient(c.c)
+
+	gasLimit, err := c.simulate(ctx, client, msgs)
+	if err != nil {
+		if isSequenceMismatch(err) {
+			return nil, fmt.Errorf("failed to simulate tx: %w: %v", errSequenceMismatch, err)
+		}
+		gasLimit = FallbackGasLimit
+	}
+
+	txBytes, err := c.buildTx(msgs, gasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// Returns *BroadcastTxResponse
+	txResp, err := client.BroadcastTx(
+		ctx,
+		&sdktx.BroadcastTxRequest{
+			Mode:    sdktx.BroadcastMode_BROADCAST_MODE_BLOCK,
+			TxBytes: txBytes,
+		},
+	)
+	if err != nil {
+		// We don't know whether the transaction made it into a block.
+		c.accountKnown = false
+		return nil, fmt.Errorf("failed to broadcast tx: %w", err)
+	}
+
+	if txResp.TxResponse == nil || txResp.TxResponse.Code != 0 {
+		if txResp.TxResponse != nil &&
+			txResp.TxResponse.Codespace == sdkerrors.ErrWrongSequence.Codespace() &&
+			txResp.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			return txResp, fmt.Errorf("failed to broadcast tx: %w: %s", errSequenceMismatch, txResp.TxResponse.RawLog)
+		}
+		// Depending on where the transaction failed, the sequence may or may not have been incremented.
+		c.accountKnown = false
+		return txResp, nil
+	}
+
+	c.sequence++
+	return txResp, nil
+}
+
+// syncAccount queries the account number and the sequence of the sender. The caller must hold the mutex.
+func (c *ClientConn) syncAccount(ctx context.Context) error {
 	authClient := auth.NewQueryClient(c.c)
 	accountQuery := &auth.QueryAccountRequest{
 		Address: c.senderAddress,
 	}
 	resp, err := authClient.Account(ctx, accountQuery)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch account: %w", err)
+		return fmt.Errorf("failed to fetch account: %w", err)
 	}
 
 	var account auth.AccountI
 	if err := c.encCfg.InterfaceRegistry.UnpackAny(resp.Account, &account); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal account info: %w", err)
+		return fmt.Errorf("failed to unmarshal account info: %w", err)
+	}
+
+	c.accountNumber = account.GetAccountNumber()
+	c.sequence = account.GetSequence()
+	c.accountKnown = true
+	return nil
+}
+
+// simulate estimates the gas limit of a transaction with the messages. The caller must hold the mutex.
+func (c *ClientConn) simulate(ctx context.Context, client sdktx.ServiceClient, msgs []sdktypes.Msg) (uint64, error) {
+	txBytes, err := c.buildTx(msgs, 0, false)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := client.Simulate(ctx, &sdktx.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, fmt.Errorf("failed to simulate tx: %w", err)
+	}
+	if resp.GasInfo == nil {
+		return 0, errors.New("simulation did not return gas info")
 	}
 
+	return adjustGas(resp.GasInfo.GasUsed), nil
+}
+
+// adjustGas applies the GasAdjustment to the simulated gas usage.
+func adjustGas(gasUsed uint64) uint64 {
+	adjusted := math.Ceil(float64(gasUsed) * GasAdjustment)
+	if adjusted >= math.MaxUint64 {
+		return math.MaxUint64
+	}
+	return uint64(adjusted)
+}
+
+// isSequenceMismatch checks if the error returned by a simulation is caused by a wrong sequence.
+func isSequenceMismatch(err error) bool {
+	return strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+}
+
+// buildTx builds and encodes a transaction with the messages using the tracked sequence. If sign is false, the
+// signature is left empty, which is what the simulation expects. The caller must hold the mutex.
+func (c *ClientConn) buildTx(msgs []sdktypes.Msg, gasLimit uint64, sign bool) ([]byte, error) {
 	builder := c.encCfg.TxConfig.NewTxBuilder()
-	if err := builder.SetMsgs(msg); err != nil {
-		return nil, fmt.Errorf("failed to add message to builder: %w", err)
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, fmt.Errorf("failed to add messages to builder: %w", err)
 	}
-	builder.SetGasLimit(2000000) // TODO: Maybe simulate and use the result
+	builder.SetGasLimit(gasLimit)
 
 	// The tx needs to be signed in 2 passes: first we populate the SignerInfo
 	// inside the TxBuilder and then sign the payload.
-	sequence := account.GetSequence()
+	sequence := c.sequence
 	sig := signing.SignatureV2{
 		PubKey: c.privateKey.PubKey(),
 		Data: &signing.SingleSignatureData{
@@ -52,25 +185,27 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 		return nil, fmt.Errorf("failed to set SignerInfo: %w", err)
 	}
 
-	signerData := authsigning.SignerData{
-		ChainID:       c.chainId,
-		AccountNumber: account.GetAccountNumber(),
-		Sequence:      sequence,
-	}
+	if sign {
+		signerData := authsigning.SignerData{
+			ChainID:       c.chainId,
+			AccountNumber: c.accountNumber,
+			Sequence:      sequence,
+		}
 
-	sig, err = txclient.SignWithPrivKey(
-		c.encCfg.TxConfig.SignModeHandler().DefaultMode(),
-		signerData,
-		builder,
-		c.privateKey,
-		c.encCfg.TxConfig,
-		sequence,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign tx: %w", err)
-	}
-	if err := builder.SetSignatures(sig); err != nil {
-		return nil, fmt.Errorf("failed to update tx signature: %w", err)
+		sig, err := txclient.SignWithPrivKey(
+			c.encCfg.TxConfig.SignModeHandler().DefaultMode(),
+			signerData,
+			builder,
+			c.privateKey,
+			c.encCfg.TxConfig,
+			sequence,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sign tx: %w", err)
+		}
+		if err := builder.SetSignatures(sig); err != nil {
+			return nil, fmt.Errorf("failed to update tx signature: %w", err)
+		}
 	}
 
 	txBytes, err := c.encCfg.TxConfig.TxEncoder()(builder.GetTx())
@@ -78,19 +213,5 @@ func (c *ClientConn) SignAndBroadcastTx(ctx context.Context, msg sdktypes.Msg) (
 		return nil, fmt.Errorf("failed to marshal tx: %w", err)
 	}
 
-	client := sdktx.NewServiceClient(c.c)
-
-	// Returns *BroadcastTxResponse
-	txResp, err := client.BroadcastTx(
-		ctx,
-		&sdktx.BroadcastTxRequest{
-			Mode:    sdktx.BroadcastMode_BROADCAST_MODE_BLOCK,
-			TxBytes: txBytes,
-		},
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to broadcast tx: %w", err)
-	}
-
-	return txResp, nil
+	return txBytes, nil
 }
@@ -0,0 +1,26 @@
+package wormconn
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdjustGas(t *testing.T) {
+	assert.Equal(t, uint64(0), adjustGas(0))
+	assert.Equal(t, uint64(150_000), adjustGas(100_000))
+	assert.Equal(t, uint64(2), adjustGas(1))
+	assert.Equal(t, uint64(math.MaxUint64), adjustGas(math.MaxUint64))
+}
+
+func TestIsSequenceMismatch(t *testing.T) {
+	// This is how the simulation reports a wrong sequence over gRPC.
+	err := fmt.Errorf("rpc error: code = Unknown desc = %w", sdkerrors.Wrapf(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected 5, got 4"))
+	assert.True(t, isSequenceMismatch(err))
+
+	assert.False(t, isSequenceMismatch(errors.New("rpc error: code = Unknown desc = out of gas")))
+}
@@ -1,9 +1,16 @@
 package sdk
 
 import (
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
+// KnownDevnetGuardianSet is the initial guardian set used in devnet (Tilt), which consists of the first devnet guardian.
+var KnownDevnetGuardianSet = vaa.GuardianSet{
+	Index: 0,
+	Keys:  []common.Address{common.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")},
+}
+
 // KnownDevnetEmitters is a list of known emitters used during development.
 var KnownDevnetEmitters = buildKnownEmitters(knownDevnetTokenbridgeEmitters, knownDevnetNFTBridgeEmitters)
 
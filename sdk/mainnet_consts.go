@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/wormhole-foundation/wormhole/sdk/vaa"
 )
 
@@ -18,6 +19,35 @@ var PublicRPCEndpoints = []string{
 	"https://guardian.mainnet.xlabs.xyz",
 }
 
+// KnownMainnetGuardianSet is the mainnet guardian set that was current when this SDK version was released.
+// It is meant to seed a vaa.Verifier, which picks up later guardian sets from guardian set upgrade VAAs.
+//
+// The keys can be checked against deployments/mainnet/guardianSetVAAs.csv.
+var KnownMainnetGuardianSet = vaa.GuardianSet{
+	Index: 4,
+	Keys: []common.Address{
+		common.HexToAddress("0x5893B5A76c3f739645648885bDCcC06cd70a3Cd3"),
+		common.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157"),
+		common.HexToAddress("0x114De8460193bdf3A2fCf81f86a09765F4762fD1"),
+		common.HexToAddress("0x107A0086b32d7A0977926A205131d8731D39cbEB"),
+		common.HexToAddress("0x8C82B2fd82FaeD2711d59AF0F2499D16e726f6b2"),
+		common.HexToAddress("0x11b39756C042441BE6D8650b69b54EbE715E2343"),
+		common.HexToAddress("0x54Ce5B4D348fb74B958e8966e2ec3dBd4958a7cd"),
+		common.HexToAddress("0x15e7cAF07C4e3DC8e7C469f92C8Cd88FB8005a20"),
+		common.HexToAddress("0x74a3bf913953D695260D88BC1aA25A4eeE363ef0"),
+		common.HexToAddress("0x000aC0076727b35FBea2dAc28fEE5cCB0fEA768e"),
+		common.HexToAddress("0xAF45Ced136b9D9e24903464AE889F5C8a723FC14"),
+		common.HexToAddress("0xf93124b7c738843CBB89E864c862c38cddCccF95"),
+		common.HexToAddress("0xD2CC37A4dc036a8D232b48f62cDD4731412f4890"),
+		common.HexToAddress("0xDA798F6896A3331F64b48c12D1D57Fd9cbe70811"),
+		common.HexToAddress("0x71AA1BE1D36CaFE3867910F99C09e347899C19C3"),
+		common.HexToAddress("0x8192b6E7387CCd768277c17DAb1b7a5027c0b3Cf"),
+		common.HexToAddress("0x178e21ad2E77AE06711549CFBB1f9c7a9d8096e8"),
+		common.HexToAddress("0x5E1487F35515d02A92753504a8D75471b9f49EdB"),
+		common.HexToAddress("0x6FbEBc898F403E4773E95feB15E80C9A99c8348d"),
+	},
+}
+
 type (
 	EmitterType uint8
 )
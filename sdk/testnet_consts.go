@@ -1,6 +1,15 @@
 package sdk
 
-import "github.com/wormhole-foundation/wormhole/sdk/vaa"
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// KnownTestnetGuardianSet is the guardian set used on testnet, which consists of a single guardian.
+var KnownTestnetGuardianSet = vaa.GuardianSet{
+	Index: 0,
+	Keys:  []common.Address{common.HexToAddress("0x13947Bd48b18E53fdAeEe77F3473391aC727C638")},
+}
 
 // KnownTestnetEmitters is a list of known emitters on the various L1 testnets.
 var KnownTestnetEmitters = buildKnownEmitters(knownTestnetTokenbridgeEmitters, knownTestnetNFTBridgeEmitters)
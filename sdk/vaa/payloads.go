@@ -310,6 +310,29 @@ func (b BodyGuardianSetUpdate) Serialize() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Deserialize parses the body of a guardian set update, i.e. the payload following the core module, action and chain ID.
+func (b *BodyGuardianSetUpdate) Deserialize(bz []byte) error {
+	if len(bz) < 5 {
+		return fmt.Errorf("incorrect payload length, should be at least 5, is %d", len(bz))
+	}
+
+	newIndex := binary.BigEndian.Uint32(bz[0:4])
+	numKeys := int(bz[4])
+	if len(bz) != 5+numKeys*ethcommon.AddressLength {
+		return fmt.Errorf("incorrect payload length, should be %d, is %d", 5+numKeys*ethcommon.AddressLength, len(bz))
+	}
+
+	keys := make([]ethcommon.Address, numKeys)
+	for i := range keys {
+		start := 5 + i*ethcommon.AddressLength
+		keys[i] = ethcommon.BytesToAddress(bz[start : start+ethcommon.AddressLength])
+	}
+
+	b.NewIndex = newIndex
+	b.Keys = keys
+	return nil
+}
+
 func (r BodyTokenBridgeRegisterChain) Serialize() ([]byte, error) {
 	payload := &bytes.Buffer{}
 	MustWrite(payload, binary.BigEndian, r.ChainID)
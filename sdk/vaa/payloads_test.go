@@ -455,3 +455,21 @@ func TestBodyRecoverChainIdModuleTooLong(t *testing.T) {
 	require.ErrorContains(t, err, "failed to left pad module: payload longer than 32 bytes")
 	assert.Nil(t, buf)
 }
+
+func TestBodyGuardianSetUpdateDeserialize(t *testing.T) {
+	keys := []common.Address{
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+		common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee"),
+	}
+	serialized, err := BodyGuardianSetUpdate{Keys: keys, NewIndex: uint32(5)}.Serialize()
+	require.NoError(t, err)
+
+	// Skip the module, action and chain ID.
+	var body BodyGuardianSetUpdate
+	require.NoError(t, body.Deserialize(serialized[35:]))
+	assert.Equal(t, keys, body.Keys)
+	assert.Equal(t, uint32(5), body.NewIndex)
+
+	require.Error(t, body.Deserialize(serialized[35:len(serialized)-1]))
+	require.Error(t, body.Deserialize([]byte{0, 0, 0}))
+}
@@ -0,0 +1,110 @@
+// Package rpcverifier seeds a vaa.Verifier with the guardian sets read from an EVM core contract. It lives in its own
+// package so that importing sdk/vaa does not pull in the go-ethereum RPC client.
+package rpcverifier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/wormhole-foundation/wormhole/sdk/vaa"
+)
+
+// coreGuardianSetABI is the subset of the EVM core contract ABI needed to read guardian sets.
+const coreGuardianSetABI = `[
+	{"inputs":[],"name":"getCurrentGuardianSetIndex","outputs":[{"internalType":"uint32","name":"","type":"uint32"}],"stateMutability":"view","type":"function"},
+	{"inputs":[{"internalType":"uint32","name":"index","type":"uint32"}],"name":"getGuardianSet","outputs":[{"components":[{"internalType":"address[]","name":"keys","type":"address[]"},{"internalType":"uint32","name":"expirationTime","type":"uint32"}],"internalType":"struct Structs.GuardianSet","name":"","type":"tuple"}],"stateMutability":"view","type":"function"}
+]`
+
+// NewVerifier creates a vaa.Verifier seeded from an EVM core contract. It reads the current guardian set and up to
+// `history` previous guardian sets (including their on-chain expiration times).
+func NewVerifier(ctx context.Context, rpcURL string, coreContract common.Address, history uint32) (*vaa.Verifier, error) {
+	sets, err := FetchGuardianSets(ctx, rpcURL, coreContract, history)
+	if err != nil {
+		return nil, err
+	}
+	return vaa.NewVerifier(sets...)
+}
+
+// FetchGuardianSets reads the current guardian set and up to `history` previous guardian sets from an EVM core contract.
+func FetchGuardianSets(ctx context.Context, rpcURL string, coreContract common.Address, history uint32) ([]vaa.GuardianSet, error) {
+	client, err := rpc.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %w", rpcURL, err)
+	}
+	defer client.Close()
+
+	parsed, err := abi.JSON(strings.NewReader(coreGuardianSetABI))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse core contract ABI: %w", err)
+	}
+
+	out, err := callContract(ctx, client, parsed, coreContract, "getCurrentGuardianSetIndex")
+	if err != nil {
+		return nil, err
+	}
+	currentIndex, ok := out[0].(uint32)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T for guardian set index", out[0])
+	}
+
+	first := uint32(0)
+	if currentIndex > history {
+		first = currentIndex - history
+	}
+
+	sets := make([]vaa.GuardianSet, 0, currentIndex-first+1)
+	for idx := first; idx <= currentIndex; idx++ {
+		out, err := callContract(ctx, client, parsed, coreContract, "getGuardianSet", idx)
+		if err != nil {
+			return nil, err
+		}
+
+		result, ok := out[0].(struct {
+			Keys           []common.Address `json:"keys"`
+			ExpirationTime uint32           `json:"expirationTime"`
+		})
+		if !ok {
+			return nil, fmt.Errorf("unexpected type %T for guardian set %d", out[0], idx)
+		}
+
+		gs := vaa.GuardianSet{Keys: result.Keys, Index: idx}
+		if result.ExpirationTime != 0 {
+			gs.ExpirationTime = time.Unix(int64(result.ExpirationTime), 0)
+		}
+		sets = append(sets, gs)
+	}
+
+	return sets, nil
+}
+
+func callContract(ctx context.Context, client *rpc.Client, parsed abi.ABI, contract common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s: %w", method, err)
+	}
+
+	var result hexutil.Bytes
+	callArgs := map[string]interface{}{
+		"to":   contract,
+		"data": hexutil.Bytes(data),
+	}
+	if err := client.CallContext(ctx, &result, "eth_call", callArgs, "latest"); err != nil {
+		return nil, fmt.Errorf("failed to call %s: %w", method, err)
+	}
+
+	out, err := parsed.Unpack(method, result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s: %w", method, err)
+	}
+	if len(out) != 1 {
+		return nil, fmt.Errorf("unexpected number of return values from %s: %d", method, len(out))
+	}
+
+	return out, nil
+}
@@ -0,0 +1,228 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// GuardianSetExpiry is how long a guardian set continues to be accepted after it has been replaced by a
+// guardian set upgrade. This needs to match the expiry used by the core contracts (24 hours).
+const GuardianSetExpiry = 24 * time.Hour
+
+var (
+	ErrUnknownGuardianSet     = errors.New("unknown guardian set")
+	ErrGuardianSetExpired     = errors.New("guardian set has expired")
+	ErrNotGuardianSetUpgrade  = errors.New("VAA is not a guardian set upgrade")
+	ErrInvalidGuardianSetKeys = errors.New("guardian set must contain at least one key")
+)
+
+// GuardianSet is a guardian set as tracked by a Verifier.
+type GuardianSet struct {
+	// Keys are the guardian addresses, in the order used for signature indexes.
+	Keys []common.Address
+	// Index is the on-chain guardian set index.
+	Index uint32
+	// ExpirationTime is the time after which VAAs signed by this set are rejected. The zero value means the set does not expire.
+	ExpirationTime time.Time
+}
+
+// Expired returns true if the guardian set has an expiration time that is before now.
+func (gs *GuardianSet) Expired(now time.Time) bool {
+	return !gs.ExpirationTime.IsZero() && now.After(gs.ExpirationTime)
+}
+
+// Verifier verifies VAAs against a history of guardian sets. It can be seeded with known guardian sets
+// (see for example sdk.KnownMainnetGuardianSet) or from a core contract and follows guardian set upgrades
+// when it is fed the corresponding governance VAAs. It is safe for concurrent use.
+type Verifier struct {
+	mu           sync.RWMutex
+	sets         map[uint32]*GuardianSet
+	currentIndex uint32
+
+	// now is used to determine guardian set expiry. It is overridden in tests.
+	now func() time.Time
+}
+
+// NewVerifier creates a Verifier seeded with the given guardian sets. The set with the highest index becomes the current set.
+func NewVerifier(sets ...GuardianSet) (*Verifier, error) {
+	if len(sets) == 0 {
+		return nil, errors.New("at least one guardian set is required")
+	}
+
+	v := &Verifier{
+		sets: make(map[uint32]*GuardianSet),
+		now:  time.Now,
+	}
+
+	for _, gs := range sets {
+		if err := v.AddGuardianSet(gs); err != nil {
+			return nil, err
+		}
+	}
+
+	return v, nil
+}
+
+// AddGuardianSet adds a guardian set to the verifier. If the index is higher than the current one, the new set becomes
+// the current set. Unlike ApplyGuardianSetUpgrade, this does not change the expiration time of any other set, so the
+// caller is responsible for providing the expiration time of historical sets.
+func (v *Verifier) AddGuardianSet(gs GuardianSet) error {
+	if len(gs.Keys) == 0 {
+		return ErrInvalidGuardianSetKeys
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if existing, exists := v.sets[gs.Index]; exists && !sameKeys(existing.Keys, gs.Keys) {
+		return fmt.Errorf("guardian set %d is already known with different keys", gs.Index)
+	}
+
+	keys := make([]common.Address, len(gs.Keys))
+	copy(keys, gs.Keys)
+	v.sets[gs.Index] = &GuardianSet{Keys: keys, Index: gs.Index, ExpirationTime: gs.ExpirationTime}
+
+	if gs.Index > v.currentIndex || len(v.sets) == 1 {
+		v.currentIndex = gs.Index
+	}
+
+	return nil
+}
+
+// CurrentGuardianSet returns a copy of the current guardian set.
+func (v *Verifier) CurrentGuardianSet() GuardianSet {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return copyGuardianSet(v.sets[v.currentIndex])
+}
+
+// GuardianSet returns a copy of the guardian set with the given index, if it is known.
+func (v *Verifier) GuardianSet(index uint32) (GuardianSet, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	gs, exists := v.sets[index]
+	if !exists {
+		return GuardianSet{}, false
+	}
+	return copyGuardianSet(gs), true
+}
+
+// Verify checks that the VAA was signed by a quorum of the guardian set it references. VAAs signed by a
+// previous guardian set are accepted until that set expires, matching the behavior of the core contracts.
+func (v *Verifier) Verify(vaa *VAA) error {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.verifyLocked(vaa)
+}
+
+func (v *Verifier) verifyLocked(vaa *VAA) error {
+	gs, exists := v.sets[vaa.GuardianSetIndex]
+	if !exists {
+		return fmt.Errorf("%w: %d", ErrUnknownGuardianSet, vaa.GuardianSetIndex)
+	}
+
+	// The current guardian set never expires, even if an expiration time was configured for it.
+	if vaa.GuardianSetIndex != v.currentIndex && gs.Expired(v.now()) {
+		return fmt.Errorf("%w: %d expired at %s", ErrGuardianSetExpired, gs.Index, gs.ExpirationTime.Format(time.RFC3339))
+	}
+
+	return vaa.Verify(gs.Keys)
+}
+
+// ApplyGuardianSetUpgrade verifies a core guardian set upgrade governance VAA and, if it is valid, makes the new
+// guardian set the current one. The previous set remains valid for GuardianSetExpiry. Like the core contracts, the
+// upgrade must be signed by the current guardian set and the new index must be exactly one higher than the current one.
+// Applying an upgrade that is already known is a no-op and returns nil.
+func (v *Verifier) ApplyGuardianSetUpgrade(vaa *VAA) error {
+	if vaa.EmitterChain != GovernanceChain || vaa.EmitterAddress != GovernanceEmitter {
+		return fmt.Errorf("%w: unexpected emitter %s", ErrNotGuardianSetUpgrade, vaa.MessageID())
+	}
+
+	body, err := parseGuardianSetUpgrade(vaa.Payload)
+	if err != nil {
+		return err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if existing, exists := v.sets[body.NewIndex]; exists {
+		if !sameKeys(existing.Keys, body.Keys) {
+			return fmt.Errorf("guardian set %d is already known with different keys", body.NewIndex)
+		}
+		return nil
+	}
+
+	if vaa.GuardianSetIndex != v.currentIndex {
+		return fmt.Errorf("guardian set upgrade must be signed by the current guardian set %d, was signed by %d", v.currentIndex, vaa.GuardianSetIndex)
+	}
+
+	if body.NewIndex != v.currentIndex+1 {
+		return fmt.Errorf("invalid new guardian set index %d, expected %d", body.NewIndex, v.currentIndex+1)
+	}
+
+	if err := v.verifyLocked(vaa); err != nil {
+		return fmt.Errorf("failed to verify guardian set upgrade: %w", err)
+	}
+
+	v.sets[v.currentIndex].ExpirationTime = v.now().Add(GuardianSetExpiry)
+	v.sets[body.NewIndex] = &GuardianSet{Keys: body.Keys, Index: body.NewIndex}
+	v.currentIndex = body.NewIndex
+
+	return nil
+}
+
+// parseGuardianSetUpgrade parses the payload of a core guardian set upgrade governance VAA.
+func parseGuardianSetUpgrade(payload []byte) (*BodyGuardianSetUpdate, error) {
+	// Module (32 bytes), action (1 byte) and chain ID (2 bytes).
+	if len(payload) < 35 {
+		return nil, fmt.Errorf("%w: payload too short", ErrNotGuardianSetUpgrade)
+	}
+
+	if !bytes.Equal(payload[0:32], CoreModule) {
+		return nil, fmt.Errorf("%w: not a core module governance message", ErrNotGuardianSetUpgrade)
+	}
+
+	if GovernanceAction(payload[32]) != ActionGuardianSetUpdate {
+		return nil, fmt.Errorf("%w: unexpected governance action %d", ErrNotGuardianSetUpgrade, payload[32])
+	}
+
+	if chainID := binary.BigEndian.Uint16(payload[33:35]); chainID != 0 {
+		return nil, fmt.Errorf("invalid guardian set upgrade target chain %d, should be 0", chainID)
+	}
+
+	var body BodyGuardianSetUpdate
+	if err := body.Deserialize(payload[35:]); err != nil {
+		return nil, fmt.Errorf("failed to parse guardian set upgrade: %w", err)
+	}
+
+	if len(body.Keys) == 0 {
+		return nil, ErrInvalidGuardianSetKeys
+	}
+
+	return &body, nil
+}
+
+func copyGuardianSet(gs *GuardianSet) GuardianSet {
+	keys := make([]common.Address, len(gs.Keys))
+	copy(keys, gs.Keys)
+	return GuardianSet{Keys: keys, Index: gs.Index, ExpirationTime: gs.ExpirationTime}
+}
+
+func sameKeys(a []common.Address, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
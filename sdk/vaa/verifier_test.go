@@ -0,0 +1,187 @@
+package vaa
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mainnetGuardianSet4UpgradeVAA is the guardian set upgrade from mainnet guardian set 3 to 4 (see deployments/mainnet/guardianSetVAAs.csv).
+const mainnetGuardianSet4UpgradeVAA = "01000000030d03d4a37a6ff4361d91714730831e9d49785f61624c8f348a9c6c1d82bc1d98cadc5e936338204445c6250bb4928f3f3e165ad47ca03a5d63111168a2de4576856301049a5df10464ea4e1961589fd30fc18d1970a7a2ffaad617e56a0f7777f25275253af7d10a0f0f2494dc6e99fc80e444ab9ebbbee252ded2d5dcb50cbf7a54bb5a01055f4603b553b9ba9e224f9c55c7bca3da00abb10abd19e0081aecd3b352be061a70f79f5f388ebe5190838ef3cd13a2f22459c9a94206883b739c90b40d5d74640006a8fade3997f650a36e46bceb1f609edff201ab32362266f166c5c7da713f6a19590c20b68ed3f0119cb24813c727560ede086b3d610c2d7a1efa66f655bad90900080f5e495a75ea52241c59d145c616bfac01e57182ad8d784cbcc9862ed3afb60c0983ccbc690553961ffcf115a0c917367daada8e60be2cbb8b8008bac6341a8c010935ab11e0eea28b87a1edc5ccce3f1fac25f75b5f640fe6b0673a7cd74513c9dc01c544216cf364cc9993b09fda612e0cd1ced9c00fb668b872a16a64ebb55d27010ab2bc39617a2396e7defa24cd7c22f42dc31f3c42ffcd9d1472b02df8468a4d0563911e8fb6a4b5b0ce0bd505daa53779b08ff660967b31f246126ed7f6f29a7e000bdb6d3fd7b33bdc9ac3992916eb4aacb97e7e21d19649e7fa28d2dd6e337937e4274516a96c13ac7a8895da9f91948ea3a09c25f44b982c62ce8842b58e20c8a9000d3d1b19c8bb000856b6610b9d28abde6c35cb7705c6ca5db711f7be96d60eed9d72cfa402a6bfe8bf0496dbc7af35796fc768da51a067b95941b3712dce8ae1e7010ec80085033157fd1a5628fc0c56267469a86f0e5a66d7dede1ad4ce74ecc3dff95b60307a39c3bfbeedc915075070da30d0395def9635130584f709b3885e1bdc0010fc480eb9ee715a2d151b23722b48b42581d7f4001fc1696c75425040bfc1ffc5394fe418adb2b64bd3dc692efda4cc408163677dbe233b16bcdabb853a20843301118ee9e115e1a0c981f19d0772b850e666591322da742a9a12cce9f52a5665bd474abdd59c580016bee8aae67fdf39b315be2528d12eec3a652910e03cc4c6fa3801129d0d1e2e429e969918ec163d16a7a5b2c6729aa44af5dccad07d25d19891556a79b574f42d9adbd9e2a9ae5a6b8750331d2fccb328dd94c3bf8791ee1bfe85aa00661e99781981faea00010000000000000000000000000000000000000000000000000000000000000004fd4c6c55ec8dfd342000000000000000000000000000000000000000000000000000000000436f726502000000000004135893b5a76c3f739645648885bdccc06cd70a3cd3ff6cb952589bde862c25ef4392132fb9d4a42157114de8460193bdf3a2fcf81f86a09765f4762fd1107a0086b32d7a0977926a205131d8731d39cbeb8c82b2fd82faed2711d59af0f2499d16e726f6b211b39756c042441be6d8650b69b54ebe715e234354ce5b4d348fb74b958e8966e2ec3dbd4958a7cd15e7caf07c4e3dc8e7c469f92c8cd88fb8005a2074a3bf913953d695260d88bc1aa25a4eee363ef0000ac0076727b35fbea2dac28fee5ccb0fea768eaf45ced136b9d9e24903464ae889f5c8a723fc14f93124b7c738843cbb89e864c862c38cddcccf95d2cc37a4dc036a8d232b48f62cdd4731412f4890da798f6896a3331f64b48c12d1d57fd9cbe7081171aa1be1d36cafe3867910f99c09e347899c19c38192b6e7387ccd768277c17dab1b7a5027c0b3cf178e21ad2e77ae06711549cfbb1f9c7a9d8096e85e1487f35515d02a92753504a8d75471b9f49edb6fbebc898f403e4773e95feb15e80c9a99c8348d"
+
+var mainnetGuardianSet3 = GuardianSet{
+	Index: 3,
+	Keys: []common.Address{
+		common.HexToAddress("0x58CC3AE5C097b213cE3c81979e1B9f9570746AA5"),
+		common.HexToAddress("0xfF6CB952589BDE862c25Ef4392132fb9D4A42157"),
+		common.HexToAddress("0x114De8460193bdf3A2fCf81f86a09765F4762fD1"),
+		common.HexToAddress("0x107A0086b32d7A0977926A205131d8731D39cbEB"),
+		common.HexToAddress("0x8C82B2fd82FaeD2711d59AF0F2499D16e726f6b2"),
+		common.HexToAddress("0x11b39756C042441BE6D8650b69b54EbE715E2343"),
+		common.HexToAddress("0x54Ce5B4D348fb74B958e8966e2ec3dBd4958a7cd"),
+		common.HexToAddress("0x15e7cAF07C4e3DC8e7C469f92C8Cd88FB8005a20"),
+		common.HexToAddress("0x74a3bf913953D695260D88BC1aA25A4eeE363ef0"),
+		common.HexToAddress("0x000aC0076727b35FBea2dAc28fEE5cCB0fEA768e"),
+		common.HexToAddress("0xAF45Ced136b9D9e24903464AE889F5C8a723FC14"),
+		common.HexToAddress("0xf93124b7c738843CBB89E864c862c38cddCccF95"),
+		common.HexToAddress("0xD2CC37A4dc036a8D232b48f62cDD4731412f4890"),
+		common.HexToAddress("0xDA798F6896A3331F64b48c12D1D57Fd9cbe70811"),
+		common.HexToAddress("0x71AA1BE1D36CaFE3867910F99C09e347899C19C3"),
+		common.HexToAddress("0x8192b6E7387CCd768277c17DAb1b7a5027c0b3Cf"),
+		common.HexToAddress("0x178e21ad2E77AE06711549CFBB1f9c7a9d8096e8"),
+		common.HexToAddress("0x5E1487F35515d02A92753504a8D75471b9f49EdB"),
+		common.HexToAddress("0x6FbEBc898F403E4773E95feB15E80C9A99c8348d"),
+	},
+}
+
+func generateGuardianKeys(t *testing.T, n int) ([]*ecdsa.PrivateKey, []common.Address) {
+	t.Helper()
+	keys := make([]*ecdsa.PrivateKey, n)
+	addrs := make([]common.Address, n)
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		addrs[i] = crypto.PubkeyToAddress(key.PublicKey)
+	}
+	return keys, addrs
+}
+
+func signVAA(v *VAA, keys []*ecdsa.PrivateKey) {
+	for i, key := range keys {
+		v.AddSignature(key, uint8(i))
+	}
+}
+
+func createGuardianSetUpgrade(t *testing.T, signedBy uint32, newIndex uint32, newKeys []common.Address, signers []*ecdsa.PrivateKey) *VAA {
+	t.Helper()
+	payload, err := BodyGuardianSetUpdate{Keys: newKeys, NewIndex: newIndex}.Serialize()
+	require.NoError(t, err)
+	v := CreateGovernanceVAA(time.Unix(1000, 0), 1, uint64(newIndex), signedBy, payload)
+	signVAA(v, signers)
+	return v
+}
+
+func TestNewVerifierRequiresGuardianSet(t *testing.T) {
+	_, err := NewVerifier()
+	require.Error(t, err)
+
+	_, err = NewVerifier(GuardianSet{Index: 0})
+	require.ErrorIs(t, err, ErrInvalidGuardianSetKeys)
+}
+
+func TestVerifierVerify(t *testing.T) {
+	keys, addrs := generateGuardianKeys(t, 4)
+	verifier, err := NewVerifier(GuardianSet{Index: 2, Keys: addrs})
+	require.NoError(t, err)
+
+	v := getVaa()
+	v.GuardianSetIndex = 2
+	signVAA(&v, keys)
+	require.NoError(t, verifier.Verify(&v))
+
+	// Not enough signatures for quorum.
+	v.Signatures = nil
+	signVAA(&v, keys[:2])
+	require.Error(t, verifier.Verify(&v))
+
+	// Unknown guardian set.
+	v.GuardianSetIndex = 3
+	require.ErrorIs(t, verifier.Verify(&v), ErrUnknownGuardianSet)
+}
+
+func TestVerifierApplyGuardianSetUpgrade(t *testing.T) {
+	oldKeys, oldAddrs := generateGuardianKeys(t, 4)
+	newKeys, newAddrs := generateGuardianKeys(t, 7)
+
+	verifier, err := NewVerifier(GuardianSet{Index: 0, Keys: oldAddrs})
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	verifier.now = func() time.Time { return now }
+
+	upgrade := createGuardianSetUpgrade(t, 0, 1, newAddrs, oldKeys)
+	require.NoError(t, verifier.ApplyGuardianSetUpgrade(upgrade))
+
+	current := verifier.CurrentGuardianSet()
+	assert.Equal(t, uint32(1), current.Index)
+	assert.Equal(t, newAddrs, current.Keys)
+	assert.True(t, current.ExpirationTime.IsZero())
+
+	previous, exists := verifier.GuardianSet(0)
+	require.True(t, exists)
+	assert.Equal(t, now.Add(GuardianSetExpiry), previous.ExpirationTime)
+
+	// Applying the same upgrade again is a no-op.
+	require.NoError(t, verifier.ApplyGuardianSetUpgrade(upgrade))
+
+	// VAAs signed by the new set are accepted.
+	v := getVaa()
+	v.GuardianSetIndex = 1
+	signVAA(&v, newKeys)
+	require.NoError(t, verifier.Verify(&v))
+
+	// VAAs signed by the previous set are accepted until it expires.
+	old := getVaa()
+	old.GuardianSetIndex = 0
+	signVAA(&old, oldKeys)
+	require.NoError(t, verifier.Verify(&old))
+
+	now = now.Add(GuardianSetExpiry + time.Second)
+	require.ErrorIs(t, verifier.Verify(&old), ErrGuardianSetExpired)
+	require.NoError(t, verifier.Verify(&v))
+}
+
+func TestVerifierApplyGuardianSetUpgradeRejectsInvalidUpgrades(t *testing.T) {
+	oldKeys, oldAddrs := generateGuardianKeys(t, 4)
+	otherKeys, newAddrs := generateGuardianKeys(t, 4)
+
+	verifier, err := NewVerifier(GuardianSet{Index: 0, Keys: oldAddrs})
+	require.NoError(t, err)
+
+	// Skipping an index.
+	require.Error(t, verifier.ApplyGuardianSetUpgrade(createGuardianSetUpgrade(t, 0, 2, newAddrs, oldKeys)))
+
+	// Signed by the wrong keys.
+	require.Error(t, verifier.ApplyGuardianSetUpgrade(createGuardianSetUpgrade(t, 0, 1, newAddrs, otherKeys)))
+
+	// Empty guardian set.
+	require.ErrorIs(t, verifier.ApplyGuardianSetUpgrade(createGuardianSetUpgrade(t, 0, 1, nil, oldKeys)), ErrInvalidGuardianSetKeys)
+
+	// Not a governance emitter.
+	upgrade := createGuardianSetUpgrade(t, 0, 1, newAddrs, oldKeys)
+	upgrade.EmitterChain = ChainIDEthereum
+	require.ErrorIs(t, verifier.ApplyGuardianSetUpgrade(upgrade), ErrNotGuardianSetUpgrade)
+
+	// Not a guardian set upgrade.
+	payload, err := BodyContractUpgrade{ChainID: ChainIDEthereum, NewContract: addr}.Serialize()
+	require.NoError(t, err)
+	contractUpgrade := CreateGovernanceVAA(time.Unix(1000, 0), 1, 1, 0, payload)
+	signVAA(contractUpgrade, oldKeys)
+	require.ErrorIs(t, verifier.ApplyGuardianSetUpgrade(contractUpgrade), ErrNotGuardianSetUpgrade)
+
+	assert.Equal(t, uint32(0), verifier.CurrentGuardianSet().Index)
+}
+
+func TestVerifierApplyMainnetGuardianSetUpgrade(t *testing.T) {
+	data, err := hex.DecodeString(mainnetGuardianSet4UpgradeVAA)
+	require.NoError(t, err)
+	upgrade, err := Unmarshal(data)
+	require.NoError(t, err)
+
+	verifier, err := NewVerifier(mainnetGuardianSet3)
+	require.NoError(t, err)
+	require.NoError(t, verifier.ApplyGuardianSetUpgrade(upgrade))
+
+	current := verifier.CurrentGuardianSet()
+	assert.Equal(t, uint32(4), current.Index)
+	require.Len(t, current.Keys, 19)
+	assert.Equal(t, common.HexToAddress("0x5893B5A76c3f739645648885bDCcC06cd70a3Cd3"), current.Keys[0])
+	assert.Equal(t, mainnetGuardianSet3.Keys[1:], current.Keys[1:])
+}